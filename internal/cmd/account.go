@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/steipete/gogcli/internal/config"
@@ -19,31 +20,12 @@ func requireAccount(flags *RootFlags) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if v := strings.TrimSpace(flags.Account); v != "" {
-		if resolved, ok, err := resolveAccountAlias(v); err != nil {
-			return "", err
-		} else if ok {
-			return resolved, nil
-		}
-		if shouldAutoSelectAccount(v) {
-			v = ""
-		}
-		if v != "" {
-			return v, nil
-		}
-	}
-	if v := strings.TrimSpace(os.Getenv("GOG_ACCOUNT")); v != "" {
-		if resolved, ok, err := resolveAccountAlias(v); err != nil {
-			return "", err
-		} else if ok {
-			return resolved, nil
-		}
-		if shouldAutoSelectAccount(v) {
-			v = ""
-		}
-		if v != "" {
-			return v, nil
+	for _, v := range []string{flags.Account, os.Getenv("GOG_ACCOUNT")} {
+		v = strings.TrimSpace(v)
+		if v == "" || shouldAutoSelectAccount(v) {
+			continue
 		}
+		return resolveAccountEmail(v)
 	}
 
 	if store, err := openSecretsStoreForAccount(); err == nil {
@@ -79,6 +61,36 @@ func requireAccount(flags *RootFlags) (string, error) {
 	return "", usage("missing --account (or set GOG_ACCOUNT, set default via `gog auth manage`, or store exactly one token)")
 }
 
+// resolveAccountEmail maps a configured alias (see `gog auth alias`) to its
+// email. Values containing "@" are returned unchanged; anything else must be a
+// known alias.
+func resolveAccountEmail(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" || strings.Contains(value, "@") {
+		return value, nil
+	}
+	resolved, ok, err := resolveAccountAlias(value)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return resolved, nil
+	}
+	aliases, err := config.ListAccountAliases()
+	if err != nil {
+		return "", err
+	}
+	if len(aliases) == 0 {
+		return "", usagef("unknown account alias %q (no aliases configured; use an email or `gog auth alias set`)", value)
+	}
+	names := make([]string, 0, len(aliases))
+	for name := range aliases {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return "", usagef("unknown account alias %q (known aliases: %s)", value, strings.Join(names, ", "))
+}
+
 func resolveAccountAlias(value string) (string, bool, error) {
 	value = strings.TrimSpace(value)
 	if value == "" || strings.Contains(value, "@") || shouldAutoSelectAccount(value) {
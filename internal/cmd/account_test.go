@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steipete/gogcli/internal/config"
@@ -184,3 +185,37 @@ func TestRequireAccount_MissingWhenMultipleTokensAndNoDefault(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestRequireAccount_UnknownAliasListsKnown(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	if err := config.WriteConfig(config.File{
+		AccountAliases: map[string]string{"work": "w@example.com", "home": "h@example.com"},
+	}); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	_, err := requireAccount(&RootFlags{Account: "wrok"})
+	if err == nil || !strings.Contains(err.Error(), `unknown account alias "wrok" (known aliases: home, work)`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Aliases are case-insensitive and emails pass through untouched.
+	if got, err := requireAccount(&RootFlags{Account: "WORK"}); err != nil || got != "w@example.com" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+	if got, err := requireAccount(&RootFlags{Account: "someone@example.com"}); err != nil || got != "someone@example.com" {
+		t.Fatalf("got %q, %v", got, err)
+	}
+}
+
+func TestResolveAccountEmail_NoAliasesConfigured(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	if _, err := resolveAccountEmail("work"); err == nil || !strings.Contains(err.Error(), "no aliases configured") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/steipete/gogcli/internal/errfmt"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// allAccountsCmd is implemented by read-only commands that may be fanned out
+// over every stored account with --all-accounts. Anything that mutates data
+// must not implement it.
+type allAccountsCmd interface {
+	allAccounts()
+}
+
+func enforceAllAccountsSupport(kctx *kong.Context, flags *RootFlags) error {
+	if !flags.AllAccounts {
+		return nil
+	}
+	if strings.TrimSpace(flags.Account) != "" {
+		return usage("--all-accounts cannot be combined with --account")
+	}
+	if strings.TrimSpace(flags.Template) != "" || strings.TrimSpace(flags.TemplateFile) != "" {
+		return usage("--all-accounts cannot be combined with --template")
+	}
+	if flags.CSV {
+		return usage("--all-accounts cannot be combined with --csv")
+	}
+	if flags.NDJSON {
+		return usage("--all-accounts cannot be combined with --ndjson")
+	}
+	node := kctx.Selected()
+	if node != nil && node.Target.IsValid() && node.Target.CanAddr() {
+		if _, ok := node.Target.Addr().Interface().(allAccountsCmd); ok {
+			return nil
+		}
+	}
+	return usage("--all-accounts is only supported by read-only list commands")
+}
+
+// storedAccountEmails returns the unique emails with a stored refresh token.
+func storedAccountEmails() ([]string, error) {
+	store, err := openSecretsStore()
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := store.ListTokens()
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	emails := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		email := strings.ToLower(strings.TrimSpace(tok.Email))
+		if email == "" || seen[email] {
+			continue
+		}
+		seen[email] = true
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	return emails, nil
+}
+
+// runAllAccounts runs the selected command once per stored account. Text
+// output gets an "=== account ===" header per block; JSON output is collected
+// into a single object keyed by email. Failures are reported per account and
+// do not stop the remaining accounts.
+func runAllAccounts(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	emails, err := storedAccountEmails()
+	if err != nil {
+		return err
+	}
+	if len(emails) == 0 {
+		return errors.New("no stored accounts (run: gog auth add <email>)")
+	}
+
+	jsonMode := outfmt.IsJSON(ctx)
+	results := make(map[string]json.RawMessage, len(emails))
+	failed, empty := 0, 0
+	for i, email := range emails {
+		flags.Account = email
+		if !jsonMode {
+			if i > 0 {
+				u.Out().Println("")
+			}
+			u.Out().Printf("=== %s ===", email)
+		}
+
+		var runErr error
+		if jsonMode {
+			// Collect this account's JSON instead of printing it; the
+			// command sees a ctx whose stdout writes land in out.
+			var out bytes.Buffer
+			kctx.BindTo(outfmt.WithStdout(ctx, &out), (*context.Context)(nil))
+			runErr = kctx.Run()
+			kctx.BindTo(ctx, (*context.Context)(nil))
+			if runErr == nil || isEmptyResultsExit(runErr) {
+				results[email] = json.RawMessage(bytes.TrimSpace(out.Bytes()))
+			}
+		} else {
+			runErr = kctx.Run()
+		}
+
+		switch {
+		case runErr == nil:
+		case isEmptyResultsExit(runErr):
+			empty++
+		default:
+			failed++
+			if jsonMode {
+				msg, _ := json.Marshal(map[string]string{"error": errfmt.Format(runErr)})
+				results[email] = msg
+			}
+			u.Err().Error(fmt.Sprintf("%s: %s", email, errfmt.Format(runErr)))
+		}
+	}
+	flags.Account = ""
+
+	if jsonMode {
+		// --fields was already applied to each account's payload.
+		if err := outfmt.WriteJSONContext(outfmt.WithFields(ctx, nil), os.Stdout, results); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d account(s) failed", failed, len(emails))
+	}
+	return failEmptyExit(empty == len(emails))
+}
+
+func isEmptyResultsExit(err error) bool {
+	var exitErr *ExitError
+	return errors.As(err, &exitErr) && exitErr.Code == emptyResultsExitCode
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+func stubAllAccountsTasks(t *testing.T) {
+	t.Helper()
+	origOpen, origNew := openSecretsStore, newTasksService
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		newTasksService = origNew
+	})
+
+	store := newMemStore()
+	for _, email := range []string{"b@example.com", "a@example.com"} {
+		if err := store.SetToken(config.DefaultClientName, email, secrets.Token{Email: email, RefreshToken: "rt"}); err != nil {
+			t.Fatalf("SetToken: %v", err)
+		}
+	}
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	// Each account gets its own endpoint prefix; b@example.com fails.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/a@example.com/") {
+			http.Error(w, `{"error":{"code":403,"message":"forbidden"}}`, http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{"id": "l1", "title": "Inbox"}}})
+	}))
+	t.Cleanup(srv.Close)
+
+	newTasksService = func(ctx context.Context, email string) (*tasks.Service, error) {
+		return tasks.NewService(ctx,
+			option.WithoutAuthentication(),
+			option.WithHTTPClient(srv.Client()),
+			option.WithEndpoint(srv.URL+"/"+email+"/"),
+		)
+	}
+}
+
+func TestExecute_AllAccounts_JSON(t *testing.T) {
+	stubAllAccountsTasks(t)
+
+	var runErr error
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			runErr = Execute([]string{"--json", "--all-accounts", "tasks", "lists"})
+		})
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "1 of 2 account(s) failed") {
+		t.Fatalf("expected partial failure, got %v", runErr)
+	}
+
+	var parsed map[string]map[string]any
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	lists, ok := parsed["a@example.com"]["tasklists"].([]any)
+	if !ok || len(lists) != 1 {
+		t.Fatalf("unexpected a@example.com result: %#v", parsed["a@example.com"])
+	}
+	if _, ok := parsed["b@example.com"]["error"]; !ok {
+		t.Fatalf("expected error entry for b@example.com: %#v", parsed)
+	}
+}
+
+func TestExecute_AllAccounts_TextHeaders(t *testing.T) {
+	stubAllAccountsTasks(t)
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			_ = Execute([]string{"--all-accounts", "tasks", "lists"})
+		})
+	})
+	a := strings.Index(out, "=== a@example.com ===")
+	b := strings.Index(out, "=== b@example.com ===")
+	if a < 0 || b < a || !strings.Contains(out[a:b], "Inbox") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestExecute_AllAccounts_RejectsMutations(t *testing.T) {
+	stubAllAccountsTasks(t)
+
+	for _, args := range [][]string{
+		{"--all-accounts", "tasks", "lists", "create", "New"},
+		{"--all-accounts", "--account", "a@example.com", "tasks", "lists"},
+	} {
+		var runErr error
+		_ = captureStderr(t, func() {
+			runErr = Execute(args)
+		})
+		if runErr == nil {
+			t.Fatalf("expected usage error for %v", args)
+		}
+	}
+}
+
+func TestExecute_AllAccounts_RejectsNDJSON(t *testing.T) {
+	stubAllAccountsTasks(t)
+
+	var runErr error
+	errOut := captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			runErr = Execute([]string{"--all-accounts", "--ndjson", "tasks", "lists"})
+		})
+	})
+	if ExitCode(runErr) != 2 {
+		t.Fatalf("expected usage exit 2, got %v", runErr)
+	}
+	if !strings.Contains(errOut, "--ndjson") {
+		t.Fatalf("unexpected stderr: %q", errOut)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/steipete/gogcli/internal/authclient"
@@ -56,6 +58,9 @@ type AuthCmd struct {
 	List        AuthListCmd           `cmd:"" name:"list" help:"List stored accounts"`
 	Aliases     AuthAliasCmd          `cmd:"" name:"alias" help:"Manage account aliases"`
 	Status      AuthStatusCmd         `cmd:"" name:"status" help:"Show auth configuration and keyring backend"`
+	Whoami      AuthWhoamiCmd         `cmd:"" name:"whoami" help:"Show the account, client, and scopes commands would use"`
+	HasScope    AuthHasScopeCmd       `cmd:"" name:"has-scope" help:"Exit 0 if the account's token grants a scope, 1 otherwise"`
+	Refresh     AuthRefreshCmd        `cmd:"" name:"refresh" help:"Exchange the refresh token now and cache the access token"`
 	Keyring     AuthKeyringCmd        `cmd:"" name:"keyring" help:"Configure keyring backend"`
 	Remove      AuthRemoveCmd         `cmd:"" name:"remove" help:"Remove a stored refresh token"`
 	Tokens      AuthTokensCmd         `cmd:"" name:"tokens" help:"Manage stored refresh tokens"`
@@ -67,6 +72,7 @@ type AuthCmd struct {
 type AuthCredentialsCmd struct {
 	Set  AuthCredentialsSetCmd  `cmd:"" default:"withargs" help:"Store OAuth client credentials"`
 	List AuthCredentialsListCmd `cmd:"" name:"list" help:"List stored OAuth client credentials"`
+	Map  AuthCredentialsMapCmd  `cmd:"" name:"map" help:"Show or set which OAuth client each account uses"`
 }
 
 type AuthCredentialsSetCmd struct {
@@ -120,7 +126,7 @@ func (c *AuthCredentialsSetCmd) Run(ctx context.Context) error {
 		}
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"saved":  true,
 			"path":   outPath,
 			"client": client,
@@ -192,14 +198,14 @@ func (c *AuthCredentialsListCmd) Run(ctx context.Context) error {
 
 	if len(entries) == 0 {
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"clients": []entry{}})
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"clients": []entry{}})
 		}
 		u.Err().Println("No OAuth client credentials stored")
 		return nil
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"clients": entries})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"clients": entries})
 	}
 
 	w, done := tableWriter(ctx)
@@ -214,7 +220,7 @@ func (c *AuthCredentialsListCmd) Run(ctx context.Context) error {
 type AuthTokensCmd struct {
 	List   AuthTokensListCmd   `cmd:"" name:"list" help:"List stored tokens (by key only)"`
 	Delete AuthTokensDeleteCmd `cmd:"" name:"delete" help:"Delete a stored refresh token"`
-	Export AuthTokensExportCmd `cmd:"" name:"export" help:"Export a refresh token to a file (contains secrets)"`
+	Export AuthTokensExportCmd `cmd:"" name:"export" help:"Export refresh tokens to a file (contains secrets)"`
 	Import AuthTokensImportCmd `cmd:"" name:"import" help:"Import a refresh token file into keyring (contains secrets)"`
 }
 
@@ -241,13 +247,13 @@ func (c *AuthTokensListCmd) Run(ctx context.Context) error {
 
 	if len(filtered) == 0 {
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"keys": []string{}})
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"keys": []string{}})
 		}
 		u.Err().Println("No tokens stored")
 		return nil
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"keys": filtered})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"keys": filtered})
 	}
 	for _, k := range filtered {
 		u.Out().Println(k)
@@ -261,7 +267,10 @@ type AuthTokensDeleteCmd struct {
 
 func (c *AuthTokensDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
-	email := strings.TrimSpace(c.Email)
+	email, err := resolveAccountEmail(c.Email)
+	if err != nil {
+		return err
+	}
 	if email == "" {
 		return usage("empty email")
 	}
@@ -282,7 +291,7 @@ func (c *AuthTokensDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted": true,
 			"email":   email,
 			"client":  client,
@@ -295,22 +304,54 @@ func (c *AuthTokensDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type AuthTokensExportCmd struct {
-	Email     string                 `arg:"" name:"email" help:"Email"`
+	Email     string                 `arg:"" name:"email" optional:"" help:"Email (omit with --all)"`
+	All       bool                   `name:"all" help:"Export every stored token as a JSON array"`
 	Output    OutputPathRequiredFlag `embed:""`
 	Overwrite bool                   `name:"overwrite" help:"Overwrite output file if it exists"`
 }
 
+// tokenExport is the on-disk shape for `auth tokens export` / `import`.
+type tokenExport struct {
+	Email        string   `json:"email"`
+	Client       string   `json:"client,omitempty"`
+	Services     []string `json:"services,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`
+	CreatedAt    string   `json:"created_at,omitempty"`
+	RefreshToken string   `json:"refresh_token"`
+}
+
+func newTokenExport(tok secrets.Token, client string) tokenExport {
+	created := ""
+	if !tok.CreatedAt.IsZero() {
+		created = tok.CreatedAt.UTC().Format(time.RFC3339)
+	}
+	return tokenExport{
+		Email:        tok.Email,
+		Client:       client,
+		Services:     tok.Services,
+		Scopes:       tok.Scopes,
+		CreatedAt:    created,
+		RefreshToken: tok.RefreshToken,
+	}
+}
+
 func (c *AuthTokensExportCmd) Run(ctx context.Context) error {
 	u := ui.FromContext(ctx)
-	email := strings.TrimSpace(c.Email)
-	if email == "" {
+	email, err := resolveAccountEmail(c.Email)
+	if err != nil {
+		return err
+	}
+	if c.All && email != "" {
+		return usage("use either <email> or --all, not both")
+	}
+	if !c.All && email == "" {
 		return usage("empty email")
 	}
 	outPath := strings.TrimSpace(c.Output.Path)
 	if outPath == "" {
 		return usage("empty outPath")
 	}
-	outPath, err := config.ExpandPath(outPath)
+	outPath, err = config.ExpandPath(outPath)
 	if err != nil {
 		return err
 	}
@@ -319,13 +360,40 @@ func (c *AuthTokensExportCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	client, err := resolveClientForEmailWithContext(ctx, email, "")
-	if err != nil {
-		return err
-	}
-	tok, err := store.GetToken(client, email)
-	if err != nil {
-		return err
+
+	var payload any
+	var single tokenExport
+	var all []tokenExport
+	if c.All {
+		tokens, listErr := store.ListTokens()
+		if listErr != nil {
+			return listErr
+		}
+		all = make([]tokenExport, 0, len(tokens))
+		for _, tok := range tokens {
+			if strings.TrimSpace(tok.Email) == "" {
+				continue
+			}
+			all = append(all, newTokenExport(tok, tok.Client))
+		}
+		sort.Slice(all, func(i, j int) bool {
+			if all[i].Client != all[j].Client {
+				return all[i].Client < all[j].Client
+			}
+			return all[i].Email < all[j].Email
+		})
+		payload = all
+	} else {
+		client, clientErr := resolveClientForEmailWithContext(ctx, email, "")
+		if clientErr != nil {
+			return clientErr
+		}
+		tok, getErr := store.GetToken(client, email)
+		if getErr != nil {
+			return getErr
+		}
+		single = newTokenExport(tok, client)
+		payload = single
 	}
 
 	if mkErr := os.MkdirAll(filepath.Dir(outPath), 0o700); mkErr != nil {
@@ -342,51 +410,46 @@ func (c *AuthTokensExportCmd) Run(ctx context.Context) error {
 	}
 	defer func() { _ = f.Close() }()
 
-	type export struct {
-		Email        string   `json:"email"`
-		Client       string   `json:"client,omitempty"`
-		Services     []string `json:"services,omitempty"`
-		Scopes       []string `json:"scopes,omitempty"`
-		CreatedAt    string   `json:"created_at,omitempty"`
-		RefreshToken string   `json:"refresh_token"`
-	}
-	created := ""
-	if !tok.CreatedAt.IsZero() {
-		created = tok.CreatedAt.UTC().Format(time.RFC3339)
-	}
-
 	enc := json.NewEncoder(f)
 	enc.SetEscapeHTML(false)
 	enc.SetIndent("", "  ")
-	if encErr := enc.Encode(export{
-		Email:        tok.Email,
-		Client:       client,
-		Services:     tok.Services,
-		Scopes:       tok.Scopes,
-		CreatedAt:    created,
-		RefreshToken: tok.RefreshToken,
-	}); encErr != nil {
+	if encErr := enc.Encode(payload); encErr != nil {
 		return encErr
 	}
 
+	if c.All {
+		u.Err().Println("WARNING: exported file contains refresh tokens (keep it safe and delete it when done)")
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+				"exported": true,
+				"count":    len(all),
+				"path":     outPath,
+			})
+		}
+		u.Out().Printf("exported\ttrue")
+		u.Out().Printf("count\t%d", len(all))
+		u.Out().Printf("path\t%s", outPath)
+		return nil
+	}
+
 	u.Err().Println("WARNING: exported file contains a refresh token (keep it safe and delete it when done)")
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"exported": true,
-			"email":    tok.Email,
-			"client":   client,
+			"email":    single.Email,
+			"client":   single.Client,
 			"path":     outPath,
 		})
 	}
 	u.Out().Printf("exported\ttrue")
-	u.Out().Printf("email\t%s", tok.Email)
-	u.Out().Printf("client\t%s", client)
+	u.Out().Printf("email\t%s", single.Email)
+	u.Out().Printf("client\t%s", single.Client)
 	u.Out().Printf("path\t%s", outPath)
 	return nil
 }
 
 type AuthTokensImportCmd struct {
-	InPath string `arg:"" name:"inPath" help:"Input path or '-' for stdin"`
+	InPath string `arg:"" name:"inPath" help:"Input path or '-' for stdin (single token object or array from export --all)"`
 }
 
 func (c *AuthTokensImportCmd) Run(ctx context.Context) error {
@@ -407,40 +470,63 @@ func (c *AuthTokensImportCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	type export struct {
-		Email        string   `json:"email"`
-		Client       string   `json:"client,omitempty"`
-		Services     []string `json:"services,omitempty"`
-		Scopes       []string `json:"scopes,omitempty"`
-		CreatedAt    string   `json:"created_at,omitempty"`
-		RefreshToken string   `json:"refresh_token"`
-	}
-	var ex export
-	if unmarshalErr := json.Unmarshal(b, &ex); unmarshalErr != nil {
-		return unmarshalErr
-	}
-	ex.Email = strings.TrimSpace(ex.Email)
-	if ex.Email == "" {
-		return usage("missing email in token file")
-	}
-	if strings.TrimSpace(ex.RefreshToken) == "" {
-		return usage("missing refresh_token in token file")
-	}
-	clientOverride := authclient.ClientOverrideFromContext(ctx)
-	if strings.TrimSpace(clientOverride) == "" {
-		clientOverride = strings.TrimSpace(ex.Client)
+	var entries []tokenExport
+	isArray := strings.HasPrefix(strings.TrimSpace(string(b)), "[")
+	if isArray {
+		if unmarshalErr := json.Unmarshal(b, &entries); unmarshalErr != nil {
+			return unmarshalErr
+		}
+	} else {
+		var ex tokenExport
+		if unmarshalErr := json.Unmarshal(b, &ex); unmarshalErr != nil {
+			return unmarshalErr
+		}
+		entries = []tokenExport{ex}
 	}
-	client, err := resolveClientForEmailWithContext(ctx, ex.Email, clientOverride)
-	if err != nil {
-		return err
+
+	type prepared struct {
+		client string
+		token  secrets.Token
 	}
-	var createdAt time.Time
-	if strings.TrimSpace(ex.CreatedAt) != "" {
-		parsed, parseErr := time.Parse(time.RFC3339, strings.TrimSpace(ex.CreatedAt))
-		if parseErr != nil {
-			return parseErr
+	toStore := make([]prepared, 0, len(entries))
+	for _, ex := range entries {
+		ex.Email = strings.TrimSpace(ex.Email)
+		if ex.Email == "" {
+			if isArray {
+				continue
+			}
+			return usage("missing email in token file")
+		}
+		if strings.TrimSpace(ex.RefreshToken) == "" {
+			return usagef("missing refresh_token in token file for %s", ex.Email)
 		}
-		createdAt = parsed
+		clientOverride := authclient.ClientOverrideFromContext(ctx)
+		if strings.TrimSpace(clientOverride) == "" {
+			clientOverride = strings.TrimSpace(ex.Client)
+		}
+		client, clientErr := resolveClientForEmailWithContext(ctx, ex.Email, clientOverride)
+		if clientErr != nil {
+			return clientErr
+		}
+		var createdAt time.Time
+		if strings.TrimSpace(ex.CreatedAt) != "" {
+			parsed, parseErr := time.Parse(time.RFC3339, strings.TrimSpace(ex.CreatedAt))
+			if parseErr != nil {
+				return parseErr
+			}
+			createdAt = parsed
+		}
+		toStore = append(toStore, prepared{client: client, token: secrets.Token{
+			Client:       client,
+			Email:        ex.Email,
+			Services:     ex.Services,
+			Scopes:       ex.Scopes,
+			CreatedAt:    createdAt,
+			RefreshToken: ex.RefreshToken,
+		}})
+	}
+	if len(toStore) == 0 {
+		return usage("no tokens in token file")
 	}
 
 	// Pre-flight: ensure keychain is accessible before storing token
@@ -453,38 +539,60 @@ func (c *AuthTokensImportCmd) Run(ctx context.Context) error {
 		return err
 	}
 
-	if err := store.SetToken(client, ex.Email, secrets.Token{
-		Client:       client,
-		Email:        ex.Email,
-		Services:     ex.Services,
-		Scopes:       ex.Scopes,
-		CreatedAt:    createdAt,
-		RefreshToken: ex.RefreshToken,
-	}); err != nil {
-		return err
+	for _, p := range toStore {
+		if err := store.SetToken(p.client, p.token.Email, p.token); err != nil {
+			return err
+		}
+	}
+
+	if isArray {
+		u.Err().Printf("Imported %d refresh tokens into keyring", len(toStore))
+		accounts := make([]map[string]string, 0, len(toStore))
+		for _, p := range toStore {
+			accounts = append(accounts, map[string]string{"email": p.token.Email, "client": p.client})
+		}
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+				"imported": true,
+				"count":    len(toStore),
+				"accounts": accounts,
+			})
+		}
+		u.Out().Printf("imported\ttrue")
+		u.Out().Printf("count\t%d", len(toStore))
+		for _, p := range toStore {
+			u.Out().Printf("%s\t%s", p.token.Email, p.client)
+		}
+		return nil
 	}
 
+	imported := toStore[0]
 	u.Err().Println("Imported refresh token into keyring")
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"imported": true,
-			"email":    ex.Email,
-			"client":   client,
+			"email":    imported.token.Email,
+			"client":   imported.client,
 		})
 	}
 	u.Out().Printf("imported\ttrue")
-	u.Out().Printf("email\t%s", ex.Email)
-	u.Out().Printf("client\t%s", client)
+	u.Out().Printf("email\t%s", imported.token.Email)
+	u.Out().Printf("client\t%s", imported.client)
 	return nil
 }
 
 type AuthAddCmd struct {
-	Email        string `arg:"" name:"email" help:"Email"`
-	Manual       bool   `name:"manual" help:"Browserless auth flow (paste redirect URL)"`
-	ForceConsent bool   `name:"force-consent" help:"Force consent screen to obtain a refresh token"`
-	ServicesCSV  string `name:"services" help:"Services to authorize: user|all or comma-separated ${auth_services} (Keep uses service account: gog auth service-account set)" default:"user"`
-	Readonly     bool   `name:"readonly" help:"Use read-only scopes where available (still includes OIDC identity scopes)"`
-	DriveScope   string `name:"drive-scope" help:"Drive scope mode: full|readonly|file" enum:"full,readonly,file" default:"full"`
+	Email        string        `arg:"" name:"email" help:"Email"`
+	Manual       bool          `name:"manual" help:"Browserless auth flow (paste redirect URL)"`
+	Device       bool          `name:"device" help:"Device-code flow for headless machines (requires a TVs and Limited Input OAuth client)"`
+	ForceConsent bool          `name:"force-consent" help:"Force consent screen to obtain a refresh token"`
+	ServicesCSV  string        `name:"services" help:"Services to authorize: user|all or comma-separated ${auth_services} (Keep uses service account: gog auth service-account set)" default:"user"`
+	Readonly     bool          `name:"readonly" help:"Use read-only scopes where available (still includes OIDC identity scopes)"`
+	DriveScope   string        `name:"drive-scope" help:"Drive scope mode: full|readonly|file" enum:"full,readonly,file" default:"full"`
+	ScopesRaw    string        `name:"scopes-raw" help:"Extra comma-separated OAuth scope URLs to request (merged with --services scopes)"`
+	ScopesOnly   bool          `name:"scopes-raw-only" help:"Request only --scopes-raw (plus identity scopes) instead of the --services scopes"`
+	Timeout      time.Duration `name:"timeout" help:"Authorization timeout (default: 2m, or 10m with --device)"`
+	NoVerify     bool          `name:"no-verify" help:"Store the token even if Google authorized a different email than requested (stored under the authorized email)"`
 }
 
 func (c *AuthAddCmd) Run(ctx context.Context) error {
@@ -496,6 +604,10 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 		return err
 	}
 
+	if c.Manual && c.Device {
+		return usage("--manual and --device are mutually exclusive")
+	}
+
 	services, err := parseAuthServices(c.ServicesCSV)
 	if err != nil {
 		return err
@@ -514,6 +626,19 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	rawScopes := splitCSV(c.ScopesRaw)
+	if c.ScopesOnly {
+		if len(rawScopes) == 0 {
+			return usage("--scopes-raw-only requires --scopes-raw")
+		}
+		services = nil
+	}
+	if len(rawScopes) > 0 {
+		scopes, err = googleauth.MergeRawScopes(scopes, rawScopes, c.ScopesOnly)
+		if err != nil {
+			return usage(err.Error())
+		}
+	}
 
 	// Pre-flight: ensure keychain is accessible before starting OAuth
 	if keychainErr := ensureKeychainAccessIfNeeded(); keychainErr != nil {
@@ -524,7 +649,9 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 		Services:     services,
 		Scopes:       scopes,
 		Manual:       c.Manual,
+		DeviceFlow:   c.Device,
 		ForceConsent: c.ForceConsent,
+		Timeout:      c.Timeout,
 		Client:       client,
 	})
 	if err != nil {
@@ -536,7 +663,10 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 		return fmt.Errorf("fetch authorized email: %w", err)
 	}
 	if normalizeEmail(authorizedEmail) != normalizeEmail(c.Email) {
-		return fmt.Errorf("authorized as %s, expected %s", authorizedEmail, c.Email)
+		if !c.NoVerify {
+			return fmt.Errorf("authorized as %s, expected %s (use --no-verify to store it anyway)", authorizedEmail, c.Email)
+		}
+		u.Err().Printf("Warning: authorized as %s, not %s; storing the token under %s", authorizedEmail, c.Email, authorizedEmail)
 	}
 
 	store, err := openSecretsStore()
@@ -571,7 +701,7 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 		}
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"stored":   true,
 			"email":    authorizedEmail,
 			"services": serviceNames,
@@ -585,8 +715,56 @@ func (c *AuthAddCmd) Run(ctx context.Context) error {
 }
 
 type AuthListCmd struct {
-	Check   bool          `name:"check" help:"Verify refresh tokens by exchanging for an access token (requires credentials.json)"`
-	Timeout time.Duration `name:"timeout" help:"Per-token check timeout" default:"15s"`
+	Check       bool          `name:"check" help:"Verify refresh tokens by exchanging for an access token (requires credentials.json)"`
+	Timeout     time.Duration `name:"timeout" help:"Per-token check timeout" default:"15s"`
+	Concurrency int           `name:"concurrency" help:"Max tokens to check in parallel (with --check)" default:"5"`
+	Service     string        `name:"service" help:"Only accounts whose token is authorized for this service (e.g. gmail)"`
+}
+
+func (*AuthListCmd) csvTable() {}
+
+// parseAuthListService validates --service, listing the supported services
+// on error.
+func parseAuthListService(raw string) (googleauth.Service, error) {
+	svc, err := googleauth.ParseService(raw)
+	if err == nil {
+		return svc, nil
+	}
+	infos := googleauth.ServicesInfo()
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, string(info.Service))
+	}
+	return "", usagef("unknown service %q (supported: %s)", strings.TrimSpace(raw), strings.Join(names, ", "))
+}
+
+// tokenHasService reports whether the token was authorized for svc.
+func tokenHasService(tok *secrets.Token, svc googleauth.Service) bool {
+	if tok == nil {
+		return false
+	}
+	for _, s := range tok.Services {
+		if strings.EqualFold(strings.TrimSpace(s), string(svc)) {
+			return true
+		}
+	}
+	return false
+}
+
+// serviceAccountHasService reports whether a stored service account key can
+// serve svc: the generic key impersonates for every service, Keep-only keys
+// just for Keep.
+func serviceAccountHasService(email string, svc googleauth.Service) bool {
+	if p, err := config.ServiceAccountPath(email); err == nil {
+		if _, statErr := os.Stat(p); statErr == nil {
+			return true
+		}
+	}
+	if svc != googleauth.ServiceKeep {
+		return false
+	}
+	_, _, ok := bestServiceAccountPathAndMtime(email)
+	return ok
 }
 
 type AuthStatusCmd struct{}
@@ -606,43 +784,13 @@ func (c *AuthStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	account := ""
-	authPreferred := ""
-	serviceAccountConfigured := false
-	serviceAccountPath := ""
-	client := ""
-	credentialsPath := ""
-	credentialsExists := false
-
-	if flags != nil {
-		if a, err := requireAccount(flags); err == nil {
-			account = a
-			resolvedClient, resolveErr := resolveClientForEmail(account, flags, "")
-			if resolveErr != nil {
-				return resolveErr
-			}
-			client = resolvedClient
-			path, pathErr := config.ClientCredentialsPathFor(client)
-			if pathErr == nil {
-				credentialsPath = path
-				if st, statErr := os.Stat(path); statErr == nil && !st.IsDir() {
-					credentialsExists = true
-				}
-			}
-			if p, _, ok := bestServiceAccountPathAndMtime(normalizeEmail(account)); ok {
-				serviceAccountConfigured = true
-				serviceAccountPath = p
-			}
-			if serviceAccountConfigured {
-				authPreferred = authTypeServiceAccount
-			} else {
-				authPreferred = authTypeOAuth
-			}
-		}
+	acct, err := resolveAuthAccountStatus(flags)
+	if err != nil {
+		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"config": map[string]any{
 				"path":   configPath,
 				"exists": configExists,
@@ -651,39 +799,103 @@ func (c *AuthStatusCmd) Run(ctx context.Context, flags *RootFlags) error {
 				"backend": backendInfo.Value,
 				"source":  backendInfo.Source,
 			},
-			"account": map[string]any{
-				"email":                      account,
-				"client":                     client,
-				"credentials_path":           credentialsPath,
-				"credentials_exists":         credentialsExists,
-				"auth_preferred":             authPreferred,
-				"service_account_configured": serviceAccountConfigured,
-				"service_account_path":       serviceAccountPath,
-			},
+			"account": acct.jsonMap(),
 		})
 	}
 	u.Out().Printf("config_path\t%s", configPath)
 	u.Out().Printf("config_exists\t%t", configExists)
 	u.Out().Printf("keyring_backend\t%s", backendInfo.Value)
 	u.Out().Printf("keyring_backend_source\t%s", backendInfo.Source)
-	if account != "" {
-		u.Out().Printf("account\t%s", account)
-		u.Out().Printf("client\t%s", client)
-		if credentialsPath != "" {
-			u.Out().Printf("credentials_path\t%s", credentialsPath)
+	if acct.Email != "" {
+		acct.print(u)
+	}
+	return nil
+}
+
+// authAccountStatus describes how the active account would authenticate.
+type authAccountStatus struct {
+	Email                    string
+	Client                   string
+	CredentialsPath          string
+	CredentialsExists        bool
+	AuthPreferred            string
+	ServiceAccountConfigured bool
+	ServiceAccountPath       string
+}
+
+// resolveAuthAccountStatus resolves the account from flags/config. A missing
+// account is not an error; the returned status is then empty.
+func resolveAuthAccountStatus(flags *RootFlags) (authAccountStatus, error) {
+	var st authAccountStatus
+	account := ""
+	if flags != nil {
+		if a, err := requireAccount(flags); err == nil {
+			account = a
 		}
-		u.Out().Printf("credentials_exists\t%t", credentialsExists)
-		u.Out().Printf("auth_preferred\t%s", authPreferred)
-		u.Out().Printf("service_account_configured\t%t", serviceAccountConfigured)
-		if serviceAccountPath != "" {
-			u.Out().Printf("service_account_path\t%s", serviceAccountPath)
+	}
+	if account == "" {
+		return st, nil
+	}
+	st.Email = account
+	client, err := resolveClientForEmail(account, flags, "")
+	if err != nil {
+		return st, err
+	}
+	st.Client = client
+	if path, pathErr := config.ClientCredentialsPathFor(client); pathErr == nil {
+		st.CredentialsPath = path
+		if fi, statErr := os.Stat(path); statErr == nil && !fi.IsDir() {
+			st.CredentialsExists = true
 		}
 	}
-	return nil
+	if p, _, ok := bestServiceAccountPathAndMtime(normalizeEmail(account)); ok {
+		st.ServiceAccountConfigured = true
+		st.ServiceAccountPath = p
+	}
+	if st.ServiceAccountConfigured {
+		st.AuthPreferred = authTypeServiceAccount
+	} else {
+		st.AuthPreferred = authTypeOAuth
+	}
+	return st, nil
+}
+
+func (st authAccountStatus) jsonMap() map[string]any {
+	return map[string]any{
+		"email":                      st.Email,
+		"client":                     st.Client,
+		"credentials_path":           st.CredentialsPath,
+		"credentials_exists":         st.CredentialsExists,
+		"auth_preferred":             st.AuthPreferred,
+		"service_account_configured": st.ServiceAccountConfigured,
+		"service_account_path":       st.ServiceAccountPath,
+	}
+}
+
+func (st authAccountStatus) print(u *ui.UI) {
+	u.Out().Printf("account\t%s", st.Email)
+	u.Out().Printf("client\t%s", st.Client)
+	if st.CredentialsPath != "" {
+		u.Out().Printf("credentials_path\t%s", st.CredentialsPath)
+	}
+	u.Out().Printf("credentials_exists\t%t", st.CredentialsExists)
+	u.Out().Printf("auth_preferred\t%s", st.AuthPreferred)
+	u.Out().Printf("service_account_configured\t%t", st.ServiceAccountConfigured)
+	if st.ServiceAccountPath != "" {
+		u.Out().Printf("service_account_path\t%s", st.ServiceAccountPath)
+	}
 }
 
 func (c *AuthListCmd) Run(ctx context.Context) error {
 	u := ui.FromContext(ctx)
+	var serviceFilter googleauth.Service
+	if strings.TrimSpace(c.Service) != "" {
+		svc, err := parseAuthListService(c.Service)
+		if err != nil {
+			return err
+		}
+		serviceFilter = svc
+	}
 	store, err := openSecretsStore()
 	if err != nil {
 		return err
@@ -750,20 +962,39 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 		entries = append(entries, entry{Email: email, Token: &t2, SA: false})
 	}
 	sort.Slice(entries, func(i, j int) bool { return entries[i].Email < entries[j].Email })
+	if serviceFilter != "" {
+		filtered := entries[:0]
+		for _, e := range entries {
+			if tokenHasService(e.Token, serviceFilter) || (e.SA && serviceAccountHasService(e.Email, serviceFilter)) {
+				filtered = append(filtered, e)
+			}
+		}
+		entries = filtered
+	}
+
+	var checkErrs []error
+	if c.Check {
+		toks := make([]*secrets.Token, len(entries))
+		for i, e := range entries {
+			toks[i] = e.Token
+		}
+		checkErrs = checkRefreshTokens(ctx, toks, c.Concurrency, c.Timeout)
+	}
 
 	if outfmt.IsJSON(ctx) {
 		type item struct {
-			Email     string   `json:"email"`
-			Client    string   `json:"client,omitempty"`
-			Services  []string `json:"services,omitempty"`
-			Scopes    []string `json:"scopes,omitempty"`
-			CreatedAt string   `json:"created_at,omitempty"`
-			Auth      string   `json:"auth"`
-			Valid     *bool    `json:"valid,omitempty"`
-			Error     string   `json:"error,omitempty"`
+			Email      string   `json:"email"`
+			Client     string   `json:"client,omitempty"`
+			Services   []string `json:"services,omitempty"`
+			Scopes     []string `json:"scopes,omitempty"`
+			CreatedAt  string   `json:"created_at,omitempty"`
+			LastUsedAt string   `json:"last_used_at,omitempty"`
+			Auth       string   `json:"auth"`
+			Valid      *bool    `json:"valid,omitempty"`
+			Error      string   `json:"error,omitempty"`
 		}
 		out := make([]item, 0, len(entries))
-		for _, e := range entries {
+		for i, e := range entries {
 			auth := authTypeOAuth
 			if e.SA {
 				auth = authTypeServiceAccount
@@ -773,6 +1004,7 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 			}
 
 			created := ""
+			lastUsed := ""
 			services := []string(nil)
 			scopes := []string(nil)
 
@@ -780,6 +1012,9 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 				if !e.Token.CreatedAt.IsZero() {
 					created = e.Token.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00")
 				}
+				if !e.Token.LastUsedAt.IsZero() {
+					lastUsed = e.Token.LastUsedAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+				}
 				services = e.Token.Services
 				scopes = e.Token.Scopes
 			} else if e.SA {
@@ -791,12 +1026,13 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 			}
 
 			it := item{
-				Email:     e.Email,
-				Client:    "",
-				Services:  services,
-				Scopes:    scopes,
-				CreatedAt: created,
-				Auth:      auth,
+				Email:      e.Email,
+				Client:     "",
+				Services:   services,
+				Scopes:     scopes,
+				CreatedAt:  created,
+				LastUsedAt: lastUsed,
+				Auth:       auth,
 			}
 			if e.Token != nil {
 				it.Client = e.Token.Client
@@ -807,7 +1043,7 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 					it.Valid = &valid
 					it.Error = "service account (not checked)"
 				} else {
-					err := checkRefreshToken(ctx, e.Token.Client, e.Token.RefreshToken, e.Token.Scopes, c.Timeout)
+					err := checkErrs[i]
 					valid := err == nil
 					it.Valid = &valid
 					if err != nil {
@@ -817,14 +1053,23 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 			}
 			out = append(out, it)
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"accounts": out})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"accounts": out})
 	}
 	if len(entries) == 0 {
+		if serviceFilter != "" {
+			u.Err().Printf("No accounts authorized for %s", serviceFilter)
+			return nil
+		}
 		u.Err().Println("No tokens stored")
 		return nil
 	}
 
-	for _, e := range entries {
+	header := []string{"EMAIL", "CLIENT", "SERVICES", "CREATED", "AUTH", "LAST_USED"}
+	if c.Check {
+		header = []string{"EMAIL", "CLIENT", "SERVICES", "CREATED", "VALID", "ERROR", "AUTH", "LAST_USED"}
+	}
+	rows := make([][]string, 0, len(entries))
+	for i, e := range entries {
 		auth := authTypeOAuth
 		if e.SA {
 			auth = authTypeServiceAccount
@@ -838,12 +1083,16 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 			client = e.Token.Client
 		}
 		created := ""
+		lastUsed := ""
 		servicesCSV := ""
 
 		if e.Token != nil {
 			if !e.Token.CreatedAt.IsZero() {
 				created = e.Token.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00")
 			}
+			if !e.Token.LastUsedAt.IsZero() {
+				lastUsed = e.Token.LastUsedAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+			}
 			servicesCSV = strings.Join(e.Token.Services, ",")
 		} else if e.SA {
 			if _, mtime, ok := bestServiceAccountPathAndMtime(e.Email); ok {
@@ -854,25 +1103,65 @@ func (c *AuthListCmd) Run(ctx context.Context) error {
 
 		if c.Check {
 			if e.Token == nil {
-				u.Out().Printf("%s\t%s\t%s\t%s\t%t\t%s\t%s", e.Email, client, servicesCSV, created, true, "service account (not checked)", auth)
+				rows = append(rows, []string{e.Email, client, servicesCSV, created, "true", "service account (not checked)", auth, lastUsed})
 				continue
 			}
 
-			err := checkRefreshToken(ctx, e.Token.Client, e.Token.RefreshToken, e.Token.Scopes, c.Timeout)
+			err := checkErrs[i]
 			valid := err == nil
 			msg := ""
 			if err != nil {
 				msg = err.Error()
 			}
-			u.Out().Printf("%s\t%s\t%s\t%s\t%t\t%s\t%s", e.Email, client, servicesCSV, created, valid, msg, auth)
+			rows = append(rows, []string{e.Email, client, servicesCSV, created, strconv.FormatBool(valid), msg, auth, lastUsed})
 			continue
 		}
 
-		u.Out().Printf("%s\t%s\t%s\t%s\t%s", e.Email, client, servicesCSV, created, auth)
+		rows = append(rows, []string{e.Email, client, servicesCSV, created, auth, lastUsed})
+	}
+
+	if outfmt.IsCSV(ctx) {
+		return outfmt.WriteCSV(os.Stdout, header, rows)
+	}
+	for _, row := range rows {
+		u.Out().Printf("%s", strings.Join(row, "\t"))
 	}
 	return nil
 }
 
+// checkRefreshTokens verifies tokens with a bounded worker pool. Results are
+// indexed like toks; nil entries (service accounts) are skipped.
+func checkRefreshTokens(ctx context.Context, toks []*secrets.Token, concurrency int, timeout time.Duration) []error {
+	errs := make([]error, len(toks))
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, tok := range toks {
+		if tok == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(idx int, tok *secrets.Token) {
+			defer wg.Done()
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[idx] = ctx.Err()
+				return
+			}
+
+			errs[idx] = checkRefreshToken(ctx, tok.Client, tok.RefreshToken, tok.Scopes, timeout)
+		}(i, tok)
+	}
+	wg.Wait()
+	return errs
+}
+
 func bestServiceAccountPathAndMtime(email string) (string, time.Time, bool) {
 	if p, err := config.ServiceAccountPath(email); err == nil {
 		if st, err := os.Stat(p); err == nil {
@@ -899,7 +1188,7 @@ type AuthServicesCmd struct {
 func (c *AuthServicesCmd) Run(ctx context.Context) error {
 	infos := googleauth.ServicesInfo()
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"services": infos})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"services": infos})
 	}
 	if c.Markdown {
 		_, err := io.WriteString(os.Stdout, googleauth.ServicesMarkdown(infos))
@@ -930,7 +1219,10 @@ type AuthRemoveCmd struct {
 
 func (c *AuthRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
-	email := strings.TrimSpace(c.Email)
+	email, err := resolveAccountEmail(c.Email)
+	if err != nil {
+		return err
+	}
 	if email == "" {
 		return usage("empty email")
 	}
@@ -950,7 +1242,7 @@ func (c *AuthRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted": true,
 			"email":   email,
 			"client":  client,
@@ -1034,7 +1326,7 @@ func (c *AuthKeepCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"stored": true,
 			"email":  email,
 			"path":   destPath,
@@ -228,6 +228,54 @@ func TestAuthAddCmd_EmailMismatch(t *testing.T) {
 	}
 }
 
+func TestAuthAddCmd_NoVerify(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	origKeychain := ensureKeychainAccess
+	origFetch := fetchAuthorizedEmail
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origKeychain
+		fetchAuthorizedEmail = origFetch
+	})
+
+	ensureKeychainAccess = func() error { return nil }
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	authorizeGoogle = func(context.Context, googleauth.AuthorizeOptions) (string, error) {
+		return "rt", nil
+	}
+	fetchAuthorizedEmail = func(context.Context, string, string, []string, time.Duration) (string, error) {
+		return "actual@example.com", nil
+	}
+
+	var out string
+	stderr := captureStderr(t, func() {
+		out = captureStdout(t, func() {
+			if err := Execute([]string{"--json", "auth", "add", "shared@example.com", "--services", "gmail", "--no-verify"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(stderr, "authorized as actual@example.com, not shared@example.com") {
+		t.Fatalf("expected mismatch warning, got %q", stderr)
+	}
+	if !strings.Contains(out, `"email": "actual@example.com"`) {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	tok, err := store.GetToken(config.DefaultClientName, "actual@example.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if tok.Email != "actual@example.com" {
+		t.Fatalf("token stored with email %q", tok.Email)
+	}
+	if _, err := store.GetToken(config.DefaultClientName, "shared@example.com"); err == nil {
+		t.Fatal("token should not be stored under the requested email")
+	}
+}
+
 func TestAuthAddCmd_ReadonlyScopes(t *testing.T) {
 	origAuth := authorizeGoogle
 	origOpen := openSecretsStore
@@ -481,3 +529,113 @@ func containsStringInSlice(items []string, want string) bool {
 	}
 	return false
 }
+
+func TestAuthAddCmd_Device(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	origKeychain := ensureKeychainAccess
+	origFetch := fetchAuthorizedEmail
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origKeychain
+		fetchAuthorizedEmail = origFetch
+	})
+
+	ensureKeychainAccess = func() error { return nil }
+	openSecretsStore = func() (secrets.Store, error) { return newMemSecretsStore(), nil }
+
+	var gotOpts googleauth.AuthorizeOptions
+	authorizeGoogle = func(_ context.Context, opts googleauth.AuthorizeOptions) (string, error) {
+		gotOpts = opts
+		return "rt", nil
+	}
+	fetchAuthorizedEmail = func(context.Context, string, string, []string, time.Duration) (string, error) {
+		return "other@example.com", nil
+	}
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			err := Execute([]string{"--json", "auth", "add", "user@example.com", "--device", "--timeout", "5m"})
+			if err == nil || !strings.Contains(err.Error(), "authorized as other@example.com") {
+				t.Fatalf("expected email mismatch error, got %v", err)
+			}
+		})
+	})
+	if !gotOpts.DeviceFlow || gotOpts.Manual || gotOpts.Timeout != 5*time.Minute {
+		t.Fatalf("unexpected options: %+v", gotOpts)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"auth", "add", "user@example.com", "--device", "--manual"}); err == nil {
+			t.Fatalf("expected error for --device with --manual")
+		}
+	})
+}
+
+func TestAuthAddCmd_ScopesRaw(t *testing.T) {
+	origAuth := authorizeGoogle
+	origOpen := openSecretsStore
+	origKeychain := ensureKeychainAccess
+	origFetch := fetchAuthorizedEmail
+	t.Cleanup(func() {
+		authorizeGoogle = origAuth
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origKeychain
+		fetchAuthorizedEmail = origFetch
+	})
+
+	ensureKeychainAccess = func() error { return nil }
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	var gotOpts googleauth.AuthorizeOptions
+	authorizeGoogle = func(_ context.Context, opts googleauth.AuthorizeOptions) (string, error) {
+		gotOpts = opts
+		return "rt", nil
+	}
+	fetchAuthorizedEmail = func(context.Context, string, string, []string, time.Duration) (string, error) {
+		return "user@example.com", nil
+	}
+
+	const extra = "https://www.googleapis.com/auth/cloud-platform"
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "add", "user@example.com", "--services", "drive", "--scopes-raw", extra + ",https://www.googleapis.com/auth/drive"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !containsStringInSlice(gotOpts.Scopes, extra) || !containsStringInSlice(gotOpts.Scopes, "https://www.googleapis.com/auth/drive") {
+		t.Fatalf("expected merged scopes, got %v", gotOpts.Scopes)
+	}
+	seen := map[string]int{}
+	for _, s := range gotOpts.Scopes {
+		seen[s]++
+		if seen[s] > 1 {
+			t.Fatalf("duplicate scope %q in %v", s, gotOpts.Scopes)
+		}
+	}
+	tok, err := store.GetToken(config.DefaultClientName, "user@example.com")
+	if err != nil || !containsStringInSlice(tok.Scopes, extra) {
+		t.Fatalf("expected stored raw scope, got %#v err=%v", tok, err)
+	}
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "add", "user@example.com", "--scopes-raw", extra, "--scopes-raw-only"}); err != nil {
+				t.Fatalf("Execute only: %v", err)
+			}
+		})
+	})
+	if containsStringInSlice(gotOpts.Scopes, "https://www.googleapis.com/auth/gmail.modify") || !containsStringInSlice(gotOpts.Scopes, "openid") {
+		t.Fatalf("expected only raw + identity scopes, got %v", gotOpts.Scopes)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"auth", "add", "user@example.com", "--scopes-raw", "drive"}); err == nil {
+			t.Fatalf("expected error for non-URL scope")
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type AuthCredentialsMapCmd struct {
+	Email  string `arg:"" optional:"" name:"email" help:"Account email (omit to list mappings)"`
+	Client string `arg:"" optional:"" name:"client" help:"OAuth client name the account should use"`
+	Clear  bool   `name:"clear" help:"Remove the account's explicit client mapping"`
+}
+
+type accountClientMapping struct {
+	Email       string `json:"email"`
+	Client      string `json:"client"`
+	Credentials bool   `json:"credentials"`
+}
+
+func (c *AuthCredentialsMapCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+	email := strings.ToLower(strings.TrimSpace(c.Email))
+	client := strings.TrimSpace(c.Client)
+
+	switch {
+	case email == "" && (client != "" || c.Clear):
+		return usage("missing email")
+	case c.Clear && client != "":
+		return usage("--clear cannot be combined with a client name")
+	case email != "" && client == "" && !c.Clear:
+		return usage("missing client (or use --clear)")
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return err
+	}
+
+	if email == "" {
+		mappings, err := accountClientMappings(cfg)
+		if err != nil {
+			return err
+		}
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"accounts": mappings})
+		}
+		if len(mappings) == 0 {
+			u.Err().Println("No account client mappings")
+			return nil
+		}
+		w, done := tableWriter(ctx)
+		defer done()
+		_, _ = fmt.Fprintln(w, "EMAIL\tCLIENT\tCREDENTIALS")
+		for _, m := range mappings {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%t\n", m.Email, m.Client, m.Credentials)
+		}
+		return nil
+	}
+
+	if c.Clear {
+		cleared := config.ClearAccountClient(&cfg, email)
+		if cleared {
+			if err := config.WriteConfig(cfg); err != nil {
+				return err
+			}
+		}
+		mappings, err := accountClientMappings(cfg)
+		if err != nil {
+			return err
+		}
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+				"email":    email,
+				"cleared":  cleared,
+				"accounts": mappings,
+			})
+		}
+		u.Out().Printf("email\t%s", email)
+		u.Out().Printf("cleared\t%t", cleared)
+		return nil
+	}
+
+	normalized, err := config.NormalizeClientNameOrDefault(client)
+	if err != nil {
+		return usage(err.Error())
+	}
+	exists, err := config.ClientCredentialsExists(normalized)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return usagef("no OAuth credentials stored for client %q (run: gog --client %s auth credentials <credentials.json>)", normalized, normalized)
+	}
+
+	if err := config.SetAccountClient(&cfg, email, normalized); err != nil {
+		return err
+	}
+	if err := config.WriteConfig(cfg); err != nil {
+		return err
+	}
+
+	mappings, err := accountClientMappings(cfg)
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"email":    email,
+			"client":   normalized,
+			"accounts": mappings,
+		})
+	}
+	u.Out().Printf("email\t%s", email)
+	u.Out().Printf("client\t%s", normalized)
+	return nil
+}
+
+// accountClientMappings returns the explicit account_clients entries sorted by
+// email, noting whether each client's credentials are stored.
+func accountClientMappings(cfg config.File) ([]accountClientMapping, error) {
+	out := make([]accountClientMapping, 0, len(cfg.AccountClients))
+	for email, raw := range cfg.AccountClients {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		client, err := config.NormalizeClientNameOrDefault(raw)
+		if err != nil {
+			// Keep invalid names visible so they can be fixed or cleared.
+			out = append(out, accountClientMapping{Email: email, Client: raw})
+			continue
+		}
+		exists, err := config.ClientCredentialsExists(client)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, accountClientMapping{Email: email, Client: client, Credentials: exists})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Email < out[j].Email })
+	return out, nil
+}
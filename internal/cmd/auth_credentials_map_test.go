@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func TestExecute_AuthCredentialsMap(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	dir, err := config.Dir()
+	if err != nil {
+		t.Fatalf("Dir: %v", err)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "credentials-work.json"), []byte(`{"installed":{"client_id":"id","client_secret":"sec"}}`), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	run := func(args ...string) (string, error) {
+		var runErr error
+		out := captureStdout(t, func() {
+			_ = captureStderr(t, func() {
+				runErr = Execute(append([]string{"--json", "auth", "credentials", "map"}, args...))
+			})
+		})
+		return out, runErr
+	}
+
+	// Unknown clients are rejected before the config is touched.
+	if _, err := run("you@company.com", "nope"); err == nil || !strings.Contains(err.Error(), "no OAuth credentials") {
+		t.Fatalf("expected missing client error, got %v", err)
+	}
+
+	out, err := run("You@Company.com", "Work")
+	if err != nil {
+		t.Fatalf("map: %v", err)
+	}
+	var set struct {
+		Email    string                 `json:"email"`
+		Client   string                 `json:"client"`
+		Accounts []accountClientMapping `json:"accounts"`
+	}
+	if err := json.Unmarshal([]byte(out), &set); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if set.Email != "you@company.com" || set.Client != "work" || len(set.Accounts) != 1 || !set.Accounts[0].Credentials {
+		t.Fatalf("unexpected set output: %#v", set)
+	}
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		t.Fatalf("ReadConfig: %v", err)
+	}
+	if got, ok := config.AccountClient(cfg, "you@company.com"); !ok || got != "work" {
+		t.Fatalf("AccountClient = %q,%v", got, ok)
+	}
+
+	out, err = run()
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if !strings.Contains(out, `"email": "you@company.com"`) || !strings.Contains(out, `"client": "work"`) {
+		t.Fatalf("unexpected list output: %q", out)
+	}
+
+	out, err = run("you@company.com", "--clear")
+	if err != nil {
+		t.Fatalf("clear: %v", err)
+	}
+	if !strings.Contains(out, `"cleared": true`) {
+		t.Fatalf("unexpected clear output: %q", out)
+	}
+	cfg, err = config.ReadConfig()
+	if err != nil {
+		t.Fatalf("ReadConfig: %v", err)
+	}
+	if _, ok := config.AccountClient(cfg, "you@company.com"); ok {
+		t.Fatalf("expected mapping to be cleared")
+	}
+
+	if _, err := run("you@company.com"); err == nil {
+		t.Fatalf("expected usage error without client or --clear")
+	}
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// errScopeNotGranted makes `auth has-scope` exit 1 after its result has been
+// printed.
+var errScopeNotGranted = errors.New("scope not granted")
+
+type AuthHasScopeCmd struct {
+	Email   string        `arg:"" name:"email" optional:"" help:"Email (defaults to --account resolution)"`
+	Scope   string        `name:"scope" help:"Scope URL or short name (e.g. drive.readonly)" required:""`
+	Live    bool          `name:"live" help:"Exchange the refresh token and check the scopes Google reports as granted"`
+	Timeout time.Duration `name:"timeout" help:"Token exchange timeout (with --live)" default:"15s"`
+}
+
+func (c *AuthHasScopeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	scope := googleauth.NormalizeScope(c.Scope)
+	if scope == "" {
+		return usage("empty --scope")
+	}
+
+	email, err := resolveAccountEmail(c.Email)
+	if err != nil {
+		return err
+	}
+	if email == "" {
+		account, accountErr := requireAccount(flags)
+		if accountErr != nil {
+			return accountErr
+		}
+		email = account
+	}
+
+	client, err := resolveClientForEmail(email, flags, "")
+	if err != nil {
+		return err
+	}
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	tok, err := store.GetToken(client, email)
+	if err != nil {
+		return err
+	}
+
+	granted := tok.Scopes
+	if c.Live {
+		access, refreshErr := refreshAccessToken(ctx, client, tok.RefreshToken, tok.Scopes, c.Timeout)
+		if refreshErr != nil {
+			return refreshErr
+		}
+		// The token response lists the scopes actually granted; fall back to
+		// the stored list if Google omits it.
+		if live, ok := access.Extra("scope").(string); ok && strings.TrimSpace(live) != "" {
+			granted = strings.Fields(live)
+		}
+	}
+	ok := googleauth.ScopeGranted(granted, scope)
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"email":   normalizeEmail(email),
+			"scope":   scope,
+			"granted": ok,
+			"live":    c.Live,
+		}); err != nil {
+			return err
+		}
+	} else {
+		u.Out().Printf("email\t%s", normalizeEmail(email))
+		u.Out().Printf("scope\t%s", scope)
+		u.Out().Printf("granted\t%t", ok)
+		u.Out().Printf("live\t%t", c.Live)
+	}
+	if !ok {
+		return errScopeNotGranted
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+func TestAuthHasScopeCmd(t *testing.T) {
+	origOpen := openSecretsStore
+	origRefresh := refreshAccessToken
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		refreshAccessToken = origRefresh
+	})
+
+	store := newMemSecretsStore()
+	if err := store.SetToken(config.DefaultClientName, "a@b.com", secrets.Token{
+		RefreshToken: "rt",
+		Scopes:       []string{"https://www.googleapis.com/auth/drive", "https://www.googleapis.com/auth/calendar.readonly"},
+	}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	refreshAccessToken = func(context.Context, string, string, []string, time.Duration) (*oauth2.Token, error) {
+		t.Fatal("token exchange without --live")
+		return nil, errors.New("unreachable")
+	}
+
+	type result struct {
+		Scope   string `json:"scope"`
+		Granted bool   `json:"granted"`
+		Live    bool   `json:"live"`
+	}
+	run := func(args ...string) (result, error) {
+		var execErr error
+		out := captureStdout(t, func() {
+			_ = captureStderr(t, func() {
+				execErr = Execute(append([]string{"--json", "--json-errors", "auth", "has-scope", "a@b.com"}, args...))
+			})
+		})
+		var parsed result
+		if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+			t.Fatalf("json parse: %v\nout=%q", err, out)
+		}
+		return parsed, execErr
+	}
+
+	got, err := run("--scope", "drive.readonly")
+	if err != nil || !got.Granted || got.Live || got.Scope != "https://www.googleapis.com/auth/drive.readonly" {
+		t.Fatalf("drive.readonly: %#v, %v", got, err)
+	}
+
+	got, err = run("--scope", "https://www.googleapis.com/auth/calendar")
+	if got.Granted || ExitCode(err) != 1 {
+		t.Fatalf("calendar: %#v, exit %d", got, ExitCode(err))
+	}
+
+	// --live trusts the scopes in the token response over the stored list.
+	refreshAccessToken = func(context.Context, string, string, []string, time.Duration) (*oauth2.Token, error) {
+		tok := &oauth2.Token{AccessToken: "at"}
+		return tok.WithExtra(map[string]any{"scope": "https://www.googleapis.com/auth/drive.file openid"}), nil
+	}
+	got, err = run("--scope", "drive.readonly", "--live")
+	if got.Granted || !got.Live || ExitCode(err) != 1 {
+		t.Fatalf("live: %#v, %v", got, err)
+	}
+	got, err = run("--scope", "drive.file", "--live")
+	if err != nil || !got.Granted {
+		t.Fatalf("live drive.file: %#v, %v", got, err)
+	}
+
+	refreshAccessToken = func(context.Context, string, string, []string, time.Duration) (*oauth2.Token, error) {
+		return nil, errors.New("invalid_grant")
+	}
+	var execErr error
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"auth", "has-scope", "a@b.com", "--scope", "drive", "--live"})
+		})
+	})
+	if execErr == nil || !strings.Contains(execErr.Error(), "invalid_grant") {
+		t.Fatalf("expected refresh error, got %v", execErr)
+	}
+}
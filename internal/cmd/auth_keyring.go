@@ -14,23 +14,20 @@ import (
 )
 
 type AuthKeyringCmd struct {
-	Backend  string `arg:"" optional:"" name:"backend" help:"Keyring backend: auto|keychain|file"`
-	Backend2 string `arg:"" optional:"" name:"backend2" help:"(compat) Use: gog auth keyring set <backend>"`
+	Set     AuthKeyringSetCmd     `cmd:"" name:"set" default:"withargs" help:"Show or set the keyring backend"`
+	Migrate AuthKeyringMigrateCmd `cmd:"" name:"migrate" help:"Copy stored tokens to another keyring backend"`
 }
 
-func (c *AuthKeyringCmd) Run(ctx context.Context) error {
+type AuthKeyringSetCmd struct {
+	Backend string `arg:"" optional:"" name:"backend" help:"Keyring backend: auto|keychain|file"`
+}
+
+func (c *AuthKeyringSetCmd) Run(ctx context.Context) error {
 	u := ui.FromContext(ctx)
 
 	const keyringPasswordEnv = "GOG_KEYRING_PASSWORD" //nolint:gosec // env var name, not a credential
 
 	backend := strings.ToLower(strings.TrimSpace(c.Backend))
-	backend2 := strings.ToLower(strings.TrimSpace(c.Backend2))
-
-	// Backwards compat for earlier suggestion: `gog auth keyring set <backend>`.
-	if backend == "set" {
-		backend = backend2
-		backend2 = ""
-	}
 
 	// No args: show current config.
 	if backend == "" {
@@ -41,7 +38,7 @@ func (c *AuthKeyringCmd) Run(ctx context.Context) error {
 		}
 
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 				"keyring_backend": info.Value,
 				"source":          info.Source,
 				"path":            path,
@@ -58,10 +55,6 @@ func (c *AuthKeyringCmd) Run(ctx context.Context) error {
 		return nil
 	}
 
-	if backend2 != "" {
-		return usagef("too many args: %q %q", c.Backend, c.Backend2)
-	}
-
 	if backend == "default" {
 		backend = "auto"
 	}
@@ -108,7 +101,7 @@ func (c *AuthKeyringCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"written":         true,
 			"path":            path,
 			"keyring_backend": backend,
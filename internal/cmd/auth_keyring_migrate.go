@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var (
+	openSecretsStoreForBackend = secrets.OpenBackend
+	effectiveKeyringBackend    = secrets.EffectiveKeyringBackend
+)
+
+type AuthKeyringMigrateCmd struct {
+	To           string `arg:"" name:"backend" help:"Target keyring backend: keychain|file"`
+	Overwrite    bool   `name:"overwrite" help:"Overwrite tokens that already exist in the target backend"`
+	DeleteSource bool   `name:"delete-source" help:"Delete migrated tokens from the source backend"`
+}
+
+func (c *AuthKeyringMigrateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+
+	target := strings.ToLower(strings.TrimSpace(c.To))
+	if target != "keychain" && target != strFile {
+		return usagef("invalid target backend: %q (expected keychain or file)", c.To)
+	}
+
+	sourceInfo, err := secrets.ResolveKeyringBackendInfo()
+	if err != nil {
+		return err
+	}
+	// "auto" opens as a concrete backend; compare that, or --delete-source
+	// could wipe the tokens it just wrote.
+	effective := effectiveKeyringBackend(sourceInfo)
+	if effective == target {
+		if sourceInfo.Value != target {
+			return usagef("source backend %q resolves to %q on this host; source and target are the same", sourceInfo.Value, target)
+		}
+		return usagef("source and target backend are both %q", target)
+	}
+	if effective == "" && c.DeleteSource {
+		return usagef("cannot tell which backend %q opens as on this host; set GOG_KEYRING_BACKEND to keychain or file to use --delete-source", sourceInfo.Value)
+	}
+
+	if c.DeleteSource {
+		if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete migrated tokens from %s keyring backend", sourceInfo.Value)); confirmErr != nil {
+			return confirmErr
+		}
+	}
+
+	source, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	dest, err := openSecretsStoreForBackend(target)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := source.ListTokens()
+	if err != nil {
+		return err
+	}
+
+	migrated := make([]string, 0, len(tokens))
+	migratedSet := make(map[string]struct{}, len(tokens))
+	skipped := make([]string, 0)
+	for _, tok := range tokens {
+		if strings.TrimSpace(tok.Email) == "" {
+			continue
+		}
+		key := secrets.TokenKey(tok.Client, tok.Email)
+		if !c.Overwrite {
+			if _, getErr := dest.GetToken(tok.Client, tok.Email); getErr == nil {
+				skipped = append(skipped, key)
+				continue
+			} else if !errors.Is(getErr, keyring.ErrKeyNotFound) {
+				return fmt.Errorf("check %s in %s backend: %w", key, target, getErr)
+			}
+		}
+		if setErr := dest.SetToken(tok.Client, tok.Email, tok); setErr != nil {
+			return fmt.Errorf("migrate %s (after %d migrated): %w", key, len(migrated), setErr)
+		}
+		migrated = append(migrated, key)
+		migratedSet[key] = struct{}{}
+	}
+
+	deleted := make([]string, 0)
+	if c.DeleteSource {
+		for _, tok := range tokens {
+			key := secrets.TokenKey(tok.Client, tok.Email)
+			if _, ok := migratedSet[key]; !ok {
+				continue
+			}
+			if delErr := source.DeleteToken(tok.Client, tok.Email); delErr != nil {
+				return fmt.Errorf("delete %s from %s backend: %w", key, sourceInfo.Value, delErr)
+			}
+			deleted = append(deleted, key)
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"source":        sourceInfo.Value,
+			"source_origin": sourceInfo.Source,
+			"target":        target,
+			"migrated":      migrated,
+			"skipped":       skipped,
+			"deleted":       deleted,
+		})
+	}
+
+	u.Out().Printf("source\t%s (%s)", sourceInfo.Value, sourceInfo.Source)
+	u.Out().Printf("target\t%s", target)
+	u.Out().Printf("migrated\t%d", len(migrated))
+	for _, key := range migrated {
+		u.Out().Printf("  %s", key)
+	}
+	if len(skipped) > 0 {
+		u.Out().Printf("skipped\t%d", len(skipped))
+		for _, key := range skipped {
+			u.Out().Printf("  %s", key)
+		}
+		u.Err().Println("Hint: use --overwrite to replace tokens that already exist in the target backend")
+	}
+	if c.DeleteSource {
+		u.Out().Printf("deleted\t%d", len(deleted))
+	}
+	u.Err().Printf("Hint: gog auth keyring %s", target)
+	return nil
+}
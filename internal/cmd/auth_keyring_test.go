@@ -3,9 +3,11 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/steipete/gogcli/internal/config"
@@ -136,3 +138,126 @@ func TestAuthKeyringSet_InvalidBackend(t *testing.T) {
 		t.Fatalf("expected usage exit 2, got: %v", err)
 	}
 }
+
+func TestAuthKeyringMigrate(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("GOG_KEYRING_BACKEND", "keychain")
+
+	origOpen := openSecretsStore
+	origOpenBackend := openSecretsStoreForBackend
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		openSecretsStoreForBackend = origOpenBackend
+	})
+
+	source := newMemSecretsStore()
+	target := newMemSecretsStore()
+	_ = source.SetToken("default", "a@b.com", secrets.Token{RefreshToken: "rt-a"})
+	_ = source.SetToken("default", "c@d.com", secrets.Token{RefreshToken: "rt-c"})
+	_ = target.SetToken("default", "c@d.com", secrets.Token{RefreshToken: "rt-existing"})
+
+	openSecretsStore = func() (secrets.Store, error) { return source, nil }
+	var openedBackend string
+	openSecretsStoreForBackend = func(backend string) (secrets.Store, error) {
+		openedBackend = backend
+		return target, nil
+	}
+
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+
+	if err := runKong(t, &AuthKeyringCmd{}, []string{"migrate", "keychain"}, ctx, &RootFlags{}); err == nil {
+		t.Fatalf("expected error when source and target match")
+	}
+	if err := runKong(t, &AuthKeyringCmd{}, []string{"migrate", "file", "--delete-source"}, ctx, &RootFlags{NoInput: true}); err == nil {
+		t.Fatalf("expected confirmation error without --force")
+	}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &AuthKeyringCmd{}, []string{"migrate", "file", "--delete-source"}, ctx, &RootFlags{Force: true}); err != nil {
+			t.Fatalf("migrate: %v", err)
+		}
+	})
+	if openedBackend != "file" {
+		t.Fatalf("expected file backend, got %q", openedBackend)
+	}
+
+	var parsed struct {
+		Source   string   `json:"source"`
+		Target   string   `json:"target"`
+		Migrated []string `json:"migrated"`
+		Skipped  []string `json:"skipped"`
+		Deleted  []string `json:"deleted"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	wantKey := secrets.TokenKey("default", "a@b.com")
+	if parsed.Source != "keychain" || parsed.Target != "file" ||
+		len(parsed.Migrated) != 1 || parsed.Migrated[0] != wantKey ||
+		len(parsed.Skipped) != 1 || len(parsed.Deleted) != 1 {
+		t.Fatalf("unexpected result: %#v", parsed)
+	}
+
+	if tok, err := target.GetToken("default", "c@d.com"); err != nil || tok.RefreshToken != "rt-existing" {
+		t.Fatalf("expected existing target token to be kept, got %#v err=%v", tok, err)
+	}
+	if _, err := source.GetToken("default", "a@b.com"); err == nil {
+		t.Fatalf("expected migrated token to be deleted from source")
+	}
+	if _, err := source.GetToken("default", "c@d.com"); err != nil {
+		t.Fatalf("expected skipped token to remain in source: %v", err)
+	}
+
+	captureStdout(t, func() {
+		if err := runKong(t, &AuthKeyringCmd{}, []string{"migrate", "file", "--overwrite"}, ctx, &RootFlags{}); err != nil {
+			t.Fatalf("migrate overwrite: %v", err)
+		}
+	})
+	if tok, _ := target.GetToken("default", "c@d.com"); tok.RefreshToken != "rt-c" {
+		t.Fatalf("expected overwrite, got %#v", tok)
+	}
+}
+
+func TestAuthKeyringMigrate_AutoResolvesToTarget(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("GOG_KEYRING_BACKEND", "")
+
+	origOpen := openSecretsStore
+	origOpenBackend := openSecretsStoreForBackend
+	origEffective := effectiveKeyringBackend
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		openSecretsStoreForBackend = origOpenBackend
+		effectiveKeyringBackend = origEffective
+	})
+
+	// auto opens the same store the target backend does.
+	store := newMemSecretsStore()
+	_ = store.SetToken("default", "a@b.com", secrets.Token{RefreshToken: "rt-a"})
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	openSecretsStoreForBackend = func(string) (secrets.Store, error) { return store, nil }
+
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+
+	effectiveKeyringBackend = func(secrets.KeyringBackendInfo) string { return "keychain" }
+	err := runKong(t, &AuthKeyringCmd{}, []string{"migrate", "keychain", "--overwrite", "--delete-source"}, ctx, &RootFlags{Force: true})
+	if err == nil || ExitCode(err) != 2 || !strings.Contains(err.Error(), "resolves to") {
+		t.Fatalf("expected usage error for auto resolving to target, got %v", err)
+	}
+	if _, getErr := store.GetToken("default", "a@b.com"); getErr != nil {
+		t.Fatalf("expected token to survive: %v", getErr)
+	}
+
+	effectiveKeyringBackend = func(secrets.KeyringBackendInfo) string { return "" }
+	err = runKong(t, &AuthKeyringCmd{}, []string{"migrate", "keychain", "--overwrite", "--delete-source"}, ctx, &RootFlags{Force: true})
+	if err == nil || ExitCode(err) != 2 {
+		t.Fatalf("expected usage error for --delete-source with unresolved auto, got %v", err)
+	}
+	if _, getErr := store.GetToken("default", "a@b.com"); getErr != nil {
+		t.Fatalf("expected token to survive: %v", getErr)
+	}
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/steipete/gogcli/internal/googleauth"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+var refreshAccessToken = googleauth.RefreshAccessToken
+
+type AuthRefreshCmd struct {
+	Email   string        `arg:"" name:"email" optional:"" help:"Email (defaults to --account resolution)"`
+	NoCache bool          `name:"no-cache" help:"Do not cache the access token in the keyring"`
+	Timeout time.Duration `name:"timeout" help:"Token exchange timeout" default:"15s"`
+}
+
+func (c *AuthRefreshCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	email, err := resolveAccountEmail(c.Email)
+	if err != nil {
+		return err
+	}
+	if email == "" {
+		account, err := requireAccount(flags)
+		if err != nil {
+			return err
+		}
+		email = account
+	}
+
+	client, err := resolveClientForEmail(email, flags, "")
+	if err != nil {
+		return err
+	}
+
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	tok, err := store.GetToken(client, email)
+	if err != nil {
+		return err
+	}
+
+	access, err := refreshAccessToken(ctx, client, tok.RefreshToken, tok.Scopes, c.Timeout)
+	if err != nil {
+		return err
+	}
+
+	cached := false
+	if !c.NoCache {
+		if cache, ok := store.(secrets.AccessTokenStore); ok {
+			if err := cache.SetAccessToken(client, email, secrets.AccessToken{
+				AccessToken: access.AccessToken,
+				TokenType:   access.TokenType,
+				Expiry:      access.Expiry,
+			}); err != nil {
+				return err
+			}
+			cached = true
+		}
+	}
+
+	expiresAt := ""
+	if !access.Expiry.IsZero() {
+		expiresAt = access.Expiry.UTC().Format(time.RFC3339)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"email":      normalizeEmail(email),
+			"client":     client,
+			"expires_at": expiresAt,
+			"cached":     cached,
+		})
+	}
+	u.Out().Printf("email\t%s", normalizeEmail(email))
+	u.Out().Printf("client\t%s", client)
+	u.Out().Printf("expires_at\t%s", expiresAt)
+	u.Out().Printf("cached\t%t", cached)
+	return nil
+}
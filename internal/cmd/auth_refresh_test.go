@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/secrets"
+)
+
+type memAccessTokenStore struct {
+	*memSecretsStore
+	access map[string]secrets.AccessToken
+}
+
+func (s *memAccessTokenStore) SetAccessToken(client string, email string, tok secrets.AccessToken) error {
+	s.access[client+":"+normalizeEmailTest(email)] = tok
+	return nil
+}
+
+func (s *memAccessTokenStore) GetAccessToken(client string, email string) (secrets.AccessToken, error) {
+	return s.access[client+":"+normalizeEmailTest(email)], nil
+}
+
+func TestAuthRefreshCmd_CachesAccessToken(t *testing.T) {
+	origOpen := openSecretsStore
+	origRefresh := refreshAccessToken
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		refreshAccessToken = origRefresh
+	})
+
+	store := &memAccessTokenStore{memSecretsStore: newMemSecretsStore(), access: map[string]secrets.AccessToken{}}
+	if err := store.SetToken(config.DefaultClientName, "a@b.com", secrets.Token{RefreshToken: "rt", Scopes: []string{"s1"}}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	expiry := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	refreshAccessToken = func(_ context.Context, client string, refreshToken string, scopes []string, _ time.Duration) (*oauth2.Token, error) {
+		if client != config.DefaultClientName || refreshToken != "rt" || strings.Join(scopes, ",") != "s1" {
+			t.Fatalf("unexpected refresh args: %q %q %v", client, refreshToken, scopes)
+		}
+		return &oauth2.Token{AccessToken: "at", TokenType: "Bearer", Expiry: expiry}, nil
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "refresh", "a@b.com"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed struct {
+		Email     string `json:"email"`
+		Client    string `json:"client"`
+		ExpiresAt string `json:"expires_at"`
+		Cached    bool   `json:"cached"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Email != "a@b.com" || parsed.Client != config.DefaultClientName || parsed.ExpiresAt != "2030-01-02T03:04:05Z" || !parsed.Cached {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+	if got := store.access[config.DefaultClientName+":a@b.com"]; got.AccessToken != "at" || !got.Expiry.Equal(expiry) {
+		t.Fatalf("unexpected cached token: %#v", got)
+	}
+
+	store.access = map[string]secrets.AccessToken{}
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "refresh", "a@b.com", "--no-cache"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if len(store.access) != 0 {
+		t.Fatalf("--no-cache should not cache, got %#v", store.access)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/steipete/gogcli/internal/config"
@@ -16,6 +17,8 @@ type AuthServiceAccountCmd struct {
 	Set    AuthServiceAccountSetCmd    `cmd:"" name:"set" help:"Store a service account key for impersonation"`
 	Unset  AuthServiceAccountUnsetCmd  `cmd:"" name:"unset" help:"Remove stored service account key"`
 	Status AuthServiceAccountStatusCmd `cmd:"" name:"status" help:"Show stored service account key status"`
+	List   AuthServiceAccountListCmd   `cmd:"" name:"list" aliases:"ls" help:"List stored service account keys"`
+	Remove AuthServiceAccountRemoveCmd `cmd:"" name:"remove" aliases:"rm" help:"Remove all stored service account keys (including Keep) for an email"`
 }
 
 type serviceAccountJSONInfo struct {
@@ -97,7 +100,7 @@ func (c *AuthServiceAccountSetCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"stored":       true,
 			"email":        email,
 			"path":         destPath,
@@ -141,7 +144,7 @@ func (c *AuthServiceAccountUnsetCmd) Run(ctx context.Context, flags *RootFlags)
 	if err := os.Remove(path); err != nil {
 		if os.IsNotExist(err) {
 			if outfmt.IsJSON(ctx) {
-				return outfmt.WriteJSON(os.Stdout, map[string]any{
+				return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 					"deleted": false,
 					"email":   email,
 					"path":    path,
@@ -156,7 +159,7 @@ func (c *AuthServiceAccountUnsetCmd) Run(ctx context.Context, flags *RootFlags)
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted": true,
 			"email":   email,
 			"path":    path,
@@ -189,7 +192,7 @@ func (c *AuthServiceAccountStatusCmd) Run(ctx context.Context) error {
 	if err != nil {
 		if os.IsNotExist(err) {
 			if outfmt.IsJSON(ctx) {
-				return outfmt.WriteJSON(os.Stdout, map[string]any{
+				return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 					"email":   email,
 					"path":    path,
 					"exists":  false,
@@ -211,7 +214,7 @@ func (c *AuthServiceAccountStatusCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"email":        email,
 			"path":         path,
 			"exists":       true,
@@ -231,3 +234,106 @@ func (c *AuthServiceAccountStatusCmd) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+type AuthServiceAccountListCmd struct{}
+
+type serviceAccountEntry struct {
+	Email      string `json:"email"`
+	Path       string `json:"path"`
+	ModifiedAt string `json:"modifiedAt"`
+}
+
+func (c *AuthServiceAccountListCmd) Run(ctx context.Context) error {
+	u := ui.FromContext(ctx)
+
+	emails, err := config.ListServiceAccountEmails()
+	if err != nil {
+		return err
+	}
+	sort.Strings(emails)
+
+	entries := make([]serviceAccountEntry, 0, len(emails))
+	for _, email := range emails {
+		path, mtime, ok := bestServiceAccountPathAndMtime(email)
+		if !ok {
+			continue
+		}
+		entries = append(entries, serviceAccountEntry{
+			Email:      email,
+			Path:       path,
+			ModifiedAt: mtime.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"serviceAccounts": entries})
+	}
+	if len(entries) == 0 {
+		u.Err().Println("No service accounts stored")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "EMAIL\tPATH\tMODIFIED")
+	for _, e := range entries {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", e.Email, e.Path, e.ModifiedAt)
+	}
+	return nil
+}
+
+type AuthServiceAccountRemoveCmd struct {
+	Email string `arg:"" name:"email" help:"Email (impersonated user)" required:""`
+}
+
+func (c *AuthServiceAccountRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+
+	email := strings.TrimSpace(c.Email)
+	if email == "" {
+		return usage("empty email")
+	}
+
+	if err := confirmDestructive(ctx, flags, fmt.Sprintf("remove all stored service account keys for %s", email)); err != nil {
+		return err
+	}
+
+	// Unlike unset, also drop the Keep-specific keys (current and legacy names).
+	candidates := make([]string, 0, 3)
+	for _, pathFn := range []func(string) (string, error){
+		config.ServiceAccountPath,
+		config.KeepServiceAccountPath,
+		config.KeepServiceAccountLegacyPath,
+	} {
+		path, err := pathFn(email)
+		if err != nil {
+			return err
+		}
+		candidates = append(candidates, path)
+	}
+
+	removed := make([]string, 0, len(candidates))
+	for _, path := range candidates {
+		if err := os.Remove(path); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("remove service account: %w", err)
+		}
+		removed = append(removed, path)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"deleted": len(removed) > 0,
+			"email":   email,
+			"removed": removed,
+		})
+	}
+	u.Out().Printf("deleted\t%t", len(removed) > 0)
+	u.Out().Printf("email\t%s", email)
+	for _, path := range removed {
+		u.Out().Printf("path\t%s", path)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
@@ -92,3 +93,63 @@ func TestAuthStatus_ShowsServiceAccountPreferred(t *testing.T) {
 		t.Fatalf("unexpected status output: %q", out)
 	}
 }
+
+func TestAuthServiceAccountListAndRemove_JSON(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	if _, err := config.EnsureDir(); err != nil {
+		t.Fatalf("EnsureDir: %v", err)
+	}
+	saPath, err := config.ServiceAccountPath("user@example.com")
+	if err != nil {
+		t.Fatalf("ServiceAccountPath: %v", err)
+	}
+	keepPath, err := config.KeepServiceAccountPath("user@example.com")
+	if err != nil {
+		t.Fatalf("KeepServiceAccountPath: %v", err)
+	}
+	for _, p := range []string{saPath, keepPath} {
+		if err := os.WriteFile(p, []byte(`{"type":"service_account"}`), 0o600); err != nil {
+			t.Fatalf("write key: %v", err)
+		}
+	}
+
+	listOut := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "auth", "service-account", "list"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	var listed struct {
+		ServiceAccounts []serviceAccountEntry `json:"serviceAccounts"`
+	}
+	if err := json.Unmarshal([]byte(listOut), &listed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, listOut)
+	}
+	if len(listed.ServiceAccounts) != 1 || listed.ServiceAccounts[0].Email != "user@example.com" ||
+		listed.ServiceAccounts[0].Path != saPath || listed.ServiceAccounts[0].ModifiedAt == "" {
+		t.Fatalf("unexpected list: %+v", listed)
+	}
+
+	removeOut := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--force", "auth", "service-account", "remove", "user@example.com"}); err != nil {
+			t.Fatalf("remove: %v", err)
+		}
+	})
+	var removed struct {
+		Deleted bool     `json:"deleted"`
+		Removed []string `json:"removed"`
+	}
+	if err := json.Unmarshal([]byte(removeOut), &removed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, removeOut)
+	}
+	if !removed.Deleted || len(removed.Removed) != 2 {
+		t.Fatalf("unexpected remove result: %+v", removed)
+	}
+	for _, p := range []string{saPath, keepPath} {
+		if _, err := os.Stat(p); !os.IsNotExist(err) {
+			t.Fatalf("expected %q to be removed, stat err=%v", p, err)
+		}
+	}
+}
@@ -215,3 +215,152 @@ func TestAuthAdd_InvalidService(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestAuthList_LastUsed(t *testing.T) {
+	origOpen := openSecretsStore
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	if err := store.SetToken(config.DefaultClientName, "a@b.com", secrets.Token{
+		Services:     []string{"gmail"},
+		RefreshToken: "rt",
+		LastUsedAt:   time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+	if err := store.SetToken(config.DefaultClientName, "c@d.com", secrets.Token{
+		Services:     []string{"gmail"},
+		RefreshToken: "rt2",
+	}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+
+	textOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"auth", "list"}); err != nil {
+				t.Fatalf("list: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(textOut, "2026-03-01T12:00:00Z") {
+		t.Fatalf("expected last used time in output: %q", textOut)
+	}
+
+	// LAST_USED is appended so the existing columns keep their positions.
+	plainOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--plain", "auth", "list"}); err != nil {
+				t.Fatalf("list --plain: %v", err)
+			}
+		})
+	})
+	var row []string
+	for _, line := range strings.Split(strings.TrimSpace(plainOut), "\n") {
+		if strings.HasPrefix(line, "a@b.com\t") {
+			row = strings.Split(line, "\t")
+		}
+	}
+	if len(row) != 6 || row[4] != authTypeOAuth || row[5] != "2026-03-01T12:00:00Z" {
+		t.Fatalf("unexpected plain row: %q", row)
+	}
+
+	jsonOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "list"}); err != nil {
+				t.Fatalf("list --json: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(jsonOut, `"last_used_at": "2026-03-01T12:00:00Z"`) {
+		t.Fatalf("expected last_used_at in JSON: %q", jsonOut)
+	}
+	if strings.Count(jsonOut, "last_used_at") != 1 {
+		t.Fatalf("expected last_used_at omitted for unused token: %q", jsonOut)
+	}
+}
+
+func TestAuthList_ServiceFilter(t *testing.T) {
+	origOpen := openSecretsStore
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	for email, services := range map[string][]string{
+		"mail@x.com": {"gmail", "calendar"},
+		"cal@x.com":  {"calendar"},
+	} {
+		if err := store.SetToken(config.DefaultClientName, email, secrets.Token{Services: services, RefreshToken: "rt"}); err != nil {
+			t.Fatalf("SetToken: %v", err)
+		}
+	}
+
+	jsonOut := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "auth", "list", "--service", "Gmail"}); err != nil {
+				t.Fatalf("list --service: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(jsonOut, "mail@x.com") || strings.Contains(jsonOut, "cal@x.com") {
+		t.Fatalf("expected only gmail accounts: %q", jsonOut)
+	}
+
+	errOut := captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			if err := Execute([]string{"auth", "list", "--service", "docs"}); err != nil {
+				t.Fatalf("list --service docs: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(errOut, "No accounts authorized for docs") {
+		t.Fatalf("expected empty-filter message: %q", errOut)
+	}
+
+	errOut = captureStderr(t, func() {
+		if err := Execute([]string{"auth", "list", "--service", "nope"}); ExitCode(err) != 2 {
+			t.Fatalf("expected usage error, got %v", err)
+		}
+	})
+	if !strings.Contains(errOut, "supported: ") || !strings.Contains(errOut, "gmail") {
+		t.Fatalf("expected supported services in error: %q", errOut)
+	}
+}
+
+func TestAuthList_ServiceFilterServiceAccounts(t *testing.T) {
+	origOpen := openSecretsStore
+	t.Cleanup(func() { openSecretsStore = origOpen })
+	store := newMemSecretsStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	_ = writeKeepSA(t, "keep@x.com")
+	genericPath, err := config.ServiceAccountPath("all@x.com")
+	if err != nil {
+		t.Fatalf("ServiceAccountPath: %v", err)
+	}
+	if err := os.WriteFile(genericPath, []byte(`{"type":"service_account"}`), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	list := func(service string) string {
+		return captureStdout(t, func() {
+			_ = captureStderr(t, func() {
+				if err := Execute([]string{"--json", "auth", "list", "--service", service}); err != nil {
+					t.Fatalf("list --service %s: %v", service, err)
+				}
+			})
+		})
+	}
+	if out := list("keep"); !strings.Contains(out, "keep@x.com") || !strings.Contains(out, "all@x.com") {
+		t.Fatalf("expected both service accounts for keep: %q", out)
+	}
+	if out := list("gmail"); strings.Contains(out, "keep@x.com") || !strings.Contains(out, "all@x.com") {
+		t.Fatalf("expected only the generic service account for gmail: %q", out)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -135,6 +136,89 @@ func TestAuthList_CheckJSON(t *testing.T) {
 	}
 }
 
+func TestAuthTokensExportImport_All(t *testing.T) {
+	origOpen := openSecretsStore
+	origEnsure := ensureKeychainAccess
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		ensureKeychainAccess = origEnsure
+	})
+
+	store := newMemStore()
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	ensureKeychainAccess = func() error { return nil }
+
+	for _, tok := range []secrets.Token{
+		{Email: "b@b.com", RefreshToken: "rt-b"},
+		{Email: "a@b.com", RefreshToken: "rt-a", Services: []string{"gmail"}},
+	} {
+		if err := store.SetToken(config.DefaultClientName, tok.Email, tok); err != nil {
+			t.Fatalf("SetToken: %v", err)
+		}
+	}
+	if err := store.SetToken("work", "c@work.com", secrets.Token{RefreshToken: "rt-c"}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+
+	outPath := filepath.Join(t.TempDir(), "tokens.json")
+	u, uiErr := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	if err := (&AuthTokensExportCmd{Email: "a@b.com", All: true, Output: OutputPathRequiredFlag{Path: outPath}}).Run(ctx); err == nil {
+		t.Fatalf("expected error when combining <email> and --all")
+	}
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := (&AuthTokensExportCmd{All: true, Output: OutputPathRequiredFlag{Path: outPath}}).Run(ctx); err != nil {
+				t.Fatalf("export: %v", err)
+			}
+		})
+	})
+
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read export: %v", err)
+	}
+	var entries []map[string]any
+	if err := json.Unmarshal(data, &entries); err != nil {
+		t.Fatalf("parse export: %v", err)
+	}
+	if len(entries) != 3 || entries[0]["email"] != "a@b.com" || entries[1]["email"] != "b@b.com" || entries[2]["client"] != "work" {
+		t.Fatalf("unexpected export entries: %#v", entries)
+	}
+
+	if err := (&AuthTokensExportCmd{All: true, Output: OutputPathRequiredFlag{Path: outPath}}).Run(ctx); err == nil {
+		t.Fatalf("expected error without --overwrite")
+	}
+
+	// Entries without an email are skipped on import.
+	entries = append(entries, map[string]any{"email": " ", "refresh_token": "x"})
+	data, _ = json.Marshal(entries)
+	if err := os.WriteFile(outPath, data, 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	newStore := newMemStore()
+	openSecretsStore = func() (secrets.Store, error) { return newStore, nil }
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := (&AuthTokensImportCmd{InPath: outPath}).Run(ctx); err != nil {
+				t.Fatalf("import: %v", err)
+			}
+		})
+	})
+	if len(newStore.tokens) != 3 {
+		t.Fatalf("expected 3 imported tokens, got %#v", newStore.tokens)
+	}
+	if tok, err := newStore.GetToken("work", "c@work.com"); err != nil || tok.RefreshToken != "rt-c" {
+		t.Fatalf("unexpected work token: %#v err=%v", tok, err)
+	}
+}
+
 type memStore struct {
 	tokens       map[string]secrets.Token
 	defaultEmail string
@@ -207,3 +291,51 @@ func (m *memStore) SetDefaultAccount(client string, email string) error {
 	m.defaultEmail = email
 	return nil
 }
+
+func TestCheckRefreshTokens_Concurrent(t *testing.T) {
+	origCheck := checkRefreshToken
+	t.Cleanup(func() { checkRefreshToken = origCheck })
+
+	var (
+		mu      sync.Mutex
+		active  int
+		maxSeen int
+	)
+	checkRefreshToken = func(_ context.Context, _ string, refreshToken string, _ []string, _ time.Duration) error {
+		mu.Lock()
+		active++
+		if active > maxSeen {
+			maxSeen = active
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		active--
+		mu.Unlock()
+		if refreshToken == "bad" {
+			return errors.New("invalid_grant")
+		}
+		return nil
+	}
+
+	toks := []*secrets.Token{
+		{Email: "a@b.com", RefreshToken: "ok"},
+		nil,
+		{Email: "c@b.com", RefreshToken: "bad"},
+		{Email: "d@b.com", RefreshToken: "ok"},
+		{Email: "e@b.com", RefreshToken: "ok"},
+	}
+	errs := checkRefreshTokens(context.Background(), toks, 2, time.Second)
+	if len(errs) != len(toks) {
+		t.Fatalf("expected %d results, got %d", len(toks), len(errs))
+	}
+	for i, err := range errs {
+		wantErr := i == 2
+		if (err != nil) != wantErr {
+			t.Fatalf("result %d: unexpected err %v", i, err)
+		}
+	}
+	if maxSeen > 2 {
+		t.Fatalf("expected at most 2 concurrent checks, saw %d", maxSeen)
+	}
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/99designs/keyring"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type AuthWhoamiCmd struct{}
+
+func (c *AuthWhoamiCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	if _, err := requireAccount(flags); err != nil {
+		return err
+	}
+
+	acct, err := resolveAuthAccountStatus(flags)
+	if err != nil {
+		return err
+	}
+
+	var services, scopes []string
+	tokenStored := false
+	store, err := openSecretsStore()
+	if err != nil {
+		return err
+	}
+	if tok, getErr := store.GetToken(acct.Client, acct.Email); getErr == nil {
+		tokenStored = true
+		services = tok.Services
+		scopes = tok.Scopes
+	} else if !errors.Is(getErr, keyring.ErrKeyNotFound) {
+		return getErr
+	}
+
+	if outfmt.IsJSON(ctx) {
+		account := acct.jsonMap()
+		account["token_stored"] = tokenStored
+		account["services"] = nonNilStrings(services)
+		account["scopes"] = nonNilStrings(scopes)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"account": account})
+	}
+
+	acct.print(u)
+	u.Out().Printf("token_stored\t%t", tokenStored)
+	u.Out().Printf("services\t%s", strings.Join(services, ","))
+	u.Out().Printf("scopes\t%s", strings.Join(scopes, ","))
+	return nil
+}
+
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/secrets"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestAuthWhoamiCmd_JSON(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg"))
+	t.Setenv("GOG_ACCOUNT", "")
+
+	origOpen := openSecretsStore
+	origOpenAccount := openSecretsStoreForAccount
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		openSecretsStoreForAccount = origOpenAccount
+	})
+
+	store := newMemSecretsStore()
+	_ = store.SetToken(config.DefaultClientName, "alias@example.com", secrets.Token{
+		RefreshToken: "rt",
+		Services:     []string{"drive"},
+		Scopes:       []string{"https://www.googleapis.com/auth/drive"},
+	})
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+	openSecretsStoreForAccount = func() (secrets.Store, error) { return store, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &AuthAliasSetCmd{}, []string{"work", "alias@example.com"}, ctx, &RootFlags{}); err != nil {
+			t.Fatalf("alias set: %v", err)
+		}
+	})
+
+	type whoami struct {
+		Account struct {
+			Email         string   `json:"email"`
+			Client        string   `json:"client"`
+			AuthPreferred string   `json:"auth_preferred"`
+			TokenStored   bool     `json:"token_stored"`
+			Scopes        []string `json:"scopes"`
+		} `json:"account"`
+	}
+
+	for _, flags := range []*RootFlags{{Account: "work"}, {}} {
+		out := captureStdout(t, func() {
+			if err := runKong(t, &AuthWhoamiCmd{}, []string{}, ctx, flags); err != nil {
+				t.Fatalf("whoami: %v", err)
+			}
+		})
+		var parsed whoami
+		if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+			t.Fatalf("json parse: %v\nout=%q", err, out)
+		}
+		if parsed.Account.Email != "alias@example.com" || parsed.Account.Client != config.DefaultClientName ||
+			parsed.Account.AuthPreferred != authTypeOAuth || !parsed.Account.TokenStored ||
+			len(parsed.Account.Scopes) != 1 {
+			t.Fatalf("unexpected whoami for %+v: %#v", flags, parsed)
+		}
+	}
+
+	openSecretsStoreForAccount = func() (secrets.Store, error) { return newMemSecretsStore(), nil }
+	if err := runKong(t, &AuthWhoamiCmd{}, []string{}, ctx, &RootFlags{}); err == nil {
+		t.Fatalf("expected missing account error without tokens")
+	}
+}
@@ -11,18 +11,26 @@ import (
 )
 
 type CalendarCmd struct {
+	DisplayTZ string `name:"display-tz" help:"Also show event times in this IANA timezone (e.g. Asia/Tokyo) on read commands"`
+
 	Calendars       CalendarCalendarsCmd       `cmd:"" name:"calendars" help:"List calendars"`
-	ACL             CalendarAclCmd             `cmd:"" name:"acl" help:"List calendar ACL"`
+	ACL             CalendarAclCmd             `cmd:"" name:"acl" help:"List and manage who a calendar is shared with"`
+	Settings        CalendarSettingsCmd        `cmd:"" name:"settings" help:"Change a calendar's name override, color, visibility and default reminders"`
 	Events          CalendarEventsCmd          `cmd:"" name:"events" aliases:"list" help:"List events from a calendar or all calendars"`
 	Event           CalendarEventCmd           `cmd:"" name:"event" aliases:"get" help:"Get event"`
 	Create          CalendarCreateCmd          `cmd:"" name:"create" help:"Create an event"`
 	Update          CalendarUpdateCmd          `cmd:"" name:"update" help:"Update an event"`
+	Duplicate       CalendarDuplicateCmd       `cmd:"" name:"duplicate" aliases:"copy" help:"Copy an event as a new event, optionally shifted"`
 	Delete          CalendarDeleteCmd          `cmd:"" name:"delete" help:"Delete an event"`
+	Exceptions      CalendarExceptionsCmd      `cmd:"" name:"exceptions" help:"List modified or cancelled occurrences of a recurring event, or cancel one"`
+	Purge           CalendarPurgeCmd           `cmd:"" name:"purge" help:"Delete all events matching a query and/or time range"`
 	FreeBusy        CalendarFreeBusyCmd        `cmd:"" name:"freebusy" help:"Get free/busy"`
 	Respond         CalendarRespondCmd         `cmd:"" name:"respond" help:"Respond to an event invitation"`
 	ProposeTime     CalendarProposeTimeCmd     `cmd:"" name:"propose-time" help:"Generate URL to propose a new meeting time (browser-only feature)"`
 	Colors          CalendarColorsCmd          `cmd:"" name:"colors" help:"Show calendar colors"`
 	Conflicts       CalendarConflictsCmd       `cmd:"" name:"conflicts" help:"Find conflicts"`
+	Agenda          CalendarAgendaCmd          `cmd:"" name:"agenda" help:"Merged agenda across several calendars"`
+	FindTime        CalendarFindTimeCmd        `cmd:"" name:"find-time" help:"Find free slots where all attendees are available"`
 	Search          CalendarSearchCmd          `cmd:"" name:"search" help:"Search events"`
 	Time            CalendarTimeCmd            `cmd:"" name:"time" help:"Show server time"`
 	Users           CalendarUsersCmd           `cmd:"" name:"users" help:"List workspace users (use their email as calendar ID)"`
@@ -30,6 +38,7 @@ type CalendarCmd struct {
 	FocusTime       CalendarFocusTimeCmd       `cmd:"" name:"focus-time" help:"Create a Focus Time block"`
 	OOO             CalendarOOOCmd             `cmd:"" name:"out-of-office" aliases:"ooo" help:"Create an Out of Office event"`
 	WorkingLocation CalendarWorkingLocationCmd `cmd:"" name:"working-location" aliases:"wl" help:"Set working location (home/office/custom)"`
+	Where           CalendarWhereCmd           `cmd:"" name:"where" help:"Show where attendees are working on a day (home/office/custom)"`
 }
 
 type CalendarCalendarsCmd struct {
@@ -37,6 +46,8 @@ type CalendarCalendarsCmd struct {
 	Page string `name:"page" help:"Page token"`
 }
 
+func (*CalendarCalendarsCmd) allAccounts() {}
+
 func (c *CalendarCalendarsCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -49,12 +60,12 @@ func (c *CalendarCalendarsCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	resp, err := svc.CalendarList.List().MaxResults(c.Max).PageToken(c.Page).Do()
+	resp, err := svc.CalendarList.List().MaxResults(c.Max).PageToken(c.Page).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"calendars":     resp.Items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -74,59 +85,6 @@ func (c *CalendarCalendarsCmd) Run(ctx context.Context, flags *RootFlags) error
 	return nil
 }
 
-type CalendarAclCmd struct {
-	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
-	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
-	Page       string `name:"page" help:"Page token"`
-}
-
-func (c *CalendarAclCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
-	account, err := requireAccount(flags)
-	if err != nil {
-		return err
-	}
-	calendarID := strings.TrimSpace(c.CalendarID)
-	if calendarID == "" {
-		return usage("calendarId required")
-	}
-
-	svc, err := newCalendarService(ctx, account)
-	if err != nil {
-		return err
-	}
-
-	resp, err := svc.Acl.List(calendarID).MaxResults(c.Max).PageToken(c.Page).Do()
-	if err != nil {
-		return err
-	}
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"rules":         resp.Items,
-			"nextPageToken": resp.NextPageToken,
-		})
-	}
-	if len(resp.Items) == 0 {
-		u.Err().Println("No ACL rules")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "SCOPE_TYPE\tSCOPE_VALUE\tROLE")
-	for _, rule := range resp.Items {
-		scopeType := ""
-		scopeValue := ""
-		if rule.Scope != nil {
-			scopeType = rule.Scope.Type
-			scopeValue = rule.Scope.Value
-		}
-		fmt.Fprintf(w, "%s\t%s\t%s\n", scopeType, scopeValue, rule.Role)
-	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
-}
-
 type CalendarEventsCmd struct {
 	CalendarID        string `arg:"" name:"calendarId" optional:"" help:"Calendar ID (default: primary)"`
 	From              string `name:"from" help:"Start time (RFC3339, date, or relative: today, tomorrow, monday)"`
@@ -142,10 +100,14 @@ type CalendarEventsCmd struct {
 	All               bool   `name:"all" help:"Fetch events from all calendars"`
 	PrivatePropFilter string `name:"private-prop-filter" help:"Filter by private extended property (key=value)"`
 	SharedPropFilter  string `name:"shared-prop-filter" help:"Filter by shared extended property (key=value)"`
-	Fields            string `name:"fields" help:"Comma-separated fields to return"`
+	Fields            string `name:"api-fields" help:"Comma-separated API fields to request (Calendar partial response)"`
 	Weekday           bool   `name:"weekday" help:"Include start/end day-of-week columns" default:"${calendar_weekday}"`
 }
 
+func (*CalendarEventsCmd) csvTable()              {}
+func (*CalendarEventsCmd) allAccounts()           {}
+func (*CalendarEventsCmd) fieldsResource() string { return "events" }
+
 func (c *CalendarEventsCmd) Run(ctx context.Context, flags *RootFlags) error {
 	account, err := requireAccount(flags)
 	if err != nil {
@@ -212,14 +174,27 @@ func (c *CalendarEventCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	event, err := svc.Events.Get(calendarID, eventID).Do()
+	event, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	tz, loc, _ := getCalendarLocation(ctx, svc, calendarID)
+	displayLoc := displayTimezoneFromContext(ctx)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(event, tz, loc)})
+		wrapped := wrapEventWithDaysWithTimezone(event, tz, loc)
+		wrapped.DisplayTZ = eventDisplayTimes(event, displayLoc)
+		payload := map[string]any{"event": wrapped}
+		if summary := summarizeEventResponses(event.Attendees); summary != nil {
+			payload["responseSummary"] = summary
+		}
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 	printCalendarEventWithTimezone(u, event, tz, loc)
+	printEventResponseSummary(u, summarizeEventResponses(event.Attendees))
+	if display := eventDisplayTimes(event, displayLoc); display != nil {
+		u.Out().Printf("display-timezone\t%s", display.Timezone)
+		u.Out().Printf("start-display\t%s", display.Start)
+		u.Out().Printf("end-display\t%s", display.End)
+	}
 	return nil
 }
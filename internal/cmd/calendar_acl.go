@@ -0,0 +1,188 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarAclCmd struct {
+	List   CalendarAclListCmd   `cmd:"" name:"list" aliases:"ls" default:"withargs" help:"List ACL rules"`
+	Add    CalendarAclAddCmd    `cmd:"" name:"add" help:"Share a calendar with a user, group or domain"`
+	Remove CalendarAclRemoveCmd `cmd:"" name:"remove" aliases:"rm" help:"Remove an ACL rule"`
+}
+
+type CalendarAclListCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
+	Page       string `name:"page" help:"Page token"`
+}
+
+func (c *CalendarAclListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("calendarId required")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	resp, err := svc.Acl.List(calendarID).MaxResults(c.Max).PageToken(c.Page).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"rules":         resp.Items,
+			"nextPageToken": resp.NextPageToken,
+		})
+	}
+	if len(resp.Items) == 0 {
+		u.Err().Println("No ACL rules")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tSCOPE_TYPE\tSCOPE_VALUE\tROLE")
+	for _, rule := range resp.Items {
+		scopeType := ""
+		scopeValue := ""
+		if rule.Scope != nil {
+			scopeType = rule.Scope.Type
+			scopeValue = rule.Scope.Value
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rule.Id, scopeType, scopeValue, rule.Role)
+	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
+
+var calendarACLRoles = []string{"freeBusyReader", "reader", "writer", "owner"}
+
+type CalendarAclAddCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+	Email      string `name:"email" help:"User or group email (domain name with --type domain)"`
+	Role       string `name:"role" help:"Role: freeBusyReader, reader, writer, owner" default:"reader"`
+	Type       string `name:"type" help:"Scope type: user, group, domain, default (public)" default:"user" enum:"user,group,domain,default"`
+	NoNotify   bool   `name:"no-notify" help:"Don't email the grantee about the change"`
+}
+
+func (c *CalendarAclAddCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("calendarId required")
+	}
+	role, err := validateCalendarACLRole(c.Role)
+	if err != nil {
+		return err
+	}
+	value := strings.TrimSpace(c.Email)
+	switch {
+	case c.Type == "default" && value != "":
+		return usage("--email is not used with --type default")
+	case c.Type != "default" && value == "":
+		return usagef("--email required for --type %s", c.Type)
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	rule := &calendar.AclRule{
+		Role:  role,
+		Scope: &calendar.AclRuleScope{Type: c.Type, Value: value},
+	}
+	created, err := svc.Acl.Insert(calendarID, rule).SendNotifications(!c.NoNotify).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"rule": created})
+	}
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("role\t%s", created.Role)
+	if created.Scope != nil {
+		u.Out().Printf("scope\t%s:%s", created.Scope.Type, created.Scope.Value)
+	}
+	return nil
+}
+
+type CalendarAclRemoveCmd struct {
+	CalendarID string `arg:"" name:"calendarId" help:"Calendar ID"`
+	RuleID     string `arg:"" name:"ruleId" help:"ACL rule ID (e.g. user:alice@example.com); a bare email means user:<email>"`
+}
+
+func (c *CalendarAclRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	ruleID := strings.TrimSpace(c.RuleID)
+	if calendarID == "" {
+		return usage("calendarId required")
+	}
+	if ruleID == "" {
+		return usage("empty ruleId")
+	}
+	if !strings.Contains(ruleID, ":") && strings.Contains(ruleID, "@") {
+		ruleID = "user:" + ruleID
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("remove ACL rule %s from calendar %s", ruleID, calendarID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	if err := svc.Acl.Delete(calendarID, ruleID).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"removed":    true,
+			"calendarId": calendarID,
+			"ruleId":     ruleID,
+		})
+	}
+	u.Out().Printf("removed\ttrue")
+	u.Out().Printf("calendar_id\t%s", calendarID)
+	u.Out().Printf("rule_id\t%s", ruleID)
+	return nil
+}
+
+func validateCalendarACLRole(role string) (string, error) {
+	role = strings.TrimSpace(role)
+	for _, r := range calendarACLRoles {
+		if strings.EqualFold(role, r) {
+			return r, nil
+		}
+	}
+	return "", usagef("invalid --role %q (must be %s)", role, strings.Join(calendarACLRoles, ", "))
+}
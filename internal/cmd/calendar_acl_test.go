@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarAclAddRemove(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var inserted calendar.AclRule
+	var insertQuery, deletedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && path == "/calendars/team@group.calendar.google.com/acl":
+			insertQuery = r.URL.RawQuery
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":    inserted.Scope.Type + ":" + inserted.Scope.Value,
+				"role":  inserted.Role,
+				"scope": inserted.Scope,
+			})
+		case r.Method == http.MethodDelete && strings.HasPrefix(path, "/calendars/team@group.calendar.google.com/acl/"):
+			deletedPath = path
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "acl", "add", "team@group.calendar.google.com",
+			"--email", "eng@example.com", "--type", "group", "--role", "WRITER", "--no-notify"}); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+	})
+	if inserted.Role != "writer" || inserted.Scope.Type != "group" || inserted.Scope.Value != "eng@example.com" {
+		t.Fatalf("unexpected rule: %#v %#v", inserted, inserted.Scope)
+	}
+	if !strings.Contains(insertQuery, "sendNotifications=false") {
+		t.Fatalf("expected sendNotifications=false, got %q", insertQuery)
+	}
+	if !strings.Contains(out, `"id": "group:eng@example.com"`) {
+		t.Fatalf("unexpected add output: %s", out)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--force", "--account", "a@b.com", "calendar", "acl", "remove", "team@group.calendar.google.com", "alice@example.com"}); err != nil {
+			t.Fatalf("remove: %v", err)
+		}
+	})
+	if deletedPath != "/calendars/team@group.calendar.google.com/acl/user:alice@example.com" {
+		t.Fatalf("unexpected delete path %q", deletedPath)
+	}
+
+	for _, args := range [][]string{
+		{"calendar", "acl", "add", "team@group.calendar.google.com", "--email", "x@example.com", "--role", "admin"},
+		{"calendar", "acl", "add", "team@group.calendar.google.com"},
+		{"calendar", "acl", "add", "team@group.calendar.google.com", "--type", "default", "--email", "x@example.com"},
+		{"--no-input", "calendar", "acl", "remove", "team@group.calendar.google.com", "user:x@example.com"},
+	} {
+		var runErr error
+		_ = captureStderr(t, func() {
+			runErr = Execute(append([]string{"--account", "a@b.com"}, args...))
+		})
+		if runErr == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
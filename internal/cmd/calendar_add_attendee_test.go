@@ -97,3 +97,18 @@ func TestMergeAttendeesNewHaveNeedsAction(t *testing.T) {
 	}
 	t.Error("new attendee not found in result")
 }
+
+func TestMergeAttendeesParsesSuffixes(t *testing.T) {
+	existing := []*calendar.EventAttendee{{Email: "existing@test.com", ResponseStatus: "accepted"}}
+	got := mergeAttendees(existing, "maybe@test.com:optional, existing@test.com:optional")
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 attendees, got %d", len(got))
+	}
+	if got[0].Optional {
+		t.Errorf("existing attendee should be untouched: %#v", got[0])
+	}
+	if got[1].Email != "maybe@test.com" || !got[1].Optional || got[1].ResponseStatus != "needsAction" {
+		t.Errorf("unexpected new attendee: %#v", got[1])
+	}
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarAgendaCmd struct {
+	Calendars string `name:"calendars" help:"Comma-separated calendar IDs" default:"primary"`
+	NoDedupe  bool   `name:"no-dedupe" help:"Keep events that appear on several calendars as separate rows"`
+	TimeRangeFlags
+}
+
+func (*CalendarAgendaCmd) allAccounts()           {}
+func (*CalendarAgendaCmd) fieldsResource() string { return "events" }
+
+// agendaEvent is one row of the merged agenda, annotated with its calendar.
+type agendaEvent struct {
+	CalendarID     string          `json:"calendarId"`
+	Calendars      []string        `json:"calendars,omitempty"`
+	ID             string          `json:"id"`
+	ICalUID        string          `json:"iCalUID,omitempty"`
+	Summary        string          `json:"summary"`
+	Status         string          `json:"status,omitempty"`
+	Location       string          `json:"location,omitempty"`
+	Start          string          `json:"start"`
+	End            string          `json:"end"`
+	Timezone       string          `json:"timezone,omitempty"`
+	DisplayTZ      *displayTZTimes `json:"displayTz,omitempty"`
+	StartDayOfWeek string          `json:"startDayOfWeek,omitempty"`
+	EndDayOfWeek   string          `json:"endDayOfWeek,omitempty"`
+	dedupeKey      string
+	sortKey        time.Time
+	startDT        *calendar.EventDateTime
+	endDT          *calendar.EventDateTime
+}
+
+func (c *CalendarAgendaCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	calendarIDs := splitCSV(c.Calendars)
+	if len(calendarIDs) == 0 {
+		return usage("required: --calendars")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	tr, err := ResolveTimeRange(ctx, svc, c.TimeRangeFlags)
+	if err != nil {
+		return err
+	}
+
+	var events []agendaEvent
+	for _, calID := range calendarIDs {
+		calEvents, err := fetchAgendaEvents(ctx, svc, calID, tr)
+		if err != nil {
+			return err
+		}
+		events = append(events, calEvents...)
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].sortKey.Before(events[j].sortKey)
+	})
+	if !c.NoDedupe {
+		events = dedupeAgendaEvents(events)
+	}
+	if events == nil {
+		events = []agendaEvent{}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"timeMin": tr.From.Format(time.RFC3339),
+			"timeMax": tr.To.Format(time.RFC3339),
+			"events":  events,
+		})
+	}
+
+	if len(events) == 0 {
+		u.Err().Println("No events")
+		return nil
+	}
+
+	displayLoc := displayTimezoneFromContext(ctx)
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "CALENDAR\tSTART\tEND\tSUMMARY")
+	for _, ev := range events {
+		cal := ev.CalendarID
+		if len(ev.Calendars) > 0 {
+			cal = strings.Join(ev.Calendars, ", ")
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			sanitizeTab(cal),
+			sanitizeTab(withDisplayTime(ev.Start, ev.startDT, displayLoc)),
+			sanitizeTab(withDisplayTime(ev.End, ev.endDT, displayLoc)),
+			sanitizeTab(ev.Summary),
+		)
+	}
+	return nil
+}
+
+// fetchAgendaEvents lists every event in the range for one calendar and
+// renders its times in that calendar's timezone. Calendars that are not in
+// the user's calendar list (e.g. a colleague's) fall back to the range zone.
+func fetchAgendaEvents(ctx context.Context, svc *calendar.Service, calendarID string, tr *TimeRange) ([]agendaEvent, error) {
+	tz, loc, err := getCalendarLocation(ctx, svc, calendarID)
+	if err != nil {
+		tz, loc = tr.Location.String(), tr.Location
+	}
+
+	items, err := collectAllPages(ctx, "", func(pageToken string) ([]*calendar.Event, string, error) {
+		call := svc.Events.List(calendarID).
+			SingleEvents(true).
+			OrderBy("startTime").
+			TimeMin(tr.From.Format(time.RFC3339)).
+			TimeMax(tr.To.Format(time.RFC3339)).
+			MaxResults(250).
+			Context(ctx)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, "", fmt.Errorf("list events for %s: %w", calendarID, err)
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]agendaEvent, 0, len(items))
+	for _, ev := range items {
+		if ev == nil || ev.Status == "cancelled" {
+			continue
+		}
+		wrapped := wrapEventWithDaysWithTimezone(ev, tz, loc)
+		startTime := parseEventStart(ev, loc)
+		out = append(out, agendaEvent{
+			CalendarID:     calendarID,
+			ID:             ev.Id,
+			ICalUID:        ev.ICalUID,
+			Summary:        ev.Summary,
+			Status:         ev.Status,
+			Location:       ev.Location,
+			Start:          wrapped.StartLocal,
+			End:            wrapped.EndLocal,
+			Timezone:       wrapped.Timezone,
+			DisplayTZ:      eventDisplayTimes(ev, displayTimezoneFromContext(ctx)),
+			StartDayOfWeek: wrapped.StartDayOfWeek,
+			EndDayOfWeek:   wrapped.EndDayOfWeek,
+			dedupeKey:      eventDedupeKey(ev, startTime),
+			sortKey:        startTime,
+			startDT:        ev.Start,
+			endDT:          ev.End,
+		})
+	}
+	return out, nil
+}
+
+// dedupeAgendaEvents collapses events sharing an iCalUID and start time,
+// keeping the first occurrence and recording every calendar it appeared on.
+func dedupeAgendaEvents(events []agendaEvent) []agendaEvent {
+	seen := make(map[string]int)
+	var result []agendaEvent
+	for _, ev := range events {
+		if ev.dedupeKey == "" {
+			result = append(result, ev)
+			continue
+		}
+		if idx, ok := seen[ev.dedupeKey]; ok {
+			if len(result[idx].Calendars) == 0 {
+				result[idx].Calendars = []string{result[idx].CalendarID}
+			}
+			result[idx].Calendars = append(result[idx].Calendars, ev.CalendarID)
+			continue
+		}
+		seen[ev.dedupeKey] = len(result)
+		result = append(result, ev)
+	}
+	return result
+}
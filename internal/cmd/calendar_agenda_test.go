@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func newAgendaTestServer(t *testing.T) {
+	t.Helper()
+
+	events := map[string][]map[string]any{
+		"primary": {
+			{
+				"id": "p1", "iCalUID": "shared", "summary": "Standup",
+				"start": map[string]any{"dateTime": "2025-01-02T10:00:00Z"},
+				"end":   map[string]any{"dateTime": "2025-01-02T10:15:00Z"},
+			},
+			{
+				"id": "p2", "iCalUID": "late", "summary": "Late",
+				"start": map[string]any{"dateTime": "2025-01-02T15:00:00Z"},
+				"end":   map[string]any{"dateTime": "2025-01-02T16:00:00Z"},
+			},
+		},
+		"work@example.com": {
+			{
+				"id": "w1", "iCalUID": "early", "summary": "Early",
+				"start": map[string]any{"dateTime": "2025-01-02T08:00:00Z"},
+				"end":   map[string]any{"dateTime": "2025-01-02T09:00:00Z"},
+			},
+			{
+				"id": "w2", "iCalUID": "shared", "summary": "Standup",
+				"start": map[string]any{"dateTime": "2025-01-02T10:00:00Z"},
+				"end":   map[string]any{"dateTime": "2025-01-02T10:15:00Z"},
+			},
+		},
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasPrefix(path, "/users/me/calendarList/"):
+			id := strings.TrimPrefix(path, "/users/me/calendarList/")
+			tz := "UTC"
+			if id == "work@example.com" {
+				tz = "Europe/Berlin"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "timeZone": tz})
+		case strings.HasPrefix(path, "/calendars/") && strings.HasSuffix(path, "/events"):
+			id := strings.TrimSuffix(strings.TrimPrefix(path, "/calendars/"), "/events")
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": events[id]})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	orig := newCalendarService
+	t.Cleanup(func() { newCalendarService = orig })
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+}
+
+func TestCalendarAgenda_JSONMergedAndDeduped(t *testing.T) {
+	newAgendaTestServer(t)
+
+	flags := &RootFlags{Account: "a@b.com"}
+	out := captureStdout(t, func() {
+		u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+		if err != nil {
+			t.Fatalf("ui.New: %v", err)
+		}
+		ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+		args := []string{"--calendars", "primary,work@example.com", "--from", "2025-01-02T00:00:00Z", "--to", "2025-01-03T00:00:00Z"}
+		if err := runKong(t, &CalendarAgendaCmd{}, args, ctx, flags); err != nil {
+			t.Fatalf("agenda: %v", err)
+		}
+	})
+
+	var parsed struct {
+		Events []struct {
+			CalendarID string   `json:"calendarId"`
+			Calendars  []string `json:"calendars"`
+			ID         string   `json:"id"`
+			Start      string   `json:"start"`
+			Timezone   string   `json:"timezone"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if len(parsed.Events) != 3 {
+		t.Fatalf("expected 3 events, got %#v", parsed.Events)
+	}
+	if parsed.Events[0].ID != "w1" || parsed.Events[1].ID != "p1" || parsed.Events[2].ID != "p2" {
+		t.Fatalf("unexpected order: %#v", parsed.Events)
+	}
+	if parsed.Events[0].Timezone != "Europe/Berlin" || parsed.Events[0].Start != "2025-01-02T09:00:00+01:00" {
+		t.Fatalf("expected calendar-local time, got %#v", parsed.Events[0])
+	}
+	if got := parsed.Events[1].Calendars; len(got) != 2 || got[0] != "primary" || got[1] != "work@example.com" {
+		t.Fatalf("expected shared event on both calendars, got %#v", got)
+	}
+}
+
+func TestCalendarAgenda_TextNoDedupe(t *testing.T) {
+	newAgendaTestServer(t)
+
+	flags := &RootFlags{Account: "a@b.com"}
+	out := captureStdout(t, func() {
+		u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+		if err != nil {
+			t.Fatalf("ui.New: %v", err)
+		}
+		ctx := ui.WithUI(context.Background(), u)
+		args := []string{"--calendars", "primary,work@example.com", "--from", "2025-01-02T00:00:00Z", "--to", "2025-01-03T00:00:00Z", "--no-dedupe"}
+		if err := runKong(t, &CalendarAgendaCmd{}, args, ctx, flags); err != nil {
+			t.Fatalf("agenda: %v", err)
+		}
+	})
+
+	if !strings.Contains(out, "CALENDAR") || strings.Count(out, "Standup") != 2 {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
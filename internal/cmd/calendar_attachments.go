@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/calendar/v3"
+	gapi "google.golang.org/api/googleapi"
+)
+
+// resolveDriveAttachments fills in Drive file attachments (those with only a
+// FileId) from the file's Drive metadata so Calendar renders them inline.
+func resolveDriveAttachments(ctx context.Context, account string, attachments []*calendar.EventAttachment) error {
+	var pending []*calendar.EventAttachment
+	for _, a := range attachments {
+		if a != nil && a.FileId != "" && a.FileUrl == "" {
+			pending = append(pending, a)
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range pending {
+		f, err := svc.Files.Get(a.FileId).
+			SupportsAllDrives(true).
+			Fields("id, name, mimeType, iconLink, webViewLink").
+			Context(ctx).
+			Do()
+		if err != nil {
+			var apiErr *gapi.Error
+			if errors.As(err, &apiErr) && apiErr.Code == http.StatusNotFound {
+				return usagef("attachment: Drive file %q not found (pass a URL or a valid Drive file ID)", a.FileId)
+			}
+			return fmt.Errorf("attachment %s: %w", a.FileId, err)
+		}
+		a.FileId = f.Id
+		a.FileUrl = f.WebViewLink
+		if a.FileUrl == "" {
+			a.FileUrl = fmt.Sprintf("https://drive.google.com/file/d/%s/view", f.Id)
+		}
+		a.Title = f.Name
+		a.MimeType = f.MimeType
+		a.IconLink = f.IconLink
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+func TestResolveDriveAttachments(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		if r.URL.Query().Get("supportsAllDrives") != "true" {
+			http.Error(w, "missing supportsAllDrives", http.StatusBadRequest)
+			return
+		}
+		switch path {
+		case "/files/doc1":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":          "doc1",
+				"name":        "Agenda",
+				"mimeType":    driveMimeGoogleDoc,
+				"iconLink":    "https://icons/doc.png",
+				"webViewLink": "https://docs.google.com/document/d/doc1/edit",
+			})
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": 404, "message": "File not found"}})
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	atts := []*calendar.EventAttachment{
+		{FileUrl: "https://example.com/a"},
+		{FileId: "doc1"},
+	}
+	if err := resolveDriveAttachments(context.Background(), "a@b.com", atts); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+	if atts[0].FileUrl != "https://example.com/a" || atts[0].Title != "" {
+		t.Fatalf("url attachment changed: %#v", atts[0])
+	}
+	got := atts[1]
+	if got.FileUrl != "https://docs.google.com/document/d/doc1/edit" || got.Title != "Agenda" || got.MimeType != driveMimeGoogleDoc || got.IconLink != "https://icons/doc.png" {
+		t.Fatalf("unexpected drive attachment: %#v", got)
+	}
+
+	err = resolveDriveAttachments(context.Background(), "a@b.com", []*calendar.EventAttachment{{FileId: "missing"}})
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func TestResolveDriveAttachments_NoDriveFiles(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+	newDriveService = func(context.Context, string) (*drive.Service, error) {
+		t.Fatalf("drive service should not be created without Drive attachments")
+		return nil, nil
+	}
+
+	if err := resolveDriveAttachments(context.Background(), "a@b.com", []*calendar.EventAttachment{{FileUrl: "https://x"}}); err != nil {
+		t.Fatalf("resolve: %v", err)
+	}
+}
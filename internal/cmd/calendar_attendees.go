@@ -42,13 +42,24 @@ func mergeAttendees(existing []*calendar.EventAttendee, addCSV string) []*calend
 	out = append(out, existing...)
 
 	// Add new attendees that don't already exist
-	for _, email := range newEmails {
-		if !existingEmails[strings.ToLower(email)] {
-			out = append(out, &calendar.EventAttendee{
-				Email:          email,
-				ResponseStatus: "needsAction",
-			})
-			existingEmails[strings.ToLower(email)] = true
+	for _, raw := range newEmails {
+		attendee := parseAttendee(raw)
+		if attendee == nil || existingEmails[strings.ToLower(attendee.Email)] {
+			continue
+		}
+		attendee.ResponseStatus = "needsAction"
+		out = append(out, attendee)
+		existingEmails[strings.ToLower(attendee.Email)] = true
+	}
+	return out
+}
+
+// buildRoomAttendees turns --room values into resource attendees.
+func buildRoomAttendees(rooms []string) []*calendar.EventAttendee {
+	var out []*calendar.EventAttendee
+	for _, room := range rooms {
+		for _, email := range splitCSV(room) {
+			out = append(out, &calendar.EventAttendee{Email: email, Resource: true})
 		}
 	}
 	return out
@@ -60,12 +71,22 @@ func parseAttendee(s string) *calendar.EventAttendee {
 		return nil
 	}
 	parts := strings.Split(s, ";")
+	attendee := &calendar.EventAttendee{}
 	email := strings.TrimSpace(parts[0])
+	// email:optional and email:resource (combinable) mark optional guests
+	// and meeting rooms.
+	for {
+		i := strings.LastIndex(email, ":")
+		if i < 0 || !applyAttendeeSuffix(attendee, email[i+1:]) {
+			break
+		}
+		email = strings.TrimSpace(email[:i])
+	}
 	if email == "" {
 		return nil
 	}
+	attendee.Email = email
 
-	attendee := &calendar.EventAttendee{Email: email}
 	for _, p := range parts[1:] {
 		raw := strings.TrimSpace(p)
 		lower := strings.ToLower(raw)
@@ -79,3 +100,15 @@ func parseAttendee(s string) *calendar.EventAttendee {
 	}
 	return attendee
 }
+
+func applyAttendeeSuffix(attendee *calendar.EventAttendee, suffix string) bool {
+	switch strings.ToLower(strings.TrimSpace(suffix)) {
+	case "optional":
+		attendee.Optional = true
+	case "resource":
+		attendee.Resource = true
+	default:
+		return false
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -25,6 +26,80 @@ func buildEventDateTime(value string, allDay bool) *calendar.EventDateTime {
 	return edt
 }
 
+// resolveFlexibleEventTimes rewrites start/end values that aren't RFC3339 (or
+// YYYY-MM-DD for all-day events) through parseFlexibleTime, in the calendar's
+// timezone. The timezone is only fetched when a value needs it. A relative
+// offset (+3h, in 90 minutes) after the first value is anchored on the value
+// before it, so "--from 'tomorrow 3pm' --to +1h" ends an hour after the start.
+func resolveFlexibleEventTimes(ctx context.Context, svc *calendar.Service, calendarID string, now time.Time, times ...*calendar.EventDateTime) error {
+	var (
+		tzName string
+		loc    *time.Location
+		prev   *calendar.EventDateTime
+	)
+	for _, edt := range times {
+		if edt == nil {
+			continue
+		}
+		value, allDay := edt.DateTime, false
+		if value == "" {
+			value, allDay = edt.Date, true
+		}
+		if value == "" {
+			continue
+		}
+		if isStrictEventTime(value, allDay) {
+			prev = edt
+			continue
+		}
+		if loc == nil {
+			var err error
+			tzName, loc, err = getCalendarLocation(ctx, svc, calendarID)
+			if err != nil {
+				return err
+			}
+		}
+		base := now
+		if prev != nil && isRelativeOffset(value) {
+			if anchor, ok := eventTimeAnchor(prev, loc); ok {
+				base = anchor
+			}
+		}
+		t, err := parseFlexibleTime(value, base, loc)
+		if err != nil {
+			return usage(err.Error())
+		}
+		prev = edt
+		if allDay {
+			edt.Date = t.In(loc).Format("2006-01-02")
+			continue
+		}
+		edt.DateTime = t.In(loc).Format(time.RFC3339)
+		edt.TimeZone = tzName
+	}
+	return nil
+}
+
+// eventTimeAnchor returns the instant of a resolved start/end value; dates
+// are midnight in loc.
+func eventTimeAnchor(edt *calendar.EventDateTime, loc *time.Location) (time.Time, bool) {
+	if edt.DateTime != "" {
+		t, err := time.Parse(time.RFC3339, edt.DateTime)
+		return t, err == nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", edt.Date, loc)
+	return t, err == nil
+}
+
+func isStrictEventTime(value string, allDay bool) bool {
+	if allDay {
+		_, err := time.Parse("2006-01-02", value)
+		return err == nil
+	}
+	_, err := time.Parse(time.RFC3339, value)
+	return err == nil
+}
+
 // extractTimezone attempts to determine a timezone from an RFC3339 datetime string.
 // Returns an IANA timezone name if determinable, empty string otherwise.
 func extractTimezone(value string) string {
@@ -91,6 +166,40 @@ func buildRecurrence(rules []string) []string {
 	return out
 }
 
+// parseEventDuration parses --duration: Go durations (1h30m, 45m) or the
+// reminder-style forms accepted by parseDuration (30, 2h, 1d).
+func parseEventDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		minutes, minErr := parseDuration(s)
+		if minErr != nil {
+			return 0, fmt.Errorf("invalid --duration %q (expected e.g. 30m, 1h30m, 2h)", s)
+		}
+		d = time.Duration(minutes) * time.Minute
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("--duration must be positive")
+	}
+	return d, nil
+}
+
+// eventEndAfter builds the end of a timed event that lasts d from start.
+func eventEndAfter(start *calendar.EventDateTime, d time.Duration) (*calendar.EventDateTime, error) {
+	t, err := time.Parse(time.RFC3339, start.DateTime)
+	if err != nil {
+		return nil, usagef("--duration needs a timed --from, got %q", start.DateTime)
+	}
+	end := buildEventDateTime(t.Add(d).Format(time.RFC3339), false)
+	if start.TimeZone != "" {
+		end.TimeZone = start.TimeZone
+	}
+	return end, nil
+}
+
+// maxEventReminders is the Calendar API cap on reminder overrides.
+const maxEventReminders = 5
+
 var durationRegex = regexp.MustCompile(`^(\d+)(w|d|h|m)?$`)
 
 func parseDuration(s string) (int64, error) {
@@ -121,31 +230,34 @@ func parseDuration(s string) (int64, error) {
 	}
 
 	if value < 0 || value > 40320 {
-		return 0, fmt.Errorf("reminder duration must be 0-40320 minutes (got %d)", value)
+		return 0, fmt.Errorf("reminder duration must be 0-40320 minutes, i.e. at most 4 weeks (got %d)", value)
 	}
 
 	return value, nil
 }
 
+// parseReminder parses one --reminder value: method:duration or
+// method@duration (popup:30m, email@1d), or a bare duration (30m) for a popup.
 func parseReminder(s string) (string, int64, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
 		return "", 0, fmt.Errorf("empty reminder")
 	}
 
-	parts := strings.SplitN(s, ":", 2)
-	if len(parts) != 2 {
-		return "", 0, fmt.Errorf("invalid reminder format: %q (expected method:duration, e.g., popup:30m)", s)
-	}
-
-	method := strings.TrimSpace(strings.ToLower(parts[0]))
-	if method != "email" && method != "popup" {
-		return "", 0, fmt.Errorf("invalid reminder method: %q (expected 'email' or 'popup')", method)
+	method, duration := "popup", s
+	if i := strings.IndexAny(s, ":@"); i >= 0 {
+		method = strings.TrimSpace(strings.ToLower(s[:i]))
+		duration = s[i+1:]
+		if method != "email" && method != "popup" {
+			return "", 0, fmt.Errorf("invalid reminder method %q in %q (expected email or popup, e.g. email:1d)", method, s)
+		}
+	} else if durationRegex.FindStringSubmatch(strings.ToLower(s)) == nil {
+		return "", 0, fmt.Errorf("invalid reminder %q (expected a duration like 30m, 1h, 1d, optionally with a method: popup:30m, email@1d)", s)
 	}
 
-	minutes, err := parseDuration(parts[1])
+	minutes, err := parseDuration(duration)
 	if err != nil {
-		return "", 0, fmt.Errorf("invalid reminder duration: %w", err)
+		return "", 0, fmt.Errorf("invalid reminder duration in %q: %w", s, err)
 	}
 
 	return method, minutes, nil
@@ -168,15 +280,15 @@ func buildReminders(reminders []string) (*calendar.EventReminders, error) {
 		return nil, nil
 	}
 
-	if len(filtered) > 5 {
-		return nil, fmt.Errorf("maximum 5 reminders allowed (got %d)", len(filtered))
+	if len(filtered) > maxEventReminders {
+		return nil, fmt.Errorf("maximum %d reminders allowed per event (got %d)", maxEventReminders, len(filtered))
 	}
 
 	overrides := make([]*calendar.EventReminder, 0, len(filtered))
 	for _, r := range filtered {
 		method, minutes, err := parseReminder(r)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("--reminder: %w", err)
 		}
 		overrides = append(overrides, &calendar.EventReminder{
 			Method:  method,
@@ -192,6 +304,11 @@ func buildReminders(reminders []string) (*calendar.EventReminders, error) {
 	}, nil
 }
 
+var driveFileIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{20,}$`)
+
+// buildAttachments turns --attachment values into event attachments. URLs are
+// used as-is; Drive file IDs (bare or "drive:<id>") only carry FileId and are
+// filled in by resolveDriveAttachments.
 func buildAttachments(urls []string) []*calendar.EventAttachment {
 	if len(urls) == 0 {
 		return nil
@@ -199,13 +316,29 @@ func buildAttachments(urls []string) []*calendar.EventAttachment {
 	out := make([]*calendar.EventAttachment, 0, len(urls))
 	for _, u := range urls {
 		u = strings.TrimSpace(u)
-		if u != "" {
-			out = append(out, &calendar.EventAttachment{FileUrl: u})
+		if u == "" {
+			continue
 		}
+		if id, ok := driveAttachmentID(u); ok {
+			out = append(out, &calendar.EventAttachment{FileId: id})
+			continue
+		}
+		out = append(out, &calendar.EventAttachment{FileUrl: u})
 	}
 	return out
 }
 
+func driveAttachmentID(value string) (string, bool) {
+	if rest, ok := strings.CutPrefix(value, "drive:"); ok {
+		rest = strings.TrimSpace(rest)
+		return rest, rest != ""
+	}
+	if driveFileIDPattern.MatchString(value) {
+		return value, true
+	}
+	return "", false
+}
+
 func buildExtendedProperties(privateProps, sharedProps []string) *calendar.EventExtendedProperties {
 	if len(privateProps) == 0 && len(sharedProps) == 0 {
 		return nil
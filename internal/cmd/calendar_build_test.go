@@ -43,6 +43,20 @@ func TestBuildAttachments(t *testing.T) {
 	if out[0].FileUrl != "https://example.com/a" || out[1].FileUrl != "https://example.com/b" {
 		t.Fatalf("unexpected urls: %#v", out)
 	}
+
+	out = buildAttachments([]string{"drive:abc", "1A2b3C4d5E6f7G8h9I0jKlMnOp", "https://example.com/c"})
+	if len(out) != 3 {
+		t.Fatalf("expected 3 attachments, got %d", len(out))
+	}
+	if out[0].FileId != "abc" || out[0].FileUrl != "" {
+		t.Fatalf("unexpected drive: prefix attachment: %#v", out[0])
+	}
+	if out[1].FileId != "1A2b3C4d5E6f7G8h9I0jKlMnOp" || out[1].FileUrl != "" {
+		t.Fatalf("unexpected bare id attachment: %#v", out[1])
+	}
+	if out[2].FileId != "" || out[2].FileUrl != "https://example.com/c" {
+		t.Fatalf("unexpected url attachment: %#v", out[2])
+	}
 }
 
 func TestBuildExtendedProperties(t *testing.T) {
@@ -26,16 +26,13 @@ func (c *CalendarColorsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	colors, err := svc.Colors.Get().Do()
+	colors, err := svc.Colors.Get().Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"event":    colors.Event,
-			"calendar": colors.Calendar,
-		})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, colors)
 	}
 
 	if len(colors.Event) == 0 && len(colors.Calendar) == 0 {
@@ -46,7 +43,7 @@ func (c *CalendarColorsCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if len(colors.Event) > 0 {
 		fmt.Println("EVENT COLORS:")
 		tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
-		fmt.Fprintln(tw, "ID\tBACKGROUND\tFOREGROUND")
+		fmt.Fprintln(tw, "ID\tNAME\tBACKGROUND\tFOREGROUND")
 
 		ids := make([]int, 0, len(colors.Event))
 		for id := range colors.Event {
@@ -59,7 +56,11 @@ func (c *CalendarColorsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		for _, num := range ids {
 			id := strconv.Itoa(num)
 			c := colors.Event[id]
-			fmt.Fprintf(tw, "%s\t%s\t%s\n", id, c.Background, c.Foreground)
+			name := eventColorNames[id]
+			if name == "" {
+				name = "-"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", id, name, c.Background, c.Foreground)
 		}
 		_ = tw.Flush()
 		fmt.Println()
@@ -72,7 +72,7 @@ func (c *CalendarConflictsCmd) Run(ctx context.Context, flags *RootFlags) error
 		TimeMin: from,
 		TimeMax: to,
 		Items:   items,
-	}).Do()
+	}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -80,7 +80,7 @@ func (c *CalendarConflictsCmd) Run(ctx context.Context, flags *RootFlags) error
 	conflicts := detectConflicts(resp.Calendars)
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"conflicts": conflicts,
 			"count":     len(conflicts),
 		})
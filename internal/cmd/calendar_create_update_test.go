@@ -120,6 +120,63 @@ func TestCalendarCreateCmd_WithMeetAndAttachments(t *testing.T) {
 	}
 }
 
+func TestCalendarCreateCmd_OptionalAttendeesAndRooms(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var got calendar.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && path == "/calendars/cal/events":
+			_ = json.NewDecoder(r.Body).Decode(&got)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "ev1"})
+		case strings.HasPrefix(path, "/users/me/calendarList/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "cal", "timeZone": "UTC"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &CalendarCreateCmd{}, []string{
+			"cal", "--summary", "Sync", "--from", "2025-01-02T10:00:00Z", "--to", "2025-01-02T11:00:00Z",
+			"--attendees", "a@b.com,c@d.com:optional,room-a@resource.calendar.google.com:resource",
+			"--room", "room-b@resource.calendar.google.com",
+		}, context.Background(), &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("runKong: %v", err)
+		}
+	})
+
+	want := []calendar.EventAttendee{
+		{Email: "a@b.com"},
+		{Email: "c@d.com", Optional: true},
+		{Email: "room-a@resource.calendar.google.com", Resource: true},
+		{Email: "room-b@resource.calendar.google.com", Resource: true},
+	}
+	if len(got.Attendees) != len(want) {
+		t.Fatalf("unexpected attendees: %#v", got.Attendees)
+	}
+	for i, w := range want {
+		a := got.Attendees[i]
+		if a.Email != w.Email || a.Optional != w.Optional || a.Resource != w.Resource {
+			t.Fatalf("attendee %d = %#v, want %#v", i, a, w)
+		}
+	}
+}
+
 func TestCalendarUpdateCmd_RunJSON(t *testing.T) {
 	origNew := newCalendarService
 	t.Cleanup(func() { newCalendarService = origNew })
@@ -170,3 +170,67 @@ func TestCalendarDeleteCmd_ScopeFuture(t *testing.T) {
 		t.Fatalf("unexpected output: %#v", payload)
 	}
 }
+
+func TestCalendarDeleteCmd_SendUpdates(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var gotSendUpdates []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		if r.Method == http.MethodDelete && path == "/calendars/cal/events/ev" {
+			gotSendUpdates = append(gotSendUpdates, r.URL.Query().Get("sendUpdates"))
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com", Force: true}
+
+	var modes []string
+	for _, args := range [][]string{
+		{"cal", "ev"},
+		{"cal", "ev", "--send-updates", "externalonly"},
+		{"cal", "ev", "--send-updates", "none"},
+	} {
+		out := captureStdout(t, func() {
+			if err := runKong(t, &CalendarDeleteCmd{}, args, ctx, flags); err != nil {
+				t.Fatalf("delete %v: %v", args, err)
+			}
+		})
+		var payload struct {
+			SendUpdates string `json:"sendUpdates"`
+		}
+		if err := json.Unmarshal([]byte(out), &payload); err != nil {
+			t.Fatalf("decode output: %v", err)
+		}
+		modes = append(modes, payload.SendUpdates)
+	}
+	if strings.Join(gotSendUpdates, ",") != "all,externalOnly,none" || strings.Join(modes, ",") != "all,externalOnly,none" {
+		t.Fatalf("unexpected sendUpdates: sent=%v reported=%v", gotSendUpdates, modes)
+	}
+
+	if err := runKong(t, &CalendarDeleteCmd{}, []string{"cal", "ev", "--send-updates", "loud"}, ctx, flags); err == nil {
+		t.Fatalf("expected invalid --send-updates error")
+	}
+	if len(gotSendUpdates) != 3 {
+		t.Fatalf("invalid mode should not reach the API")
+	}
+}
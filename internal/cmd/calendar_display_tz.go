@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+)
+
+type displayTimezoneKey struct{}
+
+// displayTZTimes is the JSON "displayTz" block: an event's start/end
+// converted into the zone requested with --display-tz.
+type displayTZTimes struct {
+	Timezone string `json:"timezone"`
+	Start    string `json:"start,omitempty"`
+	End      string `json:"end,omitempty"`
+}
+
+func parseDisplayTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, usage("empty --display-tz")
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, usagef("invalid --display-tz %q: unknown IANA timezone", name)
+	}
+	return loc, nil
+}
+
+func withDisplayTimezone(ctx context.Context, loc *time.Location) context.Context {
+	if loc == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, displayTimezoneKey{}, loc)
+}
+
+func displayTimezoneFromContext(ctx context.Context) *time.Location {
+	if ctx == nil {
+		return nil
+	}
+	loc, _ := ctx.Value(displayTimezoneKey{}).(*time.Location)
+	return loc
+}
+
+func eventDisplayTimes(event *calendar.Event, loc *time.Location) *displayTZTimes {
+	if event == nil || loc == nil {
+		return nil
+	}
+	return &displayTZTimes{
+		Timezone: loc.String(),
+		Start:    formatEventLocal(event.Start, loc),
+		End:      formatEventLocal(event.End, loc),
+	}
+}
+
+// withDisplayTime appends the --display-tz equivalent of a timed event
+// boundary, e.g. "2025-01-02T10:00:00Z (2025-01-02 19:00 JST)". All-day
+// boundaries are returned unchanged.
+func withDisplayTime(value string, dt *calendar.EventDateTime, loc *time.Location) string {
+	if loc == nil || dt == nil || dt.DateTime == "" {
+		return value
+	}
+	t, ok := parseEventTime(dt.DateTime, dt.TimeZone)
+	if !ok {
+		return value
+	}
+	return value + " (" + t.In(loc).Format("2006-01-02 15:04 MST") + ")"
+}
+
+// marshalEventWithDisplayTZ renders the event exactly as the embedded
+// *calendar.Event would and only adds "displayTz" when --display-tz is set,
+// so existing --json consumers see the same fields as before.
+func marshalEventWithDisplayTZ(event *calendar.Event, display *displayTZTimes) ([]byte, error) {
+	b, err := json.Marshal(event)
+	if err != nil || event == nil || display == nil {
+		return b, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if fields["displayTz"], err = json.Marshal(display); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func newDisplayTZTestService(t *testing.T) {
+	t.Helper()
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	srv := httptest.NewServer(withPrimaryCalendar(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/events") && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{
+					{
+						"id":      "event1",
+						"summary": "Sync",
+						"start":   map[string]any{"dateTime": "2024-01-15T10:00:00Z"},
+						"end":     map[string]any{"dateTime": "2024-01-15T11:00:00Z"},
+					},
+				},
+			})
+			return
+		}
+		http.NotFound(w, r)
+	})))
+	t.Cleanup(srv.Close)
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+}
+
+func TestCalendarDisplayTZ_JSON(t *testing.T) {
+	newDisplayTZTestService(t)
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "search", "sync", "--display-tz", "Asia/Tokyo"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed struct {
+		Events []struct {
+			ID        string `json:"id"`
+			DisplayTZ struct {
+				Timezone string `json:"timezone"`
+				Start    string `json:"start"`
+				End      string `json:"end"`
+			} `json:"displayTz"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Events) != 1 {
+		t.Fatalf("unexpected events: %s", out)
+	}
+	got := parsed.Events[0].DisplayTZ
+	if got.Timezone != "Asia/Tokyo" || got.Start != "2024-01-15T19:00:00+09:00" || got.End != "2024-01-15T20:00:00+09:00" {
+		t.Fatalf("unexpected displayTz: %#v", got)
+	}
+}
+
+func TestCalendarDisplayTZ_Text(t *testing.T) {
+	newDisplayTZTestService(t)
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--account", "a@b.com", "calendar", "--display-tz", "Asia/Tokyo", "events", "primary", "--from", "2024-01-15", "--to", "2024-01-16"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "2024-01-15T10:00:00Z (2024-01-15 19:00 JST)") {
+		t.Fatalf("missing display time: %q", out)
+	}
+}
+
+func TestCalendarDisplayTZ_InvalidZone(t *testing.T) {
+	_ = captureStderr(t, func() {
+		err := Execute([]string{"--account", "a@b.com", "calendar", "search", "sync", "--display-tz", "Mars/Base"})
+		if err == nil || !strings.Contains(err.Error(), "Mars/Base") {
+			t.Fatalf("expected invalid timezone error, got %v", err)
+		}
+	})
+}
+
+func TestEventWithDays_MarshalKeepsEventShape(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	ev := &calendar.Event{
+		Id:    "ev",
+		Start: &calendar.EventDateTime{DateTime: "2024-01-15T10:00:00Z"},
+		End:   &calendar.EventDateTime{DateTime: "2024-01-15T11:00:00Z"},
+	}
+	plain, err := json.Marshal(ev)
+	if err != nil {
+		t.Fatalf("marshal event: %v", err)
+	}
+
+	wrapped := wrapEventWithDaysWithTimezone(ev, "UTC", time.UTC)
+	b, err := json.Marshal(wrapped)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(b) != string(plain) {
+		t.Fatalf("without --display-tz the JSON must match the event:\n%s\nwant:\n%s", b, plain)
+	}
+
+	wrapped.DisplayTZ = eventDisplayTimes(ev, loc)
+	if b, err = json.Marshal(wrapped); err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var got map[string]json.RawMessage
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	var want map[string]json.RawMessage
+	_ = json.Unmarshal(plain, &want)
+	if len(got) != len(want)+1 || !strings.Contains(string(got["displayTz"]), `"timezone":"Asia/Tokyo"`) {
+		t.Fatalf("expected the event fields plus displayTz only, got %s", b)
+	}
+	for k := range want {
+		if _, ok := got[k]; !ok {
+			t.Fatalf("missing event field %q in %s", k, b)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarDuplicateCmd struct {
+	CalendarID  string `arg:"" name:"calendarId" help:"Calendar ID"`
+	EventID     string `arg:"" name:"eventId" help:"Event ID to copy"`
+	Shift       string `name:"shift" help:"Move the copy by this much (e.g. 1w, 2d, -3h, 1h30m)"`
+	From        string `name:"from" help:"New start time for the copy (RFC3339, date for all-day events, or relative: next monday 09:00); keeps the original length"`
+	SendUpdates string `name:"send-updates" help:"Notification mode for the copy's attendees: all, externalOnly, none (default: all)"`
+}
+
+func (c *CalendarDuplicateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	eventID := strings.TrimSpace(c.EventID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+	if eventID == "" {
+		return usage("empty eventId")
+	}
+	shiftValue := strings.TrimSpace(c.Shift)
+	from := strings.TrimSpace(c.From)
+	if shiftValue != "" && from != "" {
+		return usage("use either --shift or --from, not both")
+	}
+	var shift eventShift
+	if shiftValue != "" {
+		if shift, err = parseEventShift(shiftValue); err != nil {
+			return usage(err.Error())
+		}
+	}
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	src, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if src.Status == "cancelled" {
+		return fmt.Errorf("event %s is cancelled; nothing to duplicate", eventID)
+	}
+	if src.Start == nil || src.End == nil {
+		return fmt.Errorf("event %s has no start/end time", eventID)
+	}
+
+	if from != "" {
+		start := buildEventDateTime(from, isAllDayEvent(src))
+		if err = resolveFlexibleEventTimes(ctx, svc, calendarID, time.Now(), start); err != nil {
+			return err
+		}
+		if shift, err = shiftBetween(src.Start, start); err != nil {
+			return err
+		}
+	}
+
+	event := duplicateEvent(src)
+	if err = shift.applyToEvent(event); err != nil {
+		return err
+	}
+
+	call := svc.Events.Insert(calendarID, event)
+	if sendUpdates != "" {
+		call = call.SendUpdates(sendUpdates)
+	}
+	if len(event.Attachments) > 0 {
+		call = call.SupportsAttachments(true)
+	}
+	created, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	tz, loc, _ := getCalendarLocation(ctx, svc, calendarID)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
+	}
+	printCalendarEventWithTimezone(u, created, tz, loc)
+	return nil
+}
+
+// duplicateEvent copies src without the fields the server assigns, so it can
+// be inserted as a new event. A copied recurring instance becomes a standalone
+// event, guests start over as needsAction, and the Meet link is not shared.
+func duplicateEvent(src *calendar.Event) *calendar.Event {
+	dup := *src
+	dup.Id = ""
+	dup.Etag = ""
+	dup.ICalUID = ""
+	dup.Sequence = 0
+	dup.HtmlLink = ""
+	dup.Created = ""
+	dup.Updated = ""
+	dup.Creator = nil
+	dup.Organizer = nil
+	dup.RecurringEventId = ""
+	dup.OriginalStartTime = nil
+	dup.HangoutLink = ""
+	dup.ConferenceData = nil
+	dup.Locked = false
+	dup.PrivateCopy = false
+
+	start := *src.Start
+	end := *src.End
+	dup.Start = &start
+	dup.End = &end
+	if len(src.Recurrence) > 0 {
+		dup.Recurrence = append([]string(nil), src.Recurrence...)
+	}
+	if len(src.Attendees) > 0 {
+		dup.Attendees = make([]*calendar.EventAttendee, 0, len(src.Attendees))
+		for _, a := range src.Attendees {
+			if a == nil {
+				continue
+			}
+			copied := *a
+			copied.Id = ""
+			copied.Self = false
+			copied.Organizer = false
+			copied.Comment = ""
+			copied.ResponseStatus = "needsAction"
+			dup.Attendees = append(dup.Attendees, &copied)
+		}
+	}
+	return &dup
+}
+
+// eventShift moves an event by whole calendar days plus a fixed duration.
+// Days are added on the wall clock so a 1w shift keeps the local time across
+// DST changes.
+type eventShift struct {
+	days int
+	dur  time.Duration
+}
+
+var eventShiftRegex = regexp.MustCompile(`^([+-]?)(\d+)(w|d|h|m)$`)
+
+// parseEventShift parses --shift: 1w, 2d, -3h, 45m, or any Go duration.
+func parseEventShift(s string) (eventShift, error) {
+	s = strings.TrimSpace(strings.ToLower(s))
+	var shift eventShift
+	if match := eventShiftRegex.FindStringSubmatch(s); match != nil {
+		n, err := strconv.Atoi(match[2])
+		if err != nil {
+			return eventShift{}, fmt.Errorf("invalid --shift %q", s)
+		}
+		if match[1] == "-" {
+			n = -n
+		}
+		switch match[3] {
+		case "w":
+			shift.days = 7 * n
+		case "d":
+			shift.days = n
+		case "h":
+			shift.dur = time.Duration(n) * time.Hour
+		case "m":
+			shift.dur = time.Duration(n) * time.Minute
+		}
+	} else {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return eventShift{}, fmt.Errorf("invalid --shift %q (expected e.g. 1w, 2d, -3h, 1h30m)", s)
+		}
+		shift.dur = d
+	}
+	if shift.isZero() {
+		return eventShift{}, fmt.Errorf("--shift must not be zero")
+	}
+	return shift, nil
+}
+
+// shiftBetween returns the shift that moves from to to. Both must be of the
+// same kind (all-day or timed).
+func shiftBetween(from, to *calendar.EventDateTime) (eventShift, error) {
+	if from.Date != "" {
+		if to.Date == "" {
+			return eventShift{}, usage("event is all-day; --from must be a date (YYYY-MM-DD)")
+		}
+		a, err := time.Parse("2006-01-02", from.Date)
+		if err != nil {
+			return eventShift{}, fmt.Errorf("invalid event start date %q", from.Date)
+		}
+		b, err := time.Parse("2006-01-02", to.Date)
+		if err != nil {
+			return eventShift{}, usagef("invalid --from date %q", to.Date)
+		}
+		return eventShift{days: int(b.Sub(a).Hours() / 24)}, nil
+	}
+	a, err := time.Parse(time.RFC3339, from.DateTime)
+	if err != nil {
+		return eventShift{}, fmt.Errorf("invalid event start %q", from.DateTime)
+	}
+	b, err := time.Parse(time.RFC3339, to.DateTime)
+	if err != nil {
+		return eventShift{}, usagef("invalid --from %q", to.DateTime)
+	}
+	return eventShift{dur: b.Sub(a)}, nil
+}
+
+func (s eventShift) isZero() bool {
+	return s.days == 0 && s.dur == 0
+}
+
+func (s eventShift) apply(t time.Time) time.Time {
+	return t.AddDate(0, 0, s.days).Add(s.dur)
+}
+
+// applyToEvent moves start, end and any dated recurrence values (EXDATE,
+// RDATE, UNTIL) so exceptions still line up with the shifted series.
+func (s eventShift) applyToEvent(e *calendar.Event) error {
+	if s.isZero() {
+		return nil
+	}
+	if isAllDayEvent(e) && s.dur%(24*time.Hour) != 0 {
+		return usage("all-day events can only be shifted by whole days")
+	}
+	for _, edt := range []*calendar.EventDateTime{e.Start, e.End} {
+		if err := s.applyToDateTime(edt); err != nil {
+			return err
+		}
+	}
+	for i, rule := range e.Recurrence {
+		e.Recurrence[i] = s.applyToRecurrence(rule)
+	}
+	return nil
+}
+
+func (s eventShift) wholeDays() int {
+	return s.days + int(s.dur/(24*time.Hour))
+}
+
+func (s eventShift) applyToDateTime(edt *calendar.EventDateTime) error {
+	if edt.Date != "" {
+		t, err := time.Parse("2006-01-02", edt.Date)
+		if err != nil {
+			return fmt.Errorf("invalid event date %q", edt.Date)
+		}
+		edt.Date = t.AddDate(0, 0, s.wholeDays()).Format("2006-01-02")
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, edt.DateTime)
+	if err != nil {
+		return fmt.Errorf("invalid event time %q", edt.DateTime)
+	}
+	if edt.TimeZone != "" {
+		if loc, locErr := time.LoadLocation(edt.TimeZone); locErr == nil {
+			t = t.In(loc)
+		}
+	}
+	edt.DateTime = s.apply(t).Format(time.RFC3339)
+	return nil
+}
+
+func (s eventShift) applyToRecurrence(rule string) string {
+	upper := strings.ToUpper(rule)
+	switch {
+	case strings.HasPrefix(upper, "EXDATE"), strings.HasPrefix(upper, "RDATE"):
+		idx := strings.LastIndex(rule, ":")
+		if idx < 0 {
+			return rule
+		}
+		values := strings.Split(rule[idx+1:], ",")
+		for i, v := range values {
+			values[i] = s.applyToICalValue(strings.TrimSpace(v))
+		}
+		return rule[:idx+1] + strings.Join(values, ",")
+	case strings.HasPrefix(upper, "RRULE"):
+		parts := strings.Split(rule, ";")
+		for i, part := range parts {
+			key, value, ok := strings.Cut(part, "=")
+			if ok && strings.HasSuffix(strings.ToUpper(key), "UNTIL") {
+				parts[i] = key + "=" + s.applyToICalValue(value)
+			}
+		}
+		return strings.Join(parts, ";")
+	}
+	return rule
+}
+
+func (s eventShift) applyToICalValue(v string) string {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return s.apply(t).Format(layout)
+		}
+	}
+	if t, err := time.Parse("20060102", v); err == nil {
+		return t.AddDate(0, 0, s.wholeDays()).Format("20060102")
+	}
+	return v
+}
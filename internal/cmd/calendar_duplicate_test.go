@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestParseEventShift(t *testing.T) {
+	cases := map[string]eventShift{
+		"1w":    {days: 7},
+		"-2d":   {days: -2},
+		"3h":    {dur: 3 * time.Hour},
+		"45m":   {dur: 45 * time.Minute},
+		"1h30m": {dur: 90 * time.Minute},
+	}
+	for in, want := range cases {
+		got, err := parseEventShift(in)
+		if err != nil || got != want {
+			t.Fatalf("parseEventShift(%q) = %+v, %v; want %+v", in, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "0d", "soon", "1y"} {
+		if _, err := parseEventShift(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestEventShift_ApplyToEvent(t *testing.T) {
+	e := &calendar.Event{
+		Start: &calendar.EventDateTime{DateTime: "2025-03-03T09:00:00-05:00", TimeZone: "America/New_York"},
+		End:   &calendar.EventDateTime{DateTime: "2025-03-03T10:00:00-05:00", TimeZone: "America/New_York"},
+		Recurrence: []string{
+			"RRULE:FREQ=WEEKLY;UNTIL=20250331T140000Z",
+			"EXDATE;TZID=America/New_York:20250310T090000,20250317T090000",
+		},
+	}
+	if err := (eventShift{days: 7}).applyToEvent(e); err != nil {
+		t.Fatalf("applyToEvent: %v", err)
+	}
+	// The week crosses the DST change; the local time stays at 09:00.
+	if e.Start.DateTime != "2025-03-10T09:00:00-04:00" || e.End.DateTime != "2025-03-10T10:00:00-04:00" {
+		t.Fatalf("unexpected times: %s – %s", e.Start.DateTime, e.End.DateTime)
+	}
+	if e.Recurrence[0] != "RRULE:FREQ=WEEKLY;UNTIL=20250407T140000Z" {
+		t.Fatalf("unexpected rrule: %s", e.Recurrence[0])
+	}
+	if e.Recurrence[1] != "EXDATE;TZID=America/New_York:20250317T090000,20250324T090000" {
+		t.Fatalf("unexpected exdate: %s", e.Recurrence[1])
+	}
+
+	allDay := &calendar.Event{Start: &calendar.EventDateTime{Date: "2025-01-01"}, End: &calendar.EventDateTime{Date: "2025-01-02"}}
+	if err := (eventShift{dur: 3 * time.Hour}).applyToEvent(allDay); err == nil {
+		t.Fatalf("expected error for partial-day shift of all-day event")
+	}
+}
+
+func TestCalendarDuplicateCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var (
+		inserted    map[string]any
+		sendUpdates string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && path == "/calendars/cal/events/ev1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":       "ev1",
+				"etag":     "\"1\"",
+				"iCalUID":  "ev1@google.com",
+				"sequence": 3,
+				"summary":  "Planning",
+				"start":    map[string]any{"dateTime": "2025-01-06T10:00:00Z"},
+				"end":      map[string]any{"dateTime": "2025-01-06T11:30:00Z"},
+				"attendees": []map[string]any{
+					{"email": "me@example.com", "self": true, "organizer": true, "responseStatus": "accepted"},
+					{"email": "bob@example.com", "responseStatus": "declined", "optional": true},
+				},
+			})
+		case r.Method == http.MethodPost && path == "/calendars/cal/events":
+			sendUpdates = r.URL.Query().Get("sendUpdates")
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			resp := map[string]any{"id": "ev2"}
+			for k, v := range inserted {
+				resp[k] = v
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &CalendarDuplicateCmd{}, []string{"cal", "ev1", "--from", "2025-01-13T15:00:00Z", "--send-updates", "none"}, ctx, flags); err != nil {
+			t.Fatalf("duplicate: %v", err)
+		}
+	})
+	for _, key := range []string{"id", "etag", "iCalUID", "sequence"} {
+		if _, ok := inserted[key]; ok {
+			t.Fatalf("server-assigned %q was copied: %v", key, inserted)
+		}
+	}
+	start, _ := inserted["start"].(map[string]any)
+	end, _ := inserted["end"].(map[string]any)
+	if start["dateTime"] != "2025-01-13T15:00:00Z" || end["dateTime"] != "2025-01-13T16:30:00Z" {
+		t.Fatalf("unexpected times: start=%v end=%v", start, end)
+	}
+	attendees, _ := inserted["attendees"].([]any)
+	if len(attendees) != 2 {
+		t.Fatalf("unexpected attendees: %v", inserted["attendees"])
+	}
+	for _, a := range attendees {
+		if a.(map[string]any)["responseStatus"] != "needsAction" {
+			t.Fatalf("expected responses reset, got %v", a)
+		}
+	}
+	if sendUpdates != "none" {
+		t.Fatalf("unexpected sendUpdates: %q", sendUpdates)
+	}
+	var parsed struct {
+		Event struct {
+			ID string `json:"id"`
+		} `json:"event"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil || parsed.Event.ID != "ev2" {
+		t.Fatalf("unexpected output: %v %q", err, out)
+	}
+
+	if err := runKong(t, &CalendarDuplicateCmd{}, []string{"cal", "ev1", "--from", "2025-01-13T15:00:00Z", "--shift", "1w"}, ctx, flags); err == nil {
+		t.Fatalf("expected error for --from with --shift")
+	}
+}
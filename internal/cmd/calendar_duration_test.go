@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarCreateCmd_Duration(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var got calendar.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && path == "/calendars/cal/events":
+			_ = json.NewDecoder(r.Body).Decode(&got)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "ev1"})
+		case strings.HasPrefix(path, "/users/me/calendarList/"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "cal", "timeZone": "UTC"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &CalendarCreateCmd{}, []string{
+			"cal", "--summary", "Focus", "--from", "2025-01-02T10:00:00-05:00", "--duration", "1h30m",
+		}, context.Background(), &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("runKong: %v", err)
+		}
+	})
+	if got.End == nil || got.End.DateTime != "2025-01-02T11:30:00-05:00" {
+		t.Fatalf("unexpected end: %#v", got.End)
+	}
+
+	// --duration is ignored for all-day events, which then last one day.
+	got = calendar.Event{}
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &CalendarCreateCmd{}, []string{
+			"cal", "--summary", "Offsite", "--from", "2025-01-02", "--all-day", "--duration", "3d",
+		}, context.Background(), &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("runKong all-day: %v", err)
+		}
+	})
+	if got.End == nil || got.End.Date != "2025-01-03" || got.End.DateTime != "" {
+		t.Fatalf("unexpected all-day end: %#v", got.End)
+	}
+
+	for _, args := range [][]string{
+		{"cal", "--summary", "x", "--from", "2025-01-02T10:00:00Z", "--to", "2025-01-02T11:00:00Z", "--duration", "1h"},
+		{"cal", "--summary", "x", "--from", "2025-01-02T10:00:00Z", "--duration", "soon"},
+		{"cal", "--summary", "x", "--from", "2025-01-02T10:00:00Z"},
+	} {
+		if err := runKong(t, &CalendarCreateCmd{}, args, context.Background(), &RootFlags{Account: "a@b.com"}); err == nil {
+			t.Fatalf("expected usage error for %v", args)
+		}
+	}
+}
+
+func TestParseEventDuration(t *testing.T) {
+	for in, want := range map[string]time.Duration{
+		"45m":   45 * time.Minute,
+		"1h30m": 90 * time.Minute,
+		"30":    30 * time.Minute,
+		"1d":    24 * time.Hour,
+	} {
+		got, err := parseEventDuration(in)
+		if err != nil || got != want {
+			t.Fatalf("parseEventDuration(%q) = %v, %v; want %v", in, got, err, want)
+		}
+	}
+	for _, bad := range []string{"", "0m", "-1h", "x"} {
+		if _, err := parseEventDuration(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
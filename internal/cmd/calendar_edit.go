@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"google.golang.org/api/calendar/v3"
@@ -15,16 +17,19 @@ import (
 
 type CalendarCreateCmd struct {
 	CalendarID            string   `arg:"" name:"calendarId" help:"Calendar ID"`
+	EventID               string   `name:"event-id" help:"Client-assigned event ID (5-1024 chars, a-v and 0-9); makes retries return the existing event instead of a duplicate"`
 	Summary               string   `name:"summary" help:"Event summary/title"`
-	From                  string   `name:"from" help:"Start time (RFC3339)"`
-	To                    string   `name:"to" help:"End time (RFC3339)"`
+	From                  string   `name:"from" help:"Start time (RFC3339, or relative: tomorrow 3pm, next monday 09:00, +2h)"`
+	To                    string   `name:"to" help:"End time (RFC3339, or relative: tomorrow 4pm; offsets like +3h count from --from)"`
+	Duration              string   `name:"duration" help:"Event length instead of --to (e.g. 30m, 1h30m, 2h); ignored for all-day events"`
 	Description           string   `name:"description" help:"Description"`
 	Location              string   `name:"location" help:"Location"`
-	Attendees             string   `name:"attendees" help:"Comma-separated attendee emails"`
+	Attendees             string   `name:"attendees" help:"Comma-separated attendee emails (suffix :optional for optional guests, :resource for rooms)"`
+	Rooms                 []string `name:"room" help:"Meeting room (resource calendar email) to book; can be repeated"`
 	AllDay                bool     `name:"all-day" help:"All-day event (use date-only in --from/--to)"`
 	Recurrence            []string `name:"rrule" help:"Recurrence rules (e.g., 'RRULE:FREQ=MONTHLY;BYMONTHDAY=11'). Can be repeated."`
-	Reminders             []string `name:"reminder" help:"Custom reminders as method:duration (e.g., popup:30m, email:1d). Can be repeated (max 5)."`
-	ColorId               string   `name:"event-color" help:"Event color ID (1-11). Use 'gog calendar colors' to see available colors."`
+	Reminders             []string `name:"reminder" help:"Custom reminders: a duration for a popup (30m, 1h, 1d) or method:duration / method@duration (popup:30m, email@1d). Can be repeated (max 5)."`
+	ColorId               string   `name:"event-color" help:"Event color ID (1-11) or name (e.g. tomato, sage). Use 'gog calendar colors' to see available colors."`
 	Visibility            string   `name:"visibility" help:"Event visibility: default, public, private, confidential"`
 	Transparency          string   `name:"transparency" help:"Show as busy (opaque) or free (transparent). Aliases: busy, free"`
 	SendUpdates           string   `name:"send-updates" help:"Notification mode: all, externalOnly, none (default: all)"`
@@ -34,7 +39,7 @@ type CalendarCreateCmd struct {
 	WithMeet              bool     `name:"with-meet" help:"Create a Google Meet video conference for this event"`
 	SourceUrl             string   `name:"source-url" help:"URL where event was created/imported from"`
 	SourceTitle           string   `name:"source-title" help:"Title of the source"`
-	Attachments           []string `name:"attachment" help:"File attachment URL (can be repeated)"`
+	Attachments           []string `name:"attachment" help:"File attachment URL or Drive file ID (bare or drive:<id>); can be repeated"`
 	PrivateProps          []string `name:"private-prop" help:"Private extended property (key=value, can be repeated)"`
 	SharedProps           []string `name:"shared-prop" help:"Shared extended property (key=value, can be repeated)"`
 	EventType             string   `name:"event-type" help:"Event type: default, focus-time, out-of-office, working-location"`
@@ -62,6 +67,13 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty calendarId")
 	}
 
+	eventID := strings.TrimSpace(c.EventID)
+	if eventID != "" {
+		if err = validateCalendarEventID(eventID); err != nil {
+			return err
+		}
+	}
+
 	eventType, err := c.resolveCreateEventType()
 	if err != nil {
 		return err
@@ -71,8 +83,12 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if summary == "" {
 		summary = c.defaultSummaryForEventType(eventType)
 	}
-	if summary == "" || strings.TrimSpace(c.From) == "" || strings.TrimSpace(c.To) == "" {
-		return usage("required: --summary, --from, --to")
+	duration := strings.TrimSpace(c.Duration)
+	if duration != "" && strings.TrimSpace(c.To) != "" {
+		return usage("use either --to or --duration, not both")
+	}
+	if summary == "" || strings.TrimSpace(c.From) == "" || (strings.TrimSpace(c.To) == "" && duration == "") {
+		return usage("required: --summary, --from, --to (or --duration)")
 	}
 
 	colorId, err := validateColorId(c.ColorId)
@@ -100,6 +116,17 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
+	var eventDuration time.Duration
+	if duration != "" && allDay {
+		// All-day events span whole days; without --to they last one day.
+		if u != nil {
+			u.Err().Println("Warning: --duration is ignored for all-day events")
+		}
+	} else if duration != "" {
+		if eventDuration, err = parseEventDuration(duration); err != nil {
+			return usage(err.Error())
+		}
+	}
 	transparency = applyEventTypeTransparencyDefault(transparency, eventType)
 
 	svc, err := newCalendarService(ctx, account)
@@ -108,12 +135,12 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	event := &calendar.Event{
+		Id:                 eventID,
 		Summary:            summary,
 		Description:        strings.TrimSpace(c.Description),
 		Location:           strings.TrimSpace(c.Location),
 		Start:              buildEventDateTime(c.From, allDay),
-		End:                buildEventDateTime(c.To, allDay),
-		Attendees:          buildAttendees(c.Attendees),
+		Attendees:          append(buildAttendees(c.Attendees), buildRoomAttendees(c.Rooms)...),
 		Recurrence:         buildRecurrence(c.Recurrence),
 		Reminders:          reminders,
 		ColorId:            colorId,
@@ -142,6 +169,31 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err = c.applyCreateEventType(event, eventType); err != nil {
 		return err
 	}
+	if eventID != "" {
+		setCreateRequestFingerprint(event, createRequestFingerprint(
+			summary, event.Description, event.Location, calendarID, c.From, c.To, duration, strconv.FormatBool(allDay)))
+	}
+	if err = resolveDriveAttachments(ctx, account, event.Attachments); err != nil {
+		return err
+	}
+	if eventDuration == 0 {
+		event.End = buildEventDateTime(c.To, allDay)
+	}
+	if err = resolveFlexibleEventTimes(ctx, svc, calendarID, time.Now(), event.Start, event.End); err != nil {
+		return err
+	}
+	if eventDuration > 0 {
+		if event.End, err = eventEndAfter(event.Start, eventDuration); err != nil {
+			return err
+		}
+	}
+	if allDay && event.End.Date == "" {
+		start, parseErr := time.Parse("2006-01-02", event.Start.Date)
+		if parseErr != nil {
+			return usagef("invalid all-day --from %q (want YYYY-MM-DD)", event.Start.Date)
+		}
+		event.End = &calendar.EventDateTime{Date: start.AddDate(0, 0, 1).Format("2006-01-02")}
+	}
 
 	call := svc.Events.Insert(calendarID, event)
 	if sendUpdates != "" {
@@ -153,13 +205,28 @@ func (c *CalendarCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if len(event.Attachments) > 0 {
 		call = call.SupportsAttachments(true)
 	}
-	created, err := call.Do()
+	created, err := call.Context(ctx).Do()
+	alreadyExisted := false
 	if err != nil {
-		return err
+		if eventID == "" || !isConflictAPIError(err) {
+			return err
+		}
+		// A retried create with the same --event-id: hand back the stored event.
+		if created, err = existingCreatedEvent(ctx, svc, calendarID, event); err != nil {
+			return err
+		}
+		alreadyExisted = true
 	}
 	tz, loc, _ := getCalendarLocation(ctx, svc, calendarID)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
+		payload := map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)}
+		if eventID != "" {
+			payload["created"] = !alreadyExisted
+		}
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
+	}
+	if alreadyExisted {
+		u.Err().Printf("Event %s already exists; returning it instead of creating a duplicate", eventID)
 	}
 	printCalendarEventWithTimezone(u, created, tz, loc)
 	return nil
@@ -298,16 +365,16 @@ type CalendarUpdateCmd struct {
 	CalendarID            string   `arg:"" name:"calendarId" help:"Calendar ID"`
 	EventID               string   `arg:"" name:"eventId" help:"Event ID"`
 	Summary               string   `name:"summary" help:"New summary/title (set empty to clear)"`
-	From                  string   `name:"from" help:"New start time (RFC3339; set empty to clear)"`
-	To                    string   `name:"to" help:"New end time (RFC3339; set empty to clear)"`
+	From                  string   `name:"from" help:"New start time (RFC3339 or relative, e.g. tomorrow 3pm; set empty to clear)"`
+	To                    string   `name:"to" help:"New end time (RFC3339 or relative, e.g. tomorrow 4pm; offsets like +1h count from --from when given; set empty to clear)"`
 	Description           string   `name:"description" help:"New description (set empty to clear)"`
 	Location              string   `name:"location" help:"New location (set empty to clear)"`
 	Attendees             string   `name:"attendees" help:"Comma-separated attendee emails (replaces all; set empty to clear)"`
 	AddAttendee           string   `name:"add-attendee" help:"Comma-separated attendee emails to add (preserves existing attendees)"`
 	AllDay                bool     `name:"all-day" help:"All-day event (use date-only in --from/--to)"`
 	Recurrence            []string `name:"rrule" help:"Recurrence rules (e.g., 'RRULE:FREQ=MONTHLY;BYMONTHDAY=11'). Can be repeated. Set empty to clear."`
-	Reminders             []string `name:"reminder" help:"Custom reminders as method:duration (e.g., popup:30m, email:1d). Can be repeated (max 5). Set empty to clear."`
-	ColorId               string   `name:"event-color" help:"Event color ID (1-11, or empty to clear)"`
+	Reminders             []string `name:"reminder" help:"Custom reminders: a duration for a popup (30m, 1h, 1d) or method:duration / method@duration (popup:30m, email@1d). Can be repeated (max 5). Set empty to clear."`
+	ColorId               string   `name:"event-color" help:"Event color ID (1-11) or name (e.g. tomato), or empty to clear"`
 	Visibility            string   `name:"visibility" help:"Event visibility: default, public, private, confidential"`
 	Transparency          string   `name:"transparency" help:"Show as busy (opaque) or free (transparent). Aliases: busy, free"`
 	GuestsCanInviteOthers *bool    `name:"guests-can-invite" help:"Allow guests to invite others"`
@@ -408,13 +475,16 @@ func (c *CalendarUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *
 	if !changed {
 		return usage("no updates provided")
 	}
+	if err = resolveFlexibleEventTimes(ctx, svc, calendarID, time.Now(), patch.Start, patch.End); err != nil {
+		return err
+	}
 
 	targetEventID, parentRecurrence, err := applyUpdateScope(ctx, svc, calendarID, eventID, scope, c.OriginalStartTime, patch)
 	if err != nil {
 		return err
 	}
 
-	updated, err := svc.Events.Patch(calendarID, targetEventID, patch).Do()
+	updated, err := svc.Events.Patch(calendarID, targetEventID, patch).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -425,7 +495,7 @@ func (c *CalendarUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *
 	}
 	tz, loc, _ := getCalendarLocation(ctx, svc, calendarID)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(updated, tz, loc)})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(updated, tz, loc)})
 	}
 	printCalendarEventWithTimezone(u, updated, tz, loc)
 	return nil
@@ -805,6 +875,7 @@ type CalendarDeleteCmd struct {
 	EventID           string `arg:"" name:"eventId" help:"Event ID"`
 	Scope             string `name:"scope" help:"For recurring events: single, future, all" default:"all"`
 	OriginalStartTime string `name:"original-start" help:"Original start time of instance (required for scope=single,future)"`
+	SendUpdates       string `name:"send-updates" help:"Cancellation notices: all, externalOnly, none (default: all)"`
 }
 
 func (c *CalendarDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -839,6 +910,14 @@ func (c *CalendarDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 	default:
 		return fmt.Errorf("invalid scope: %q (must be single, future, or all)", scope)
 	}
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return usage(err.Error())
+	}
+	// Notify by default; events without attendees have no one to notify.
+	if sendUpdates == "" {
+		sendUpdates = scopeAll
+	}
 
 	confirmMessage := fmt.Sprintf("delete event %s from calendar %s", eventID, calendarID)
 	if scope == scopeSingle {
@@ -876,7 +955,7 @@ func (c *CalendarDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 		targetEventID = instanceID
 	}
 
-	if err := svc.Events.Delete(calendarID, targetEventID).Do(); err != nil {
+	if err := svc.Events.Delete(calendarID, targetEventID).SendUpdates(sendUpdates).Context(ctx).Do(); err != nil {
 		return err
 	}
 	if scope == scopeFuture {
@@ -884,20 +963,22 @@ func (c *CalendarDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if truncateErr != nil {
 			return truncateErr
 		}
-		_, patchErr := svc.Events.Patch(calendarID, eventID, &calendar.Event{Recurrence: truncated}).Context(ctx).Do()
+		_, patchErr := svc.Events.Patch(calendarID, eventID, &calendar.Event{Recurrence: truncated}).SendUpdates(sendUpdates).Context(ctx).Do()
 		if patchErr != nil {
 			return patchErr
 		}
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"deleted":    true,
-			"calendarId": calendarID,
-			"eventId":    targetEventID,
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"deleted":     true,
+			"calendarId":  calendarID,
+			"eventId":     targetEventID,
+			"sendUpdates": sendUpdates,
 		})
 	}
 	u.Out().Printf("deleted\ttrue")
 	u.Out().Printf("calendarId\t%s", calendarID)
 	u.Out().Printf("eventId\t%s", targetEventID)
+	u.Out().Printf("sendUpdates\t%s", sendUpdates)
 	return nil
 }
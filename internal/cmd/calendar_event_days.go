@@ -9,12 +9,30 @@ import (
 
 type eventWithDays struct {
 	*calendar.Event
-	StartDayOfWeek string `json:"startDayOfWeek,omitempty"`
-	EndDayOfWeek   string `json:"endDayOfWeek,omitempty"`
-	Timezone       string `json:"timezone,omitempty"`
-	EventTimezone  string `json:"eventTimezone,omitempty"`
-	StartLocal     string `json:"startLocal,omitempty"`
-	EndLocal       string `json:"endLocal,omitempty"`
+	StartDayOfWeek string          `json:"startDayOfWeek,omitempty"`
+	EndDayOfWeek   string          `json:"endDayOfWeek,omitempty"`
+	Timezone       string          `json:"timezone,omitempty"`
+	EventTimezone  string          `json:"eventTimezone,omitempty"`
+	StartLocal     string          `json:"startLocal,omitempty"`
+	EndLocal       string          `json:"endLocal,omitempty"`
+	DisplayTZ      *displayTZTimes `json:"displayTz,omitempty"`
+}
+
+func (e eventWithDays) MarshalJSON() ([]byte, error) {
+	return marshalEventWithDisplayTZ(e.Event, e.DisplayTZ)
+}
+
+// withDisplayTimezones fills the displayTz block of wrapped events.
+func withDisplayTimezones(events []*eventWithDays, loc *time.Location) []*eventWithDays {
+	if loc == nil {
+		return events
+	}
+	for _, ev := range events {
+		if ev != nil {
+			ev.DisplayTZ = eventDisplayTimes(ev.Event, loc)
+		}
+	}
+	return events
 }
 
 func wrapEventsWithDays(events []*calendar.Event) []*eventWithDays {
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	minCalendarEventIDLen = 5
+	maxCalendarEventIDLen = 1024
+
+	// createRequestProp is the private extended property that records which
+	// create request produced an event with a client-assigned ID.
+	createRequestProp = "gogCreateRequest"
+)
+
+// validateCalendarEventID checks a client-assigned event ID against the
+// Calendar API rules: 5-1024 characters from the base32hex alphabet
+// (lowercase a-v and digits 0-9).
+func validateCalendarEventID(id string) error {
+	if len(id) < minCalendarEventIDLen || len(id) > maxCalendarEventIDLen {
+		return usagef("invalid --event-id %q: must be %d-%d characters long", id, minCalendarEventIDLen, maxCalendarEventIDLen)
+	}
+	for _, r := range id {
+		if (r < 'a' || r > 'v') && (r < '0' || r > '9') {
+			return usagef("invalid --event-id %q: only lowercase a-v and digits 0-9 are allowed (base32hex)", id)
+		}
+	}
+	return nil
+}
+
+func isConflictAPIError(err error) bool {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code == http.StatusConflict
+	}
+	return false
+}
+
+// existingCreatedEvent is called when inserting an event with a
+// client-assigned ID conflicts. It returns the stored event when it matches
+// what was being created (a retried create) and an error otherwise.
+func existingCreatedEvent(ctx context.Context, svc *calendar.Service, calendarID string, want *calendar.Event) (*calendar.Event, error) {
+	existing, err := svc.Events.Get(calendarID, want.Id).Context(ctx).Do()
+	if err != nil {
+		return nil, fmt.Errorf("event ID %s already exists and could not be fetched: %w", want.Id, err)
+	}
+	if existing.Status == "cancelled" {
+		return nil, fmt.Errorf("event ID %s belongs to a deleted event; choose a new --event-id", want.Id)
+	}
+	if !sameCreatedEvent(existing, want) {
+		return nil, fmt.Errorf("event ID %s already exists with different content (summary %q, start %s)", want.Id, existing.Summary, eventStart(existing))
+	}
+	return existing, nil
+}
+
+// createRequestFingerprint hashes the create inputs as typed. Relative times
+// ("tomorrow 3pm", +1h) resolve to a new instant on every run, so a retry is
+// recognised by what was asked for rather than by the resolved times.
+func createRequestFingerprint(parts ...string) string {
+	h := sha256.New()
+	for _, p := range parts {
+		h.Write([]byte(strings.TrimSpace(p)))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+func setCreateRequestFingerprint(event *calendar.Event, fingerprint string) {
+	if event.ExtendedProperties == nil {
+		event.ExtendedProperties = &calendar.EventExtendedProperties{}
+	}
+	if event.ExtendedProperties.Private == nil {
+		event.ExtendedProperties.Private = map[string]string{}
+	}
+	event.ExtendedProperties.Private[createRequestProp] = fingerprint
+}
+
+func createRequestFingerprintOf(event *calendar.Event) string {
+	if event == nil || event.ExtendedProperties == nil {
+		return ""
+	}
+	return event.ExtendedProperties.Private[createRequestProp]
+}
+
+// sameCreatedEvent reports whether existing came from the same create request
+// as want: by stored request fingerprint when both carry one, otherwise by
+// the fields a retried create must agree on.
+func sameCreatedEvent(existing, want *calendar.Event) bool {
+	if fp := createRequestFingerprintOf(want); fp != "" && createRequestFingerprintOf(existing) != "" {
+		return createRequestFingerprintOf(existing) == fp
+	}
+	return existing.Summary == want.Summary &&
+		existing.Description == want.Description &&
+		existing.Location == want.Location &&
+		sameEventDateTime(existing.Start, want.Start) &&
+		sameEventDateTime(existing.End, want.End)
+}
+
+func sameEventDateTime(a, b *calendar.EventDateTime) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Date != "" || b.Date != "" {
+		return a.Date == b.Date
+	}
+	at, aErr := time.Parse(time.RFC3339, a.DateTime)
+	bt, bErr := time.Parse(time.RFC3339, b.DateTime)
+	if aErr != nil || bErr != nil {
+		return strings.TrimSpace(a.DateTime) == strings.TrimSpace(b.DateTime)
+	}
+	return at.Equal(bt)
+}
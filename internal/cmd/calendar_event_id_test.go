@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestValidateCalendarEventID(t *testing.T) {
+	for _, ok := range []string{"abcde", "0123456789abcdefghijklmnopqrstuv", strings.Repeat("a", 1024)} {
+		if err := validateCalendarEventID(ok); err != nil {
+			t.Fatalf("validate %q: %v", ok, err)
+		}
+	}
+	for _, bad := range []string{"abcd", "abcdw", "ABCDE", "abc-de", strings.Repeat("a", 1025)} {
+		if err := validateCalendarEventID(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestCalendarCreateCmd_EventID(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var (
+		stored   map[string]any
+		insertID string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && path == "/calendars/cal/events":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			insertID, _ = body["id"].(string)
+			if stored != nil {
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": 409, "message": "The requested identifier already exists."}})
+				return
+			}
+			stored = body
+			_ = json.NewEncoder(w).Encode(body)
+		case r.Method == http.MethodGet && path == "/calendars/cal/events/meeting20250102":
+			_ = json.NewEncoder(w).Encode(stored)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+	args := func(summary string) []string {
+		return []string{
+			"cal",
+			"--event-id", "meeting20250102",
+			"--summary", summary,
+			"--from", "2025-01-02T10:00:00Z",
+			"--to", "2025-01-02T11:00:00Z",
+		}
+	}
+	run := func(summary string) (map[string]any, error) {
+		var runErr error
+		out := captureStdout(t, func() {
+			runErr = runKong(t, &CalendarCreateCmd{}, args(summary), ctx, flags)
+		})
+		if runErr != nil {
+			return nil, runErr
+		}
+		var parsed map[string]any
+		if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+			t.Fatalf("json parse: %v\nout=%q", err, out)
+		}
+		return parsed, nil
+	}
+
+	first, err := run("Meeting")
+	if err != nil {
+		t.Fatalf("first create: %v", err)
+	}
+	if insertID != "meeting20250102" || first["created"] != true {
+		t.Fatalf("unexpected first create: id=%q out=%v", insertID, first)
+	}
+
+	retry, err := run("Meeting")
+	if err != nil {
+		t.Fatalf("retried create: %v", err)
+	}
+	if retry["created"] != false {
+		t.Fatalf("expected created=false on retry, got %v", retry)
+	}
+
+	if _, err := run("Different"); err == nil || !strings.Contains(err.Error(), "already exists with different content") {
+		t.Fatalf("expected conflict error, got %v", err)
+	}
+
+	if err := runKong(t, &CalendarCreateCmd{}, []string{"cal", "--event-id", "Bad_ID", "--summary", "x", "--from", "2025-01-02T10:00:00Z", "--to", "2025-01-02T11:00:00Z"}, ctx, flags); ExitCode(err) != 2 {
+		t.Fatalf("expected usage error for invalid id, got %v", err)
+	}
+}
+
+func TestSameCreatedEvent_Fingerprint(t *testing.T) {
+	fp := createRequestFingerprint("Standup", "tomorrow 9am", "+15m")
+	existing := &calendar.Event{
+		Summary: "Standup",
+		Start:   &calendar.EventDateTime{DateTime: "2025-01-02T09:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2025-01-02T09:15:00Z"},
+	}
+	setCreateRequestFingerprint(existing, fp)
+
+	// A retry the next day resolves "tomorrow 9am" to a new instant.
+	retry := &calendar.Event{
+		Summary: "Standup",
+		Start:   &calendar.EventDateTime{DateTime: "2025-01-03T09:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2025-01-03T09:15:00Z"},
+	}
+	setCreateRequestFingerprint(retry, fp)
+	if !sameCreatedEvent(existing, retry) {
+		t.Fatal("expected a retry with the same request to match")
+	}
+
+	other := &calendar.Event{Summary: "Standup", Start: existing.Start, End: existing.End}
+	setCreateRequestFingerprint(other, createRequestFingerprint("Standup", "tomorrow 10am", "+15m"))
+	if sameCreatedEvent(existing, other) {
+		t.Fatal("expected a different request to conflict")
+	}
+
+	// Events created without a fingerprint fall back to comparing content.
+	legacy := &calendar.Event{Summary: "Standup", Start: existing.Start, End: existing.End}
+	if sameCreatedEvent(legacy, retry) || !sameCreatedEvent(legacy, existing) {
+		t.Fatal("expected content comparison without a stored fingerprint")
+	}
+}
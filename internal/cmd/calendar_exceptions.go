@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarExceptionsCmd struct {
+	CalendarID  string `arg:"" name:"calendarId" help:"Calendar ID"`
+	EventID     string `arg:"" name:"eventId" help:"Recurring event (series) ID"`
+	Cancel      string `name:"cancel" help:"Cancel the occurrence with this original start (RFC3339, or YYYY-MM-DD for all-day series) instead of listing"`
+	SendUpdates string `name:"send-updates" help:"With --cancel: cancellation notices: all, externalOnly, none (default: all)"`
+	TimeRangeFlags
+}
+
+type calendarException struct {
+	ID            string   `json:"id"`
+	OriginalStart string   `json:"originalStart"`
+	Status        string   `json:"status"` // cancelled or modified
+	Changes       []string `json:"changes,omitempty"`
+	Start         string   `json:"start,omitempty"`
+	End           string   `json:"end,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+}
+
+func (c *CalendarExceptionsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	eventID := strings.TrimSpace(c.EventID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+	if eventID == "" {
+		return usage("empty eventId")
+	}
+	cancel := strings.TrimSpace(c.Cancel)
+	if cancel != "" && c.hasTimeRange() {
+		return usage("--cancel cannot be combined with a time range")
+	}
+	if cancel == "" && strings.TrimSpace(c.SendUpdates) != "" {
+		return usage("--send-updates requires --cancel")
+	}
+
+	if cancel != "" {
+		return c.cancelOccurrence(ctx, flags, account, calendarID, eventID, cancel)
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	master, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if len(master.Recurrence) == 0 {
+		return usagef("event %s is not a recurring series", eventID)
+	}
+
+	var timeMin, timeMax string
+	if c.hasTimeRange() {
+		tr, trErr := ResolveTimeRange(ctx, svc, c.TimeRangeFlags)
+		if trErr != nil {
+			return trErr
+		}
+		timeMin, timeMax = tr.From.Format(time.RFC3339), tr.To.Format(time.RFC3339)
+	} else {
+		// Endless series expand forever; stop a year out.
+		timeMax = time.Now().AddDate(1, 0, 0).Format(time.RFC3339)
+	}
+
+	instances, err := collectAllPages(ctx, "", func(pageToken string) ([]*calendar.Event, string, error) {
+		call := svc.Events.Instances(calendarID, eventID).
+			ShowDeleted(true).
+			MaxResults(250)
+		if timeMin != "" {
+			call = call.TimeMin(timeMin)
+		}
+		if timeMax != "" {
+			call = call.TimeMax(timeMax)
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, callErr := call.Context(ctx).Do()
+		if callErr != nil {
+			return nil, "", callErr
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	exceptions := make([]calendarException, 0)
+	for _, inst := range instances {
+		if ex, ok := recurringException(master, inst); ok {
+			exceptions = append(exceptions, ex)
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"calendarId": calendarID,
+			"eventId":    eventID,
+			"exceptions": exceptions,
+			"count":      len(exceptions),
+		})
+	}
+	if len(exceptions) == 0 {
+		u.Err().Println("No modified or cancelled occurrences")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ORIGINAL_START\tSTATUS\tSTART\tCHANGES\tID")
+	for _, ex := range exceptions {
+		start := ex.Start
+		if start == "" {
+			start = "-"
+		}
+		changes := strings.Join(ex.Changes, ",")
+		if changes == "" {
+			changes = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", ex.OriginalStart, ex.Status, start, changes, ex.ID)
+	}
+	return nil
+}
+
+func (c *CalendarExceptionsCmd) cancelOccurrence(ctx context.Context, flags *RootFlags, account, calendarID, eventID, originalStart string) error {
+	u := ui.FromContext(ctx)
+	sendUpdates, err := validateSendUpdates(c.SendUpdates)
+	if err != nil {
+		return usage(err.Error())
+	}
+	if sendUpdates == "" {
+		sendUpdates = scopeAll
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("cancel the %s occurrence of event %s in calendar %s", originalStart, eventID, calendarID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	instanceID, err := resolveRecurringInstanceID(ctx, svc, calendarID, eventID, originalStart)
+	if err != nil {
+		return err
+	}
+	// Deleting an instance cancels only that occurrence; the series stays.
+	if err := svc.Events.Delete(calendarID, instanceID).SendUpdates(sendUpdates).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"cancelled":     true,
+			"calendarId":    calendarID,
+			"eventId":       instanceID,
+			"originalStart": originalStart,
+			"sendUpdates":   sendUpdates,
+		})
+	}
+	u.Out().Printf("cancelled\ttrue")
+	u.Out().Printf("calendarId\t%s", calendarID)
+	u.Out().Printf("eventId\t%s", instanceID)
+	u.Out().Printf("originalStart\t%s", originalStart)
+	u.Out().Printf("sendUpdates\t%s", sendUpdates)
+	return nil
+}
+
+// recurringException reports whether inst is a cancelled or changed
+// occurrence of master, and what differs from the series.
+func recurringException(master, inst *calendar.Event) (calendarException, bool) {
+	if inst == nil || inst.OriginalStartTime == nil {
+		return calendarException{}, false
+	}
+	ex := calendarException{
+		ID:            inst.Id,
+		OriginalStart: eventDateTimeValue(inst.OriginalStartTime),
+	}
+	if inst.Status == "cancelled" {
+		ex.Status = "cancelled"
+		return ex, true
+	}
+
+	if !sameEventInstant(inst.Start, inst.OriginalStartTime) {
+		ex.Changes = append(ex.Changes, "start")
+	}
+	if d, ok := eventLength(inst); ok {
+		if md, mok := eventLength(master); mok && d != md {
+			ex.Changes = append(ex.Changes, "duration")
+		}
+	}
+	fields := []struct {
+		name      string
+		got, want string
+	}{
+		{"summary", inst.Summary, master.Summary},
+		{"description", inst.Description, master.Description},
+		{"location", inst.Location, master.Location},
+		{"color", inst.ColorId, master.ColorId},
+		{"visibility", inst.Visibility, master.Visibility},
+		{"transparency", inst.Transparency, master.Transparency},
+		{"attendees", attendeeEmailKey(inst.Attendees), attendeeEmailKey(master.Attendees)},
+	}
+	for _, f := range fields {
+		if f.got != f.want {
+			ex.Changes = append(ex.Changes, f.name)
+		}
+	}
+	if len(ex.Changes) == 0 {
+		return calendarException{}, false
+	}
+	ex.Status = "modified"
+	ex.Start = eventStart(inst)
+	ex.End = eventEnd(inst)
+	ex.Summary = inst.Summary
+	return ex, true
+}
+
+func eventDateTimeValue(edt *calendar.EventDateTime) string {
+	if edt == nil {
+		return ""
+	}
+	if edt.DateTime != "" {
+		return edt.DateTime
+	}
+	return edt.Date
+}
+
+func sameEventInstant(a, b *calendar.EventDateTime) bool {
+	av, bv := eventDateTimeValue(a), eventDateTimeValue(b)
+	if av == bv {
+		return true
+	}
+	at, aErr := time.Parse(time.RFC3339, av)
+	bt, bErr := time.Parse(time.RFC3339, bv)
+	return aErr == nil && bErr == nil && at.Equal(bt)
+}
+
+func eventLength(e *calendar.Event) (time.Duration, bool) {
+	if e == nil || e.Start == nil || e.End == nil {
+		return 0, false
+	}
+	if e.Start.Date != "" {
+		s, sErr := time.Parse("2006-01-02", e.Start.Date)
+		en, eErr := time.Parse("2006-01-02", e.End.Date)
+		return en.Sub(s), sErr == nil && eErr == nil
+	}
+	s, sErr := time.Parse(time.RFC3339, e.Start.DateTime)
+	en, eErr := time.Parse(time.RFC3339, e.End.DateTime)
+	return en.Sub(s), sErr == nil && eErr == nil
+}
+
+// attendeeEmailKey is an order-independent key of the attendee emails.
+func attendeeEmailKey(attendees []*calendar.EventAttendee) string {
+	emails := make([]string, 0, len(attendees))
+	for _, a := range attendees {
+		if a != nil {
+			emails = append(emails, strings.ToLower(a.Email))
+		}
+	}
+	sort.Strings(emails)
+	return strings.Join(emails, ",")
+}
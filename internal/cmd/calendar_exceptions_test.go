@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestCalendarExceptionsCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	instance := func(id, original, start, end, summary string) map[string]any {
+		return map[string]any{
+			"id":                id,
+			"recurringEventId":  "series",
+			"summary":           summary,
+			"originalStartTime": map[string]any{"dateTime": original},
+			"start":             map[string]any{"dateTime": start},
+			"end":               map[string]any{"dateTime": end},
+		}
+	}
+	var (
+		showDeleted []string
+		deleted     []string
+		sendUpdates []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && path == "/calendars/cal/events/series":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":         "series",
+				"summary":    "Standup",
+				"recurrence": []string{"RRULE:FREQ=WEEKLY"},
+				"start":      map[string]any{"dateTime": "2025-01-06T09:00:00Z"},
+				"end":        map[string]any{"dateTime": "2025-01-06T09:15:00Z"},
+			})
+		case r.Method == http.MethodGet && path == "/calendars/cal/events/single":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "single", "summary": "One-off"})
+		case r.Method == http.MethodGet && path == "/calendars/cal/events/series/instances":
+			showDeleted = append(showDeleted, r.URL.Query().Get("showDeleted"))
+			cancelled := instance("series_20250113T090000Z", "2025-01-13T09:00:00Z", "2025-01-13T09:00:00Z", "2025-01-13T09:15:00Z", "Standup")
+			cancelled["status"] = "cancelled"
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []any{
+				instance("series_20250106T090000Z", "2025-01-06T09:00:00Z", "2025-01-06T09:00:00Z", "2025-01-06T09:15:00Z", "Standup"),
+				cancelled,
+				instance("series_20250120T090000Z", "2025-01-20T09:00:00Z", "2025-01-20T10:00:00Z", "2025-01-20T10:30:00Z", "Standup"),
+				instance("series_20250127T090000Z", "2025-01-27T09:00:00Z", "2025-01-27T10:00:00+01:00", "2025-01-27T10:15:00+01:00", "Planning"),
+			}})
+		case r.Method == http.MethodDelete && strings.HasPrefix(path, "/calendars/cal/events/"):
+			deleted = append(deleted, strings.TrimPrefix(path, "/calendars/cal/events/"))
+			sendUpdates = append(sendUpdates, r.URL.Query().Get("sendUpdates"))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com", Force: true}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &CalendarExceptionsCmd{}, []string{"cal", "series"}, ctx, flags); err != nil {
+			t.Fatalf("exceptions: %v", err)
+		}
+	})
+	var parsed struct {
+		Exceptions []calendarException `json:"exceptions"`
+		Count      int                 `json:"count"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Count != 3 || len(showDeleted) != 1 || showDeleted[0] != "true" {
+		t.Fatalf("unexpected result: %+v (showDeleted=%v)", parsed, showDeleted)
+	}
+	got := parsed.Exceptions
+	if got[0].Status != "cancelled" || got[0].OriginalStart != "2025-01-13T09:00:00Z" {
+		t.Fatalf("unexpected cancelled exception: %+v", got[0])
+	}
+	if got[1].Status != "modified" || strings.Join(got[1].Changes, ",") != "start,duration" {
+		t.Fatalf("unexpected moved exception: %+v", got[1])
+	}
+	// 10:00+01:00 is the original 09:00Z, so only the title changed.
+	if got[2].Status != "modified" || strings.Join(got[2].Changes, ",") != "summary" || got[2].Summary != "Planning" {
+		t.Fatalf("unexpected retitled exception: %+v", got[2])
+	}
+
+	out = captureStdout(t, func() {
+		if err := runKong(t, &CalendarExceptionsCmd{}, []string{"cal", "series", "--cancel", "2025-01-20T09:00:00Z", "--send-updates", "none"}, ctx, flags); err != nil {
+			t.Fatalf("cancel: %v", err)
+		}
+	})
+	if len(deleted) != 1 || deleted[0] != "series_20250120T090000Z" || sendUpdates[0] != "none" {
+		t.Fatalf("unexpected delete: %v %v", deleted, sendUpdates)
+	}
+	if !strings.Contains(out, `"cancelled": true`) || !strings.Contains(out, `"eventId": "series_20250120T090000Z"`) {
+		t.Fatalf("unexpected cancel output: %s", out)
+	}
+
+	if err := runKong(t, &CalendarExceptionsCmd{}, []string{"cal", "single"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "not a recurring") {
+		t.Fatalf("expected non-recurring error, got %v", err)
+	}
+	if err := runKong(t, &CalendarExceptionsCmd{}, []string{"cal", "series", "--send-updates", "none"}, ctx, flags); err == nil {
+		t.Fatal("expected error for --send-updates without --cancel")
+	}
+}
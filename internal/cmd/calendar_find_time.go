@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// findTimeSlotAlign keeps candidate slots on quarter-hour boundaries.
+const findTimeSlotAlign = 15 * time.Minute
+
+type CalendarFindTimeCmd struct {
+	Attendees   string        `name:"attendees" help:"Comma-separated attendee emails/calendar IDs (required)"`
+	Duration    time.Duration `name:"duration" help:"Meeting length (e.g. 30m, 1h)" default:"30m"`
+	From        string        `name:"from" help:"Search window start (RFC3339, date, or relative: today, tomorrow, monday)"`
+	To          string        `name:"to" help:"Search window end (RFC3339, date, or relative)"`
+	Today       bool          `name:"today" help:"Today only (timezone-aware)"`
+	Tomorrow    bool          `name:"tomorrow" help:"Tomorrow only (timezone-aware)"`
+	Week        bool          `name:"week" help:"This week (uses --week-start, default Mon)"`
+	Days        int           `name:"days" help:"Next N days (timezone-aware)" default:"0"`
+	WeekStart   string        `name:"week-start" help:"Week start day for --week (sun, mon, ...)" default:""`
+	WithinHours string        `name:"within-hours" help:"Only consider slots inside these daily hours (e.g. 9-17 or 09:30-17:00)"`
+	Count       int           `name:"count" help:"Number of slots to return" default:"5"`
+	ExcludeSelf bool          `name:"exclude-self" help:"Do not include your own primary calendar"`
+}
+
+type timeSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+func (c *CalendarFindTimeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	attendees := splitCSV(c.Attendees)
+	if len(attendees) == 0 {
+		return usage("required: --attendees")
+	}
+	if c.Duration <= 0 {
+		return usage("--duration must be positive")
+	}
+	if c.Count < 1 {
+		return usage("--count must be >= 1")
+	}
+	dayStart, dayEnd, err := parseWithinHours(c.WithinHours)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	tz, loc, err := getCalendarLocation(ctx, svc, "primary")
+	if err != nil {
+		return err
+	}
+
+	timeRange, err := ResolveTimeRange(ctx, svc, TimeRangeFlags{
+		From:      c.From,
+		To:        c.To,
+		Today:     c.Today,
+		Tomorrow:  c.Tomorrow,
+		Week:      c.Week,
+		Days:      c.Days,
+		WeekStart: c.WeekStart,
+	})
+	if err != nil {
+		return err
+	}
+	from, to := timeRange.FormatRFC3339()
+
+	ids := attendees
+	if !c.ExcludeSelf {
+		ids = append([]string{"primary"}, attendees...)
+	}
+	items := make([]*calendar.FreeBusyRequestItem, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, &calendar.FreeBusyRequestItem{Id: id})
+	}
+
+	resp, err := svc.Freebusy.Query(&calendar.FreeBusyRequest{
+		TimeMin:  from,
+		TimeMax:  to,
+		TimeZone: tz,
+		Items:    items,
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	var busy []timeSlot
+	for id, data := range resp.Calendars {
+		for _, e := range data.Errors {
+			// Unknown availability would make every suggestion a guess.
+			return fmt.Errorf("free/busy unavailable for %s: %s", id, e.Reason)
+		}
+		for _, b := range data.Busy {
+			start, startErr := time.Parse(time.RFC3339, b.Start)
+			end, endErr := time.Parse(time.RFC3339, b.End)
+			if startErr != nil || endErr != nil {
+				continue
+			}
+			busy = append(busy, timeSlot{Start: start, End: end})
+		}
+	}
+
+	slots := findFreeSlots(busy, timeRange.From, timeRange.To, c.Duration, c.Count, loc, dayStart, dayEnd)
+
+	if outfmt.IsJSON(ctx) {
+		out := make([]map[string]string, 0, len(slots))
+		for _, s := range slots {
+			out = append(out, map[string]string{
+				"start": s.Start.In(loc).Format(time.RFC3339),
+				"end":   s.End.In(loc).Format(time.RFC3339),
+			})
+		}
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"timezone": tz,
+			"duration": c.Duration.String(),
+			"slots":    out,
+		})
+	}
+
+	if len(slots) == 0 {
+		u.Err().Println("No free slots found")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "START\tEND")
+	for _, s := range slots {
+		fmt.Fprintf(w, "%s\t%s\n", s.Start.In(loc).Format("Mon 2006-01-02 15:04"), s.End.In(loc).Format("15:04 MST"))
+	}
+	return nil
+}
+
+// findFreeSlots returns up to count non-overlapping slots of length d inside
+// [from, to) that avoid every busy interval. When dayEnd > dayStart, slots must
+// also fall within those offsets from local midnight in loc.
+func findFreeSlots(busy []timeSlot, from, to time.Time, d time.Duration, count int, loc *time.Location, dayStart, dayEnd time.Duration) []timeSlot {
+	sort.Slice(busy, func(i, j int) bool { return busy[i].Start.Before(busy[j].Start) })
+
+	var slots []timeSlot
+	t := alignUp(from, findTimeSlotAlign)
+	for !t.Add(d).After(to) && len(slots) < count {
+		end := t.Add(d)
+
+		if dayEnd > dayStart {
+			local := t.In(loc)
+			midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+			open := midnight.Add(dayStart)
+			closeAt := midnight.Add(dayEnd)
+			if t.Before(open) {
+				t = open
+				continue
+			}
+			if end.After(closeAt) {
+				t = midnight.AddDate(0, 0, 1).Add(dayStart)
+				continue
+			}
+		}
+
+		if blocker, ok := overlappingBusy(busy, t, end); ok {
+			t = alignUp(blocker.End, findTimeSlotAlign)
+			continue
+		}
+
+		slots = append(slots, timeSlot{Start: t, End: end})
+		t = end
+	}
+	return slots
+}
+
+func overlappingBusy(busy []timeSlot, start, end time.Time) (timeSlot, bool) {
+	for _, b := range busy {
+		if b.Start.Before(end) && b.End.After(start) {
+			return b, true
+		}
+	}
+	return timeSlot{}, false
+}
+
+func alignUp(t time.Time, step time.Duration) time.Time {
+	truncated := t.Truncate(step)
+	if truncated.Equal(t) {
+		return t
+	}
+	return truncated.Add(step)
+}
+
+// parseWithinHours parses "9-17" or "09:30-17:00" into offsets from midnight.
+func parseWithinHours(value string) (time.Duration, time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, 0, nil
+	}
+	startRaw, endRaw, ok := strings.Cut(value, "-")
+	if !ok {
+		return 0, 0, usagef("invalid --within-hours %q (expected e.g. 9-17)", value)
+	}
+	start, err := parseClockOffset(startRaw)
+	if err != nil {
+		return 0, 0, usagef("invalid --within-hours %q: %v", value, err)
+	}
+	end, err := parseClockOffset(endRaw)
+	if err != nil {
+		return 0, 0, usagef("invalid --within-hours %q: %v", value, err)
+	}
+	if end <= start {
+		return 0, 0, usagef("invalid --within-hours %q: end must be after start", value)
+	}
+	return start, end, nil
+}
+
+func parseClockOffset(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	hourRaw, minuteRaw, hasMinutes := strings.Cut(value, ":")
+	hour, err := strconv.Atoi(hourRaw)
+	if err != nil || hour < 0 || hour > 24 {
+		return 0, fmt.Errorf("bad hour %q", value)
+	}
+	minute := 0
+	if hasMinutes {
+		minute, err = strconv.Atoi(minuteRaw)
+		if err != nil || minute < 0 || minute > 59 {
+			return 0, fmt.Errorf("bad minute %q", value)
+		}
+	}
+	offset := time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute
+	if offset > 24*time.Hour {
+		return 0, fmt.Errorf("time past midnight %q", value)
+	}
+	return offset, nil
+}
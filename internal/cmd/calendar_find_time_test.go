@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFindFreeSlots(t *testing.T) {
+	loc := time.UTC
+	day := func(h, m int) time.Time { return time.Date(2025, 3, 10, h, m, 0, 0, loc) }
+
+	busy := []timeSlot{
+		{Start: day(10, 0), End: day(11, 10)},
+		{Start: day(9, 0), End: day(9, 30)},
+	}
+
+	slots := findFreeSlots(busy, day(8, 50), day(12, 0), 30*time.Minute, 3, loc, 0, 0)
+	want := []timeSlot{
+		{Start: day(9, 30), End: day(10, 0)},
+		{Start: day(11, 15), End: day(11, 45)},
+	}
+	// 08:50 aligns up to 09:00, which is busy until 09:30; 11:10 aligns up to 11:15.
+	if len(slots) != len(want) {
+		t.Fatalf("unexpected slots: %#v", slots)
+	}
+	for i := range want {
+		if !slots[i].Start.Equal(want[i].Start) || !slots[i].End.Equal(want[i].End) {
+			t.Fatalf("slot %d = %v-%v, want %v-%v", i, slots[i].Start, slots[i].End, want[i].Start, want[i].End)
+		}
+	}
+}
+
+func TestFindFreeSlots_WithinHoursAndCount(t *testing.T) {
+	loc := time.UTC
+	from := time.Date(2025, 3, 10, 16, 0, 0, 0, loc)
+	to := time.Date(2025, 3, 12, 0, 0, 0, 0, loc)
+
+	slots := findFreeSlots(nil, from, to, time.Hour, 3, loc, 9*time.Hour, 17*time.Hour)
+	want := []time.Time{
+		time.Date(2025, 3, 10, 16, 0, 0, 0, loc),
+		time.Date(2025, 3, 11, 9, 0, 0, 0, loc),
+		time.Date(2025, 3, 11, 10, 0, 0, 0, loc),
+	}
+	if len(slots) != len(want) {
+		t.Fatalf("unexpected slots: %#v", slots)
+	}
+	for i := range want {
+		if !slots[i].Start.Equal(want[i]) {
+			t.Fatalf("slot %d starts %v, want %v", i, slots[i].Start, want[i])
+		}
+	}
+
+	if got := findFreeSlots(nil, from, to, 9*time.Hour, 1, loc, 9*time.Hour, 17*time.Hour); len(got) != 0 {
+		t.Fatalf("expected no slots longer than working hours, got %#v", got)
+	}
+}
+
+func TestParseWithinHours(t *testing.T) {
+	start, end, err := parseWithinHours("9-17")
+	if err != nil || start != 9*time.Hour || end != 17*time.Hour {
+		t.Fatalf("9-17: %v %v %v", start, end, err)
+	}
+	start, end, err = parseWithinHours("09:30-17:45")
+	if err != nil || start != 9*time.Hour+30*time.Minute || end != 17*time.Hour+45*time.Minute {
+		t.Fatalf("09:30-17:45: %v %v %v", start, end, err)
+	}
+	if start, end, err = parseWithinHours(""); err != nil || start != 0 || end != 0 {
+		t.Fatalf("empty: %v %v %v", start, end, err)
+	}
+	for _, bad := range []string{"9", "17-9", "9-25", "a-b", "9:75-10"} {
+		if _, _, err := parseWithinHours(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
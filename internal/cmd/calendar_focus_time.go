@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"google.golang.org/api/calendar/v3"
 
@@ -15,8 +16,8 @@ import (
 type CalendarFocusTimeCmd struct {
 	CalendarID     string   `arg:"" name:"calendarId" help:"Calendar ID (default: primary)" default:"primary"`
 	Summary        string   `name:"summary" help:"Focus time title" default:"Focus Time"`
-	From           string   `name:"from" required:"" help:"Start time (RFC3339)"`
-	To             string   `name:"to" required:"" help:"End time (RFC3339)"`
+	From           string   `name:"from" required:"" help:"Start time (RFC3339 or relative, e.g. tomorrow 3pm)"`
+	To             string   `name:"to" required:"" help:"End time (RFC3339 or relative, e.g. +2h)"`
 	AutoDecline    string   `name:"auto-decline" help:"Auto-decline mode: none, all, new" default:"all"`
 	DeclineMessage string   `name:"decline-message" help:"Message for declined invitations"`
 	ChatStatus     string   `name:"chat-status" help:"Chat status: available, doNotDisturb" default:"doNotDisturb"`
@@ -59,14 +60,18 @@ func (c *CalendarFocusTimeCmd) Run(ctx context.Context, flags *RootFlags) error
 		Recurrence: buildRecurrence(c.Recurrence),
 	}
 
-	created, err := svc.Events.Insert(c.CalendarID, event).Do()
+	if err = resolveFlexibleEventTimes(ctx, svc, c.CalendarID, time.Now(), event.Start, event.End); err != nil {
+		return err
+	}
+
+	created, err := svc.Events.Insert(c.CalendarID, event).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	tz, loc, _ := getCalendarLocation(ctx, svc, c.CalendarID)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
 	}
 	printCalendarEventWithTimezone(u, created, tz, loc)
 	return nil
@@ -47,13 +47,13 @@ func (c *CalendarFreeBusyCmd) Run(ctx context.Context, flags *RootFlags) error {
 		req.Items = append(req.Items, &calendar.FreeBusyRequestItem{Id: id})
 	}
 
-	resp, err := svc.Freebusy.Query(req).Do()
+	resp, err := svc.Freebusy.Query(req).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"calendars": resp.Calendars})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"calendars": resp.Calendars})
 	}
 
 	if len(resp.Calendars) == 0 {
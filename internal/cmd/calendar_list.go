@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"strings"
 
@@ -39,9 +38,10 @@ func listCalendarEvents(ctx context.Context, svc *calendar.Service, calendarID,
 	if err != nil {
 		return err
 	}
+	displayLoc := displayTimezoneFromContext(ctx)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"events":        wrapEventsWithDays(resp.Items),
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"events":        withDisplayTimezones(wrapEventsWithDays(resp.Items), displayLoc),
 			"nextPageToken": resp.NextPageToken,
 		})
 	}
@@ -51,22 +51,21 @@ func listCalendarEvents(ctx context.Context, svc *calendar.Service, calendarID,
 		return nil
 	}
 
-	w, flush := tableWriter(ctx)
-	defer flush()
-
+	header := []string{"ID", "START", "END", "SUMMARY"}
 	if showWeekday {
-		fmt.Fprintln(w, "ID\tSTART\tSTART_DOW\tEND\tEND_DOW\tSUMMARY")
-		for _, e := range resp.Items {
+		header = []string{"ID", "START", "START_DOW", "END", "END_DOW", "SUMMARY"}
+	}
+	rows := make([][]string, 0, len(resp.Items))
+	for _, e := range resp.Items {
+		if showWeekday {
 			startDay, endDay := eventDaysOfWeek(e)
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Id, eventStart(e), startDay, eventEnd(e), endDay, e.Summary)
+			rows = append(rows, []string{e.Id, withDisplayTime(eventStart(e), e.Start, displayLoc), startDay, withDisplayTime(eventEnd(e), e.End, displayLoc), endDay, e.Summary})
+			continue
 		}
-		printNextPageHint(u, resp.NextPageToken)
-		return nil
+		rows = append(rows, []string{e.Id, withDisplayTime(eventStart(e), e.Start, displayLoc), withDisplayTime(eventEnd(e), e.End, displayLoc), e.Summary})
 	}
-
-	fmt.Fprintln(w, "ID\tSTART\tEND\tSUMMARY")
-	for _, e := range resp.Items {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Id, eventStart(e), eventEnd(e), e.Summary)
+	if err := writeTable(ctx, header, rows); err != nil {
+		return err
 	}
 	printNextPageHint(u, resp.NextPageToken)
 	return nil
@@ -75,11 +74,16 @@ func listCalendarEvents(ctx context.Context, svc *calendar.Service, calendarID,
 type eventWithCalendar struct {
 	*calendar.Event
 	CalendarID     string
-	StartDayOfWeek string `json:"startDayOfWeek,omitempty"`
-	EndDayOfWeek   string `json:"endDayOfWeek,omitempty"`
-	Timezone       string `json:"timezone,omitempty"`
-	StartLocal     string `json:"startLocal,omitempty"`
-	EndLocal       string `json:"endLocal,omitempty"`
+	StartDayOfWeek string          `json:"startDayOfWeek,omitempty"`
+	EndDayOfWeek   string          `json:"endDayOfWeek,omitempty"`
+	Timezone       string          `json:"timezone,omitempty"`
+	StartLocal     string          `json:"startLocal,omitempty"`
+	EndLocal       string          `json:"endLocal,omitempty"`
+	DisplayTZ      *displayTZTimes `json:"displayTz,omitempty"`
+}
+
+func (e eventWithCalendar) MarshalJSON() ([]byte, error) {
+	return marshalEventWithDisplayTZ(e.Event, e.DisplayTZ)
 }
 
 func listAllCalendarsEvents(ctx context.Context, svc *calendar.Service, from, to string, maxResults int64, page, query, privatePropFilter, sharedPropFilter, fields string, showWeekday bool) error {
@@ -95,6 +99,7 @@ func listAllCalendarsEvents(ctx context.Context, svc *calendar.Service, from, to
 		return nil
 	}
 
+	displayLoc := displayTimezoneFromContext(ctx)
 	all := []*eventWithCalendar{}
 	for _, cal := range calResp.Items {
 		call := svc.Events.List(cal.Id).
@@ -134,31 +139,30 @@ func listAllCalendarsEvents(ctx context.Context, svc *calendar.Service, from, to
 				Timezone:       evTimezone,
 				StartLocal:     startLocal,
 				EndLocal:       endLocal,
+				DisplayTZ:      eventDisplayTimes(e, displayLoc),
 			})
 		}
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"events": all})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"events": all})
 	}
 	if len(all) == 0 {
 		u.Err().Println("No events")
 		return nil
 	}
 
-	w, flush := tableWriter(ctx)
-	defer flush()
+	header := []string{"CALENDAR", "ID", "START", "END", "SUMMARY"}
 	if showWeekday {
-		fmt.Fprintln(w, "CALENDAR\tID\tSTART\tSTART_DOW\tEND\tEND_DOW\tSUMMARY")
-		for _, e := range all {
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.CalendarID, e.Id, eventStart(e.Event), e.StartDayOfWeek, eventEnd(e.Event), e.EndDayOfWeek, e.Summary)
-		}
-		return nil
+		header = []string{"CALENDAR", "ID", "START", "START_DOW", "END", "END_DOW", "SUMMARY"}
 	}
-
-	fmt.Fprintln(w, "CALENDAR\tID\tSTART\tEND\tSUMMARY")
+	rows := make([][]string, 0, len(all))
 	for _, e := range all {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.CalendarID, e.Id, eventStart(e.Event), eventEnd(e.Event), e.Summary)
+		if showWeekday {
+			rows = append(rows, []string{e.CalendarID, e.Id, withDisplayTime(eventStart(e.Event), e.Start, displayLoc), e.StartDayOfWeek, withDisplayTime(eventEnd(e.Event), e.End, displayLoc), e.EndDayOfWeek, e.Summary})
+			continue
+		}
+		rows = append(rows, []string{e.CalendarID, e.Id, withDisplayTime(eventStart(e.Event), e.Start, displayLoc), withDisplayTime(eventEnd(e.Event), e.End, displayLoc), e.Summary})
 	}
-	return nil
+	return writeTable(ctx, header, rows)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"strings"
+	"time"
 
 	"google.golang.org/api/calendar/v3"
 
@@ -14,8 +15,8 @@ import (
 type CalendarOOOCmd struct {
 	CalendarID     string `arg:"" name:"calendarId" help:"Calendar ID (default: primary)" default:"primary"`
 	Summary        string `name:"summary" help:"Out of office title" default:"Out of office"`
-	From           string `name:"from" required:"" help:"Start date or datetime (RFC3339 or YYYY-MM-DD)"`
-	To             string `name:"to" required:"" help:"End date or datetime (RFC3339 or YYYY-MM-DD)"`
+	From           string `name:"from" required:"" help:"Start date or datetime (RFC3339, YYYY-MM-DD, or relative: tomorrow 9am)"`
+	To             string `name:"to" required:"" help:"End date or datetime (RFC3339, YYYY-MM-DD, or relative)"`
 	AutoDecline    string `name:"auto-decline" help:"Auto-decline mode: none, all, new" default:"all"`
 	DeclineMessage string `name:"decline-message" help:"Message for declined invitations" default:"I am out of office and will respond when I return."`
 	AllDay         bool   `name:"all-day" help:"Create as all-day event"`
@@ -50,14 +51,18 @@ func (c *CalendarOOOCmd) Run(ctx context.Context, flags *RootFlags) error {
 		},
 	}
 
-	created, err := svc.Events.Insert(c.CalendarID, event).Do()
+	if err = resolveFlexibleEventTimes(ctx, svc, c.CalendarID, time.Now(), event.Start, event.End); err != nil {
+		return err
+	}
+
+	created, err := svc.Events.Insert(c.CalendarID, event).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	tz, loc, _ := getCalendarLocation(ctx, svc, c.CalendarID)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
 	}
 	printCalendarEventWithTimezone(u, created, tz, loc)
 	return nil
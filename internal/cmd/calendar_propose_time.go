@@ -53,7 +53,7 @@ func (c *CalendarProposeTimeCmd) Run(ctx context.Context, flags *RootFlags) erro
 	}
 
 	// Fetch event to display info and verify it exists
-	event, err := svc.Events.Get(calendarID, eventID).Do()
+	event, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("failed to get event: %w", err)
 	}
@@ -97,7 +97,7 @@ func (c *CalendarProposeTimeCmd) Run(ctx context.Context, flags *RootFlags) erro
 			Attendees: event.Attendees,
 		}
 
-		if _, err := svc.Events.Patch(calendarID, eventID, patchEvent).SendUpdates("all").Do(); err != nil {
+		if _, err := svc.Events.Patch(calendarID, eventID, patchEvent).SendUpdates("all").Context(ctx).Do(); err != nil {
 			return fmt.Errorf("failed to decline event: %w", err)
 		}
 	}
@@ -133,7 +133,7 @@ func (c *CalendarProposeTimeCmd) Run(ctx context.Context, flags *RootFlags) erro
 				result["comment"] = strings.TrimSpace(c.Comment)
 			}
 		}
-		return outfmt.WriteJSON(os.Stdout, result)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, result)
 	}
 
 	// Text output
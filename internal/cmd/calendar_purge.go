@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarPurgeCmd struct {
+	CalendarID    string `arg:"" name:"calendarId" help:"Calendar ID"`
+	Query         string `name:"query" short:"q" help:"Free text search (summary, description, location, attendees)"`
+	InstancesOnly bool   `name:"instances-only" help:"Delete matching occurrences of recurring events instead of the whole series"`
+	TimeRangeFlags
+}
+
+type purgeFailure struct {
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+func (c *CalendarPurgeCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	calendarID := strings.TrimSpace(c.CalendarID)
+	if calendarID == "" {
+		return usage("empty calendarId")
+	}
+	query := strings.TrimSpace(c.Query)
+	hasRange := c.hasTimeRange()
+	if query == "" && !hasRange {
+		return usage("required: --query and/or a time range (--from/--to, --today, --week, --days)")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var tr *TimeRange
+	if hasRange {
+		tr, err = ResolveTimeRange(ctx, svc, c.TimeRangeFlags)
+		if err != nil {
+			return err
+		}
+	}
+
+	events, err := listPurgeEvents(ctx, svc, calendarID, query, tr, c.InstancesOnly)
+	if err != nil {
+		return err
+	}
+
+	preview := make([]map[string]any, 0, len(events))
+	for _, ev := range events {
+		preview = append(preview, map[string]any{
+			"id":        ev.Id,
+			"summary":   ev.Summary,
+			"start":     eventStart(ev),
+			"recurring": len(ev.Recurrence) > 0,
+		})
+	}
+	if stop, dryErr := dryRunExit(ctx, flags, "calendar.purge", map[string]any{
+		"calendarId": calendarID,
+		"events":     preview,
+		"count":      len(events),
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	if len(events) == 0 {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"deleted": []string{}, "failed": []purgeFailure{}, "count": 0})
+		}
+		u.Err().Println("No matching events")
+		return nil
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete %d events from calendar %s", len(events), calendarID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	deleted := make([]string, 0, len(events))
+	failed := make([]purgeFailure, 0)
+	progress := u.Progress("delete", len(events))
+	for _, ev := range events {
+		delErr := svc.Events.Delete(calendarID, ev.Id).Context(ctx).Do()
+		progress.Add(1)
+		if delErr != nil {
+			failed = append(failed, purgeFailure{ID: ev.Id, Error: delErr.Error()})
+			continue
+		}
+		deleted = append(deleted, ev.Id)
+	}
+	progress.Done()
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"calendarId": calendarID,
+			"deleted":    deleted,
+			"failed":     failed,
+			"count":      len(deleted),
+		}); err != nil {
+			return err
+		}
+	} else {
+		u.Out().Printf("deleted\t%d", len(deleted))
+		for _, f := range failed {
+			u.Err().Printf("failed\t%s\t%s", f.ID, f.Error)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%d event(s) failed to delete", len(failed))
+	}
+	return nil
+}
+
+// listPurgeEvents returns the events to delete. Without instancesOnly the API
+// is asked for unexpanded events, so a recurring series shows up once as its
+// parent and deleting it removes every occurrence.
+func listPurgeEvents(ctx context.Context, svc *calendar.Service, calendarID, query string, tr *TimeRange, instancesOnly bool) ([]*calendar.Event, error) {
+	items, err := collectAllPages(ctx, "", func(pageToken string) ([]*calendar.Event, string, error) {
+		call := svc.Events.List(calendarID).
+			SingleEvents(instancesOnly).
+			MaxResults(250).
+			Context(ctx)
+		if query != "" {
+			call = call.Q(query)
+		}
+		if tr != nil {
+			call = call.TimeMin(tr.From.Format(time.RFC3339)).TimeMax(tr.To.Format(time.RFC3339))
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*calendar.Event, 0, len(items))
+	for _, ev := range items {
+		if ev == nil || ev.Status == "cancelled" {
+			continue
+		}
+		out = append(out, ev)
+	}
+	return out, nil
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type purgeTestServer struct {
+	mu           sync.Mutex
+	singleEvents []string
+	deleted      []string
+}
+
+func newPurgeTestServer(t *testing.T) *purgeTestServer {
+	t.Helper()
+	state := &purgeTestServer{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		switch {
+		case r.Method == http.MethodGet && path == "/calendars/cal/events":
+			state.mu.Lock()
+			state.singleEvents = append(state.singleEvents, r.URL.Query().Get("singleEvents"))
+			state.mu.Unlock()
+			if r.URL.Query().Get("q") != "junk" {
+				t.Errorf("unexpected query: %q", r.URL.RawQuery)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{
+					{"id": "ev1", "summary": "junk 1", "start": map[string]any{"dateTime": "2025-01-02T10:00:00Z"}},
+					{"id": "series", "summary": "junk weekly", "recurrence": []string{"RRULE:FREQ=WEEKLY"}},
+					{"id": "gone", "summary": "junk cancelled", "status": "cancelled"},
+					{"id": "bad", "summary": "junk locked"},
+				},
+			})
+		case r.Method == http.MethodDelete && path == "/calendars/cal/events/bad":
+			http.Error(w, `{"error":{"code":403,"message":"forbidden"}}`, http.StatusForbidden)
+		case r.Method == http.MethodDelete && strings.HasPrefix(path, "/calendars/cal/events/"):
+			state.mu.Lock()
+			state.deleted = append(state.deleted, strings.TrimPrefix(path, "/calendars/cal/events/"))
+			state.mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+	return state
+}
+
+func TestCalendarPurgeCmd_ReportsFailuresWithoutAborting(t *testing.T) {
+	state := newPurgeTestServer(t)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	flags := &RootFlags{Account: "a@b.com", Force: true}
+	var runErr error
+	out := captureStdout(t, func() {
+		runErr = runKong(t, &CalendarPurgeCmd{}, []string{"cal", "--query", "junk"}, ctx, flags)
+	})
+	if runErr == nil || !strings.Contains(runErr.Error(), "1 event(s) failed") {
+		t.Fatalf("expected failure summary, got %v", runErr)
+	}
+
+	var payload struct {
+		Deleted []string       `json:"deleted"`
+		Failed  []purgeFailure `json:"failed"`
+		Count   int            `json:"count"`
+	}
+	if err := json.Unmarshal([]byte(out), &payload); err != nil {
+		t.Fatalf("decode output: %v\n%s", err, out)
+	}
+	if payload.Count != 2 || strings.Join(payload.Deleted, ",") != "ev1,series" {
+		t.Fatalf("unexpected deleted: %#v", payload)
+	}
+	if len(payload.Failed) != 1 || payload.Failed[0].ID != "bad" {
+		t.Fatalf("unexpected failures: %#v", payload.Failed)
+	}
+	if strings.Join(state.singleEvents, ",") != "false" {
+		t.Fatalf("expected series parents to be listed, got singleEvents=%v", state.singleEvents)
+	}
+}
+
+func TestCalendarPurgeCmd_DryRunDeletesNothing(t *testing.T) {
+	state := newPurgeTestServer(t)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	flags := &RootFlags{Account: "a@b.com", DryRun: true}
+	out := captureStdout(t, func() {
+		if err := runKong(t, &CalendarPurgeCmd{}, []string{"cal", "-q", "junk", "--instances-only"}, ctx, flags); err != nil {
+			t.Fatalf("purge: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"dryRun": true`) || !strings.Contains(out, `"count": 3`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+	if len(state.deleted) != 0 {
+		t.Fatalf("dry run deleted events: %v", state.deleted)
+	}
+	if strings.Join(state.singleEvents, ",") != "true" {
+		t.Fatalf("expected expanded instances, got singleEvents=%v", state.singleEvents)
+	}
+}
+
+func TestCalendarPurgeCmd_RequiresFilter(t *testing.T) {
+	err := (&CalendarPurgeCmd{CalendarID: "cal"}).Run(context.Background(), &RootFlags{Account: "a@b.com"})
+	if err == nil || !strings.Contains(err.Error(), "--query") {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
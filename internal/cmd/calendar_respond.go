@@ -54,7 +54,7 @@ func (c *CalendarRespondCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	event, err := svc.Events.Get(calendarID, eventID).Do()
+	event, err := svc.Events.Get(calendarID, eventID).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -84,14 +84,14 @@ func (c *CalendarRespondCmd) Run(ctx context.Context, flags *RootFlags) error {
 		event.Attendees[*selfAttendee].Comment = strings.TrimSpace(c.Comment)
 	}
 
-	updated, err := svc.Events.Patch(calendarID, eventID, event).Do()
+	updated, err := svc.Events.Patch(calendarID, eventID, event).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
 		tz, loc, _ := getCalendarLocation(ctx, svc, calendarID)
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(updated, tz, loc)})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(updated, tz, loc)})
 	}
 
 	u.Out().Printf("id\t%s", updated.Id)
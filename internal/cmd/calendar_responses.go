@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"strings"
+
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// eventResponseSummary tallies RSVPs of the required attendees; optional
+// attendees are listed separately and resources (rooms) are left out.
+type eventResponseSummary struct {
+	Total       int                     `json:"total"`
+	Accepted    int                     `json:"accepted"`
+	Declined    int                     `json:"declined"`
+	Tentative   int                     `json:"tentative"`
+	NeedsAction int                     `json:"needsAction"`
+	Optional    []eventOptionalAttendee `json:"optional"`
+}
+
+type eventOptionalAttendee struct {
+	Email          string `json:"email"`
+	ResponseStatus string `json:"responseStatus"`
+}
+
+// summarizeEventResponses returns nil when the event has no people attendees.
+func summarizeEventResponses(attendees []*calendar.EventAttendee) *eventResponseSummary {
+	summary := &eventResponseSummary{Optional: []eventOptionalAttendee{}}
+	people := 0
+	for _, a := range attendees {
+		if a == nil || a.Resource || strings.TrimSpace(a.Email) == "" {
+			continue
+		}
+		people++
+		status := a.ResponseStatus
+		if status == "" {
+			status = "needsAction"
+		}
+		if a.Optional {
+			summary.Optional = append(summary.Optional, eventOptionalAttendee{Email: strings.TrimSpace(a.Email), ResponseStatus: status})
+			continue
+		}
+		summary.Total++
+		switch status {
+		case "accepted":
+			summary.Accepted++
+		case "declined":
+			summary.Declined++
+		case "tentative":
+			summary.Tentative++
+		default:
+			summary.NeedsAction++
+		}
+	}
+	if people == 0 {
+		return nil
+	}
+	return summary
+}
+
+func printEventResponseSummary(u *ui.UI, summary *eventResponseSummary) {
+	if summary == nil {
+		return
+	}
+	u.Out().Printf("responses\t%d accepted, %d declined, %d tentative, %d awaiting (of %d required)",
+		summary.Accepted, summary.Declined, summary.Tentative, summary.NeedsAction, summary.Total)
+	for _, o := range summary.Optional {
+		u.Out().Printf("optional\t%s\t%s", o.Email, o.ResponseStatus)
+	}
+}
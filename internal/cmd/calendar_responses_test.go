@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestSummarizeEventResponses(t *testing.T) {
+	if summarizeEventResponses(nil) != nil {
+		t.Fatalf("expected nil summary without attendees")
+	}
+	if summarizeEventResponses([]*calendar.EventAttendee{{Email: "room@resource.calendar.google.com", Resource: true}}) != nil {
+		t.Fatalf("expected nil summary for rooms only")
+	}
+
+	got := summarizeEventResponses([]*calendar.EventAttendee{
+		{Email: "a@x.com", ResponseStatus: "accepted"},
+		{Email: "b@x.com", ResponseStatus: "declined"},
+		{Email: "c@x.com", ResponseStatus: "tentative"},
+		{Email: "d@x.com"},
+		{Email: "e@x.com", ResponseStatus: "accepted", Optional: true},
+		{Email: "room@resource.calendar.google.com", ResponseStatus: "accepted", Resource: true},
+	})
+	if got.Total != 4 || got.Accepted != 1 || got.Declined != 1 || got.Tentative != 1 || got.NeedsAction != 1 {
+		t.Fatalf("unexpected tally: %#v", got)
+	}
+	if len(got.Optional) != 1 || got.Optional[0].Email != "e@x.com" || got.Optional[0].ResponseStatus != "accepted" {
+		t.Fatalf("unexpected optional: %#v", got.Optional)
+	}
+}
+
+func TestCalendarEventCmd_ResponseSummary(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch path {
+		case "/calendars/cal1/events/evt1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":      "evt1",
+				"summary": "Planning",
+				"start":   map[string]any{"dateTime": "2025-01-01T10:00:00Z"},
+				"end":     map[string]any{"dateTime": "2025-01-01T11:00:00Z"},
+				"attendees": []map[string]any{
+					{"email": "a@x.com", "responseStatus": "accepted"},
+					{"email": "b@x.com", "responseStatus": "needsAction"},
+					{"email": "c@x.com", "responseStatus": "tentative", "optional": true},
+				},
+			})
+		case "/calendars/cal1":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "cal1", "timeZone": "UTC"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	flags := &RootFlags{Account: "a@b.com"}
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+
+	jsonCtx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &CalendarEventCmd{}, []string{"cal1", "evt1"}, jsonCtx, flags); err != nil {
+			t.Fatalf("runKong: %v", err)
+		}
+	})
+	var parsed struct {
+		Event struct {
+			ID string `json:"id"`
+		} `json:"event"`
+		ResponseSummary eventResponseSummary `json:"responseSummary"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	s := parsed.ResponseSummary
+	if parsed.Event.ID != "evt1" || s.Total != 2 || s.Accepted != 1 || s.NeedsAction != 1 || len(s.Optional) != 1 {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+
+	out = captureStdout(t, func() {
+		textUI, uiErr := ui.New(ui.Options{Stdout: os.Stdout, Stderr: io.Discard, Color: "never"})
+		if uiErr != nil {
+			t.Fatalf("ui.New: %v", uiErr)
+		}
+		textCtx := ui.WithUI(context.Background(), textUI)
+		if err := runKong(t, &CalendarEventCmd{}, []string{"cal1", "evt1"}, textCtx, flags); err != nil {
+			t.Fatalf("runKong: %v", err)
+		}
+	})
+	if !strings.Contains(out, "responses\t1 accepted, 0 declined, 0 tentative, 1 awaiting (of 2 required)") ||
+		!strings.Contains(out, "optional\tc@x.com\ttentative") {
+		t.Fatalf("unexpected text output: %q", out)
+	}
+}
@@ -19,6 +19,8 @@ type CalendarSearchCmd struct {
 	Max        int64  `name:"max" aliases:"limit" help:"Max results" default:"25"`
 }
 
+func (*CalendarSearchCmd) fieldsResource() string { return "events" }
+
 func (c *CalendarSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -52,14 +54,15 @@ func (c *CalendarSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 		SingleEvents(true).
 		OrderBy("startTime")
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
+	displayLoc := displayTimezoneFromContext(ctx)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"events": wrapEventsWithDays(resp.Items),
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"events": withDisplayTimezones(wrapEventsWithDays(resp.Items), displayLoc),
 			"query":  query,
 		})
 	}
@@ -72,7 +75,7 @@ func (c *CalendarSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
 	fmt.Fprintln(tw, "ID\tSTART\tEND\tSUMMARY")
 	for _, e := range resp.Items {
-		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", e.Id, eventStart(e), eventEnd(e), e.Summary)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", e.Id, withDisplayTime(eventStart(e), e.Start, displayLoc), withDisplayTime(eventEnd(e), e.End, displayLoc), e.Summary)
 	}
 	_ = tw.Flush()
 	return nil
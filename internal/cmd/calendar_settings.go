@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/alecthomas/kong"
+	"google.golang.org/api/calendar/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type CalendarSettingsCmd struct {
+	Calendar        string   `arg:"" name:"calendar" help:"Calendar ID or name (summary)"`
+	SummaryOverride string   `name:"summary-override" help:"Name shown for this calendar in your list (empty clears the override)"`
+	Color           string   `name:"calendar-color" help:"Calendar color ID (see 'gog calendar colors')"`
+	Hidden          *bool    `name:"hidden" help:"Hide the calendar from your list (--hidden=false to show it)"`
+	Selected        *bool    `name:"selected" help:"Show the calendar's events in the UI (--selected=false to deselect)"`
+	Reminders       []string `name:"reminder" help:"Default reminder as a duration for a popup (30m) or method:duration / method@duration (email@1d); can be repeated (max 5)"`
+	ClearReminders  bool     `name:"clear-reminders" help:"Remove all default reminders"`
+}
+
+func (c *CalendarSettingsCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	ref := strings.TrimSpace(c.Calendar)
+	if ref == "" {
+		return usage("empty calendar")
+	}
+	if c.ClearReminders && len(c.Reminders) > 0 {
+		return usage("--clear-reminders cannot be combined with --reminder")
+	}
+
+	patch := &calendar.CalendarListEntry{}
+	changed := false
+	if flagProvided(kctx, "summary-override") {
+		patch.SummaryOverride = strings.TrimSpace(c.SummaryOverride)
+		patch.ForceSendFields = append(patch.ForceSendFields, "SummaryOverride")
+		changed = true
+	}
+	if c.Hidden != nil {
+		patch.Hidden = *c.Hidden
+		patch.ForceSendFields = append(patch.ForceSendFields, "Hidden")
+		changed = true
+	}
+	if c.Selected != nil {
+		patch.Selected = *c.Selected
+		patch.ForceSendFields = append(patch.ForceSendFields, "Selected")
+		changed = true
+	}
+	if len(c.Reminders) > 0 {
+		reminders, remErr := buildReminders(c.Reminders)
+		if remErr != nil {
+			return usage(remErr.Error())
+		}
+		if reminders != nil {
+			patch.DefaultReminders = reminders.Overrides
+			changed = true
+		}
+	}
+	if c.ClearReminders {
+		patch.DefaultReminders = []*calendar.EventReminder{}
+		patch.ForceSendFields = append(patch.ForceSendFields, "DefaultReminders")
+		changed = true
+	}
+	color := strings.TrimSpace(c.Color)
+	if color != "" {
+		changed = true
+	}
+	if !changed {
+		return usage("no settings provided (use --summary-override, --calendar-color, --hidden, --selected, --reminder or --clear-reminders)")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	calendarID, err := resolveCalendarID(ctx, svc, ref)
+	if err != nil {
+		return err
+	}
+	if color != "" {
+		if err = validateCalendarColor(ctx, svc, color); err != nil {
+			return err
+		}
+		patch.ColorId = color
+	}
+
+	updated, err := svc.CalendarList.Patch(calendarID, patch).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"calendar": updated})
+	}
+
+	u.Out().Printf("id\t%s", updated.Id)
+	u.Out().Printf("summary\t%s", updated.Summary)
+	if updated.SummaryOverride != "" {
+		u.Out().Printf("summary_override\t%s", updated.SummaryOverride)
+	}
+	u.Out().Printf("color\t%s", updated.ColorId)
+	u.Out().Printf("hidden\t%t", updated.Hidden)
+	u.Out().Printf("selected\t%t", updated.Selected)
+	reminders := make([]string, 0, len(updated.DefaultReminders))
+	for _, r := range updated.DefaultReminders {
+		reminders = append(reminders, fmt.Sprintf("%s:%dm", r.Method, r.Minutes))
+	}
+	u.Out().Printf("reminders\t%s", strings.Join(reminders, ","))
+	return nil
+}
+
+// resolveCalendarID accepts a calendar ID (including "primary" and email-style
+// IDs) or the name of a calendar in the user's list, matched case-insensitively
+// against its summary and summary override.
+func resolveCalendarID(ctx context.Context, svc *calendar.Service, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", usage("empty calendar")
+	}
+	if strings.EqualFold(ref, "primary") || strings.Contains(ref, "@") {
+		return ref, nil
+	}
+
+	var matches []*calendar.CalendarListEntry
+	pageToken := ""
+	for {
+		resp, err := svc.CalendarList.List().PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			return "", err
+		}
+		for _, cal := range resp.Items {
+			if cal.Id == ref {
+				return cal.Id, nil
+			}
+			if strings.EqualFold(cal.Summary, ref) || strings.EqualFold(cal.SummaryOverride, ref) {
+				matches = append(matches, cal)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", usagef("no calendar with ID or name %q (see 'gog calendar calendars')", ref)
+	case 1:
+		return matches[0].Id, nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, m := range matches {
+			ids = append(ids, m.Id)
+		}
+		return "", usagef("calendar name %q is ambiguous; use an ID: %s", ref, strings.Join(ids, ", "))
+	}
+}
+
+// validateCalendarColor checks id against the calendar (not event) palette.
+func validateCalendarColor(ctx context.Context, svc *calendar.Service, id string) error {
+	colors, err := svc.Colors.Get().Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("fetch color palette: %w", err)
+	}
+	if _, ok := colors.Calendar[id]; ok {
+		return nil
+	}
+	ids := make([]int, 0, len(colors.Calendar))
+	for k := range colors.Calendar {
+		if n, convErr := strconv.Atoi(k); convErr == nil {
+			ids = append(ids, n)
+		}
+	}
+	sort.Ints(ids)
+	if len(ids) == 0 {
+		return usagef("invalid calendar color %q", id)
+	}
+	return usagef("invalid calendar color %q (valid IDs: %d-%d; see 'gog calendar colors')", id, ids[0], ids[len(ids)-1])
+}
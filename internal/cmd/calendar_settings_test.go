@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestCalendarSettingsCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var patched map[string]any
+	var patchedPath string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && path == "/users/me/calendarList":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "primary@example.com", "summary": "Me"},
+				{"id": "team123@group.calendar.google.com", "summary": "Team"},
+				{"id": "dup1@group.calendar.google.com", "summary": "Dup"},
+				{"id": "dup2@group.calendar.google.com", "summary": "Other", "summaryOverride": "dup"},
+			}})
+		case r.Method == http.MethodGet && path == "/colors":
+			_ = json.NewEncoder(w).Encode(map[string]any{"calendar": map[string]any{
+				"1": map[string]any{"background": "#ac725e"},
+				"2": map[string]any{"background": "#d06b64"},
+			}})
+		case r.Method == http.MethodPatch && strings.HasPrefix(path, "/users/me/calendarList/"):
+			patchedPath = path
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &patched)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "team123@group.calendar.google.com", "summary": "Team", "colorId": "2"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "calendar", "settings", "team",
+			"--calendar-color", "2", "--hidden=false", "--summary-override", "", "--reminder", "popup:15m"}); err != nil {
+			t.Fatalf("settings: %v", err)
+		}
+	})
+	if patchedPath != "/users/me/calendarList/team123@group.calendar.google.com" {
+		t.Fatalf("unexpected patch path %q", patchedPath)
+	}
+	if patched["colorId"] != "2" || patched["hidden"] != false || patched["summaryOverride"] != "" {
+		t.Fatalf("unexpected patch body: %#v", patched)
+	}
+	if rem, ok := patched["defaultReminders"].([]any); !ok || len(rem) != 1 {
+		t.Fatalf("unexpected reminders: %#v", patched["defaultReminders"])
+	}
+	if _, ok := patched["selected"]; ok {
+		t.Fatalf("selected must not be sent when not provided: %#v", patched)
+	}
+	if !strings.Contains(out, `"calendar"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"calendar", "settings", "team", "--calendar-color", "99"}, "invalid calendar color"},
+		{[]string{"calendar", "settings", "Dup", "--hidden"}, "ambiguous"},
+		{[]string{"calendar", "settings", "nope", "--hidden"}, "no calendar"},
+		{[]string{"calendar", "settings", "team"}, "no settings provided"},
+	} {
+		var runErr error
+		_ = captureStderr(t, func() {
+			runErr = Execute(append([]string{"--account", "a@b.com"}, tc.args...))
+		})
+		if runErr == nil || !strings.Contains(runErr.Error(), tc.want) {
+			t.Fatalf("%v: expected %q, got %v", tc.args, tc.want, runErr)
+		}
+	}
+}
@@ -136,7 +136,7 @@ func (c *CalendarTeamCmd) runFreeBusy(ctx context.Context, svc *calendar.Service
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"group":    c.GroupEmail,
 			"timeMin":  tr.From.Format(time.RFC3339),
 			"timeMax":  tr.To.Format(time.RFC3339),
@@ -188,7 +188,7 @@ func (c *CalendarTeamCmd) runEvents(ctx context.Context, svc *calendar.Service,
 				OrderBy("startTime").
 				Context(ctx)
 
-			resp, err := call.Do()
+			resp, err := call.Context(ctx).Do()
 			if err != nil {
 				mu.Lock()
 				errors = append(errors, fmt.Sprintf("%s: %v", email, err))
@@ -265,7 +265,7 @@ func (c *CalendarTeamCmd) runEvents(ctx context.Context, svc *calendar.Service,
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"group":    c.GroupEmail,
 			"timeMin":  tr.From.Format(time.RFC3339),
 			"timeMax":  tr.To.Format(time.RFC3339),
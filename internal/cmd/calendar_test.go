@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"strings"
 	"testing"
 
 	"google.golang.org/api/calendar/v3"
@@ -158,6 +159,10 @@ func TestParseAttendee(t *testing.T) {
 		{"bob@example.com;optional", "bob@example.com", true, "", false},
 		{"carol@example.com;comment=FYI only", "carol@example.com", false, "FYI only", false},
 		{"dave@example.com;OPTIONAL;comment=Hi", "dave@example.com", true, "Hi", false},
+		{"erin@example.com:optional", "erin@example.com", true, "", false},
+		{"frank@example.com:Optional;comment=Maybe", "frank@example.com", true, "Maybe", false},
+		{"odd:name@example.com", "odd:name@example.com", false, "", false},
+		{":optional", "", false, "", true},
 		{";optional", "", false, "", true},
 		{"", "", false, "", true},
 	}
@@ -180,6 +185,28 @@ func TestParseAttendee(t *testing.T) {
 	}
 }
 
+func TestParseAttendee_Resource(t *testing.T) {
+	got := parseAttendee("room-1@resource.calendar.google.com:resource")
+	if got == nil || got.Email != "room-1@resource.calendar.google.com" || !got.Resource || got.Optional {
+		t.Fatalf("unexpected attendee: %#v", got)
+	}
+
+	got = parseAttendee("room-2@resource.calendar.google.com:resource:optional")
+	if got == nil || got.Email != "room-2@resource.calendar.google.com" || !got.Resource || !got.Optional {
+		t.Fatalf("unexpected attendee: %#v", got)
+	}
+
+	rooms := buildRoomAttendees([]string{"a@resource.calendar.google.com", "b@resource.calendar.google.com, c@resource.calendar.google.com"})
+	if len(rooms) != 3 {
+		t.Fatalf("expected 3 rooms, got %#v", rooms)
+	}
+	for _, r := range rooms {
+		if !r.Resource || r.Optional {
+			t.Fatalf("unexpected room attendee: %#v", r)
+		}
+	}
+}
+
 func TestRecurrenceUntil(t *testing.T) {
 	got, err := recurrenceUntil("2025-01-10")
 	if err != nil {
@@ -292,6 +319,15 @@ func TestParseReminder(t *testing.T) {
 		{"POPUP:1d", "popup", 1440, false},
 		{"EMAIL:3d", "email", 4320, false},
 		{"popup:60", "popup", 60, false},
+		{"30m", "popup", 30, false},
+		{"1h", "popup", 60, false},
+		{"1D", "popup", 1440, false},
+		{"email@1d", "email", 1440, false},
+		{"Popup@15m", "popup", 15, false},
+		{"email", "", 0, true},
+		{"email@", "", 0, true},
+		{"sms@1d", "", 0, true},
+		{"5w", "", 0, true},
 		{"", "", 0, true},
 		{"popup", "", 0, true},
 		{"sms:30m", "", 0, true},
@@ -360,6 +396,23 @@ func TestBuildReminders(t *testing.T) {
 	if err == nil {
 		t.Fatalf("expected error for invalid reminder")
 	}
+	if !strings.Contains(err.Error(), "--reminder") || !strings.Contains(err.Error(), `"invalid"`) {
+		t.Fatalf("expected error to name the flag and value, got %v", err)
+	}
+
+	got, err = buildReminders([]string{"10m", "email@1d", "popup:1h", "2h", "1w"})
+	if err != nil {
+		t.Fatalf("unexpected error for friendly forms at the cap: %v", err)
+	}
+	if len(got.Overrides) != 5 || got.Overrides[0].Method != "popup" || got.Overrides[0].Minutes != 10 ||
+		got.Overrides[1].Method != "email" || got.Overrides[1].Minutes != 1440 || got.Overrides[4].Minutes != 10080 {
+		t.Fatalf("unexpected overrides: %#v", got.Overrides)
+	}
+
+	_, err = buildReminders([]string{"1m", "2m", "3m", "4m", "5m", "6m"})
+	if err == nil || !strings.Contains(err.Error(), "maximum 5") {
+		t.Fatalf("expected cap error for 6 friendly reminders, got %v", err)
+	}
 }
 
 func hasStringValue(values []string, value string) bool {
@@ -12,6 +12,21 @@ const (
 	sendUpdatesNone         = "none"
 )
 
+// eventColorNames are the names Google Calendar shows for event color IDs.
+var eventColorNames = map[string]string{
+	"1":  "lavender",
+	"2":  "sage",
+	"3":  "grape",
+	"4":  "flamingo",
+	"5":  "banana",
+	"6":  "tangerine",
+	"7":  "peacock",
+	"8":  "graphite",
+	"9":  "blueberry",
+	"10": "basil",
+	"11": "tomato",
+}
+
 func validateColorId(s string) (string, error) {
 	s = strings.TrimSpace(s)
 	if s == "" {
@@ -19,7 +34,10 @@ func validateColorId(s string) (string, error) {
 	}
 	id, err := strconv.Atoi(s)
 	if err != nil {
-		return "", fmt.Errorf("invalid color ID: %q (must be 1-11)", s)
+		if named, ok := eventColorIDByName(s); ok {
+			return named, nil
+		}
+		return "", fmt.Errorf("invalid color: %q (must be 1-11 or a name like tomato, sage; see 'gog calendar colors')", s)
 	}
 	if id < 1 || id > 11 {
 		return "", fmt.Errorf("color ID must be 1-11 (got %d)", id)
@@ -27,6 +45,16 @@ func validateColorId(s string) (string, error) {
 	return s, nil
 }
 
+func eventColorIDByName(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for id, n := range eventColorNames {
+		if n == name {
+			return id, true
+		}
+	}
+	return "", false
+}
+
 func validateVisibility(s string) (string, error) {
 	s = strings.TrimSpace(strings.ToLower(s))
 	if s == "" {
@@ -15,6 +15,12 @@ func TestValidateColorID(t *testing.T) {
 	if _, err := validateColorId("nope"); err == nil {
 		t.Fatalf("expected error for non-numeric")
 	}
+	if got, err := validateColorId("Tomato"); err != nil || got != "11" {
+		t.Fatalf("expected tomato -> 11, got %q %v", got, err)
+	}
+	if got, err := validateColorId(" sage "); err != nil || got != "2" {
+		t.Fatalf("expected sage -> 2, got %q %v", got, err)
+	}
 }
 
 func TestValidateVisibilityMore(t *testing.T) {
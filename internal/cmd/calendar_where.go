@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+)
+
+type CalendarWhereCmd struct {
+	Attendees string `name:"attendees" help:"Comma-separated attendee emails/calendar IDs (required)"`
+	Date      string `name:"date" help:"Day to check (YYYY-MM-DD or relative: today, tomorrow, monday)" default:"today"`
+}
+
+type workingLocation struct {
+	Type  string `json:"type"` // home, office, custom
+	Label string `json:"label,omitempty"`
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+func (c *CalendarWhereCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	attendees := splitCSV(c.Attendees)
+	if len(attendees) == 0 {
+		return usage("required: --attendees")
+	}
+
+	svc, err := newCalendarService(ctx, account)
+	if err != nil {
+		return err
+	}
+	loc, err := getUserTimezone(ctx, svc)
+	if err != nil {
+		return err
+	}
+	day, err := parseTimeExpr(c.Date, time.Now().In(loc), loc)
+	if err != nil {
+		return usagef("invalid --date: %v", err)
+	}
+	from, to := startOfDay(day.In(loc)), endOfDay(day.In(loc))
+
+	locations := make(map[string]*workingLocation, len(attendees))
+	failures := make(map[string]string)
+	for _, email := range attendees {
+		resp, listErr := svc.Events.List(email).
+			EventTypes(eventTypeWorkingLocation).
+			SingleEvents(true).
+			OrderBy("startTime").
+			TimeMin(from.Format(time.RFC3339)).
+			TimeMax(to.Format(time.RFC3339)).
+			Context(ctx).
+			Do()
+		if listErr != nil {
+			// One private or unknown calendar should not hide everyone else.
+			failures[email] = workingLocationErrorReason(listErr)
+			continue
+		}
+		locations[email] = pickWorkingLocation(resp.Items, loc)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"date":      from.Format("2006-01-02"),
+			"timezone":  loc.String(),
+			"locations": locations,
+			"errors":    failures,
+		})
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "WHO\tTYPE\tLOCATION")
+	for _, email := range attendees {
+		if reason, ok := failures[email]; ok {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", sanitizeTab(email), "error", sanitizeTab(reason))
+			continue
+		}
+		wl := locations[email]
+		if wl == nil {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", sanitizeTab(email), "-", "(not set)")
+			continue
+		}
+		where := wl.Label
+		if where == "" {
+			where = "-"
+		}
+		if wl.Start != "" {
+			where = fmt.Sprintf("%s (%s-%s)", where, wl.Start, wl.End)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", sanitizeTab(email), wl.Type, sanitizeTab(where))
+	}
+	return nil
+}
+
+// pickWorkingLocation prefers the all-day working location and otherwise
+// falls back to the earliest partial-day one. Nil means none was set.
+func pickWorkingLocation(events []*calendar.Event, loc *time.Location) *workingLocation {
+	var partial *workingLocation
+	for _, ev := range events {
+		if ev == nil || ev.Status == "cancelled" {
+			continue
+		}
+		wl := workingLocationFromProperties(ev.WorkingLocationProperties)
+		if wl == nil {
+			continue
+		}
+		if ev.Start != nil && ev.Start.Date != "" {
+			return wl
+		}
+		if partial == nil {
+			if start, err := time.Parse(time.RFC3339, eventDateTimeValue(ev.Start)); err == nil {
+				wl.Start = start.In(loc).Format("15:04")
+			}
+			if end, err := time.Parse(time.RFC3339, eventDateTimeValue(ev.End)); err == nil {
+				wl.End = end.In(loc).Format("15:04")
+			}
+			partial = wl
+		}
+	}
+	return partial
+}
+
+func workingLocationErrorReason(err error) string {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && (gerr.Code == http.StatusNotFound || gerr.Code == http.StatusForbidden) {
+		return "calendar not accessible"
+	}
+	return err.Error()
+}
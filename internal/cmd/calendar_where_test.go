@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestCalendarWhereCmd(t *testing.T) {
+	origNew := newCalendarService
+	t.Cleanup(func() { newCalendarService = origNew })
+
+	var eventTypes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/calendar/v3")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case path == "/users/me/calendarList/primary":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "primary", "timeZone": "Europe/Berlin"})
+		case path == "/calendars/home@x.com/events":
+			eventTypes = append(eventTypes, r.URL.Query().Get("eventTypes"))
+			if r.URL.Query().Get("timeMin") != "2025-03-10T00:00:00+01:00" {
+				t.Errorf("unexpected timeMin %q", r.URL.Query().Get("timeMin"))
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{
+				"id":                        "wl1",
+				"eventType":                 "workingLocation",
+				"start":                     map[string]any{"date": "2025-03-10"},
+				"end":                       map[string]any{"date": "2025-03-11"},
+				"workingLocationProperties": map[string]any{"type": "homeOffice", "homeOffice": map[string]any{}},
+			}}})
+		case path == "/calendars/office@x.com/events":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{
+				"id":                        "wl2",
+				"eventType":                 "workingLocation",
+				"start":                     map[string]any{"dateTime": "2025-03-10T09:00:00+01:00"},
+				"end":                       map[string]any{"dateTime": "2025-03-10T13:00:00+01:00"},
+				"workingLocationProperties": map[string]any{"type": "officeLocation", "officeLocation": map[string]any{"label": "Berlin HQ"}},
+			}}})
+		case path == "/calendars/unset@x.com/events":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []any{}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": 404, "message": "Not Found"}})
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newCalendarService = func(context.Context, string) (*calendar.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	flags := &RootFlags{Account: "a@b.com"}
+	args := []string{"--attendees", "home@x.com,office@x.com,unset@x.com,private@x.com", "--date", "2025-03-10"}
+
+	jsonCtx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &CalendarWhereCmd{}, args, jsonCtx, flags); err != nil {
+			t.Fatalf("where: %v", err)
+		}
+	})
+	var parsed struct {
+		Date      string                      `json:"date"`
+		Locations map[string]*workingLocation `json:"locations"`
+		Errors    map[string]string           `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(eventTypes) != 1 || eventTypes[0] != "workingLocation" {
+		t.Fatalf("expected workingLocation filter, got %v", eventTypes)
+	}
+	if parsed.Date != "2025-03-10" || parsed.Locations["home@x.com"].Type != "home" {
+		t.Fatalf("unexpected result: %+v", parsed)
+	}
+	if office := parsed.Locations["office@x.com"]; office == nil || office.Type != "office" || office.Label != "Berlin HQ" || office.Start != "09:00" {
+		t.Fatalf("unexpected office location: %+v", office)
+	}
+	if got, ok := parsed.Locations["unset@x.com"]; !ok || got != nil {
+		t.Fatalf("expected null location for unset@x.com, got %+v (present=%v)", got, ok)
+	}
+	if parsed.Errors["private@x.com"] != "calendar not accessible" {
+		t.Fatalf("unexpected errors: %+v", parsed.Errors)
+	}
+
+	textOut := captureStdout(t, func() {
+		if err := runKong(t, &CalendarWhereCmd{}, args, ui.WithUI(context.Background(), u), flags); err != nil {
+			t.Fatalf("where text: %v", err)
+		}
+	})
+	for _, want := range []string{"Berlin HQ (09:00-13:00)", "(not set)", "calendar not accessible"} {
+		if !strings.Contains(textOut, want) {
+			t.Fatalf("expected %q in output: %q", want, textOut)
+		}
+	}
+
+	if err := runKong(t, &CalendarWhereCmd{}, []string{"--date", "today"}, jsonCtx, flags); err == nil {
+		t.Fatal("expected error without --attendees")
+	}
+}
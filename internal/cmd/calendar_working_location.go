@@ -51,14 +51,14 @@ func (c *CalendarWorkingLocationCmd) Run(ctx context.Context, flags *RootFlags)
 		WorkingLocationProperties: props,
 	}
 
-	created, err := svc.Events.Insert(c.CalendarID, event).Do()
+	created, err := svc.Events.Insert(c.CalendarID, event).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	tz, loc, _ := getCalendarLocation(ctx, svc, c.CalendarID)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"event": wrapEventWithDaysWithTimezone(created, tz, loc)})
 	}
 	printCalendarEventWithTimezone(u, created, tz, loc)
 	return nil
@@ -139,3 +139,32 @@ func workingLocationSummary(input workingLocationInput) string {
 		return "Working location"
 	}
 }
+
+// workingLocationFromProperties is the inverse of buildWorkingLocationProperties,
+// mapping API types back to home, office or custom.
+func workingLocationFromProperties(props *calendar.EventWorkingLocationProperties) *workingLocation {
+	if props == nil {
+		return nil
+	}
+	switch props.Type {
+	case "homeOffice":
+		return &workingLocation{Type: "home"}
+	case "officeLocation":
+		wl := &workingLocation{Type: "office"}
+		if office := props.OfficeLocation; office != nil {
+			wl.Label = strings.TrimSpace(office.Label)
+			if wl.Label == "" {
+				wl.Label = strings.TrimSpace(office.BuildingId)
+			}
+		}
+		return wl
+	case "customLocation":
+		wl := &workingLocation{Type: "custom"}
+		if props.CustomLocation != nil {
+			wl.Label = strings.TrimSpace(props.CustomLocation.Label)
+		}
+		return wl
+	default:
+		return nil
+	}
+}
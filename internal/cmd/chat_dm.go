@@ -71,13 +71,13 @@ func (c *ChatDMSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		call = call.MessageReplyOption("REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
 	}
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"message": resp})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"message": resp})
 	}
 
 	if resp == nil {
@@ -126,7 +126,7 @@ func (c *ChatDMSpaceCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"space": space})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"space": space})
 	}
 	if space.Name != "" {
 		u.Out().Printf("resource\t%s", space.Name)
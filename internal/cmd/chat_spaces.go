@@ -67,7 +67,7 @@ func (c *ChatSpacesListCmd) Run(ctx context.Context, flags *RootFlags) error {
 				ThreadState: space.SpaceThreadingState,
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"spaces":        items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -127,7 +127,7 @@ func (c *ChatSpacesFindCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if pageToken != "" {
 			call = call.PageToken(pageToken)
 		}
-		resp, err := call.Do()
+		resp, err := call.Context(ctx).Do()
 		if err != nil {
 			return err
 		}
@@ -164,7 +164,7 @@ func (c *ChatSpacesFindCmd) Run(ctx context.Context, flags *RootFlags) error {
 				SpaceURI:  space.SpaceUri,
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"spaces": items})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"spaces": items})
 	}
 
 	if len(matches) == 0 {
@@ -237,13 +237,13 @@ func (c *ChatSpacesCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if len(memberships) > 0 {
 		req.Memberships = memberships
 	}
-	resp, err := svc.Spaces.Setup(req).Do()
+	resp, err := svc.Spaces.Setup(req).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"space": resp})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"space": resp})
 	}
 
 	if resp == nil {
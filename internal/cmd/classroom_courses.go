@@ -66,7 +66,7 @@ func (c *ClassroomCoursesListCmd) Run(ctx context.Context, flags *RootFlags) err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"courses":       resp.Courses,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -122,7 +122,7 @@ func (c *ClassroomCoursesGetCmd) Run(ctx context.Context, flags *RootFlags) erro
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"course": course})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"course": course})
 	}
 
 	u.Out().Printf("id\t%s", course.Id)
@@ -208,7 +208,7 @@ func (c *ClassroomCoursesCreateCmd) Run(ctx context.Context, flags *RootFlags) e
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"course": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"course": created})
 	}
 	u.Out().Printf("id\t%s", created.Id)
 	u.Out().Printf("name\t%s", created.Name)
@@ -285,7 +285,7 @@ func (c *ClassroomCoursesUpdateCmd) Run(ctx context.Context, flags *RootFlags) e
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"course": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"course": updated})
 	}
 	u := ui.FromContext(ctx)
 	u.Out().Printf("id\t%s", updated.Id)
@@ -324,7 +324,7 @@ func (c *ClassroomCoursesDeleteCmd) Run(ctx context.Context, flags *RootFlags) e
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted":  true,
 			"courseId": courseID,
 		})
@@ -373,7 +373,7 @@ func updateCourseState(ctx context.Context, flags *RootFlags, courseID, state st
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"course": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"course": updated})
 	}
 	u.Out().Printf("id\t%s", updated.Id)
 	u.Out().Printf("state\t%s", updated.CourseState)
@@ -420,7 +420,7 @@ func (c *ClassroomCoursesJoinCmd) Run(ctx context.Context, flags *RootFlags) err
 			return wrapClassroomError(err)
 		}
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"student": created})
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"student": created})
 		}
 		u.Out().Printf("user_id\t%s", created.UserId)
 		u.Out().Printf("email\t%s", profileEmail(created.Profile))
@@ -433,7 +433,7 @@ func (c *ClassroomCoursesJoinCmd) Run(ctx context.Context, flags *RootFlags) err
 			return wrapClassroomError(err)
 		}
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"teacher": created})
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"teacher": created})
 		}
 		u.Out().Printf("user_id\t%s", created.UserId)
 		u.Out().Printf("email\t%s", profileEmail(created.Profile))
@@ -490,7 +490,7 @@ func (c *ClassroomCoursesLeaveCmd) Run(ctx context.Context, flags *RootFlags) er
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"removed":  true,
 			"courseId": courseID,
 			"userId":   userID,
@@ -532,7 +532,7 @@ func (c *ClassroomCoursesURLCmd) Run(ctx context.Context, flags *RootFlags) erro
 			}
 			urls = append(urls, map[string]string{"id": id, "url": link})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"urls": urls})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"urls": urls})
 	}
 
 	for _, id := range c.CourseIDs {
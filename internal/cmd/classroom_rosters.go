@@ -47,7 +47,7 @@ func (c *ClassroomStudentsListCmd) Run(ctx context.Context, flags *RootFlags) er
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"students":      resp.Students,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -106,7 +106,7 @@ func (c *ClassroomStudentsGetCmd) Run(ctx context.Context, flags *RootFlags) err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"student": student})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"student": student})
 	}
 
 	u.Out().Printf("user_id\t%s", student.UserId)
@@ -155,7 +155,7 @@ func (c *ClassroomStudentsAddCmd) Run(ctx context.Context, flags *RootFlags) err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"student": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"student": created})
 	}
 	u.Out().Printf("user_id\t%s", created.UserId)
 	u.Out().Printf("email\t%s", profileEmail(created.Profile))
@@ -198,7 +198,7 @@ func (c *ClassroomStudentsRemoveCmd) Run(ctx context.Context, flags *RootFlags)
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"removed":  true,
 			"courseId": courseID,
 			"userId":   userID,
@@ -245,7 +245,7 @@ func (c *ClassroomTeachersListCmd) Run(ctx context.Context, flags *RootFlags) er
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"teachers":      resp.Teachers,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -304,7 +304,7 @@ func (c *ClassroomTeachersGetCmd) Run(ctx context.Context, flags *RootFlags) err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"teacher": teacher})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"teacher": teacher})
 	}
 
 	u.Out().Printf("user_id\t%s", teacher.UserId)
@@ -345,7 +345,7 @@ func (c *ClassroomTeachersAddCmd) Run(ctx context.Context, flags *RootFlags) err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"teacher": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"teacher": created})
 	}
 	u.Out().Printf("user_id\t%s", created.UserId)
 	u.Out().Printf("email\t%s", profileEmail(created.Profile))
@@ -388,7 +388,7 @@ func (c *ClassroomTeachersRemoveCmd) Run(ctx context.Context, flags *RootFlags)
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"removed":  true,
 			"courseId": courseID,
 			"userId":   userID,
@@ -453,7 +453,7 @@ func (c *ClassroomRosterCmd) Run(ctx context.Context, flags *RootFlags) error {
 			payload["teachers"] = teachersResp.Teachers
 			payload["teachersNextPageToken"] = teachersResp.NextPageToken
 		}
-		return outfmt.WriteJSON(os.Stdout, payload)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 
 	w, flush := tableWriter(ctx)
@@ -7,6 +7,9 @@ import (
 	"sync"
 
 	"github.com/alecthomas/kong"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/secrets"
 )
 
 type completionFlag struct {
@@ -40,6 +43,9 @@ func completeWords(cword int, words []string) ([]string, error) {
 	}
 
 	start := completionStartIndex(words)
+	if values, ok := completeAccountValue(cword, words, start); ok {
+		return values, nil
+	}
 
 	node, terminatorIndex, needsValue := advanceCompletionNode(root, words, start, cword)
 	if needsValue {
@@ -264,3 +270,55 @@ func matchingFlags(node *completionNode, prefix string) []string {
 	}
 	return results
 }
+
+// completeAccountValue suggests stored account emails and aliases when the
+// word being completed is the value of --account (either "--account <v>" or
+// "--account=<v>").
+func completeAccountValue(cword int, words []string, start int) ([]string, bool) {
+	current := ""
+	if cword < len(words) {
+		current = words[cword]
+	}
+	prefix := ""
+	switch {
+	case strings.HasPrefix(current, "--account="):
+		prefix = "--account="
+		current = strings.TrimPrefix(current, prefix)
+	case cword > start && cword-1 < len(words) && words[cword-1] == "--account":
+	default:
+		return nil, false
+	}
+
+	results := make([]string, 0)
+	for _, candidate := range completionAccountCandidates() {
+		if strings.HasPrefix(candidate, current) {
+			results = append(results, prefix+candidate)
+		}
+	}
+	sort.Strings(results)
+	return results, true
+}
+
+// completionAccountCandidates never fails: a locked keyring or unreadable
+// config simply yields fewer suggestions. Only key names are read so the file
+// keyring never prompts for its password mid-completion.
+func completionAccountCandidates() []string {
+	var out []string
+	seen := map[string]bool{}
+	if store, err := openSecretsStore(); err == nil {
+		if keys, keysErr := store.Keys(); keysErr == nil {
+			for _, k := range keys {
+				if _, email, ok := secrets.ParseTokenKey(k); ok && !seen[email] {
+					seen[email] = true
+					out = append(out, email)
+				}
+			}
+		}
+	}
+	if aliases, err := config.ListAccountAliases(); err == nil {
+		for alias := range aliases {
+			out = append(out, alias)
+		}
+	}
+	return out
+}
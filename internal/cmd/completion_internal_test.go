@@ -1,6 +1,13 @@
 package cmd
 
-import "testing"
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/secrets"
+)
 
 func TestCompleteWordsStopsAfterTerminator(t *testing.T) {
 	cases := []struct {
@@ -33,3 +40,46 @@ func TestCompleteWordsStopsAfterTerminator(t *testing.T) {
 		})
 	}
 }
+
+func TestCompleteWordsAccountValues(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	if err := config.WriteConfig(config.File{
+		AccountAliases: map[string]string{"work": "work@example.com"},
+	}); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	origOpen := openSecretsStore
+	t.Cleanup(func() { openSecretsStore = origOpen })
+	store := newMemStore()
+	for _, email := range []string{"alice@example.com", "bob@example.com"} {
+		if err := store.SetToken(config.DefaultClientName, email, secrets.Token{Email: email, RefreshToken: "rt"}); err != nil {
+			t.Fatalf("SetToken: %v", err)
+		}
+	}
+	openSecretsStore = func() (secrets.Store, error) { return store, nil }
+
+	cases := []struct {
+		name  string
+		cword int
+		words []string
+		want  []string
+	}{
+		{"separate-value", 3, []string{"gog", "gmail", "--account", "a"}, []string{"alice@example.com"}},
+		{"empty-value", 2, []string{"gog", "--account"}, []string{"alice@example.com", "bob@example.com", "work"}},
+		{"inline-value", 1, []string{"gog", "--account=w"}, []string{"--account=work"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := completeWords(tc.cword, tc.words)
+			if err != nil {
+				t.Fatalf("completeWords: %v", err)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/steipete/gogcli/internal/config"
 	"github.com/steipete/gogcli/internal/outfmt"
 )
 
 type ConfigCmd struct {
-	Get   ConfigGetCmd   `cmd:"" help:"Get a config value"`
-	Keys  ConfigKeysCmd  `cmd:"" help:"List available config keys"`
-	Set   ConfigSetCmd   `cmd:"" help:"Set a config value"`
-	Unset ConfigUnsetCmd `cmd:"" help:"Unset a config value"`
-	List  ConfigListCmd  `cmd:"" help:"List all config values"`
-	Path  ConfigPathCmd  `cmd:"" help:"Print config file path"`
+	Get      ConfigGetCmd      `cmd:"" help:"Get a config value"`
+	Keys     ConfigKeysCmd     `cmd:"" help:"List available config keys"`
+	Set      ConfigSetCmd      `cmd:"" help:"Set a config value"`
+	Unset    ConfigUnsetCmd    `cmd:"" help:"Unset a config value"`
+	List     ConfigListCmd     `cmd:"" help:"List all config values"`
+	Path     ConfigPathCmd     `cmd:"" help:"Print config file path"`
+	Profiles ConfigProfilesCmd `cmd:"" help:"List config profiles (select with --profile or GOG_PROFILE)"`
 }
 
 type ConfigGetCmd struct {
@@ -39,7 +41,7 @@ func (c *ConfigGetCmd) Run(ctx context.Context) error {
 	value := config.GetValue(cfg, key)
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, outfmt.KeyValuePayload(key.String(), value))
+		return outfmt.WriteJSONContext(ctx, os.Stdout, outfmt.KeyValuePayload(key.String(), value))
 	}
 	fmt.Fprintln(os.Stdout, formatConfigValue(value, spec.EmptyHint))
 	return nil
@@ -50,7 +52,7 @@ type ConfigKeysCmd struct{}
 func (c *ConfigKeysCmd) Run(ctx context.Context) error {
 	keys := config.KeyNames()
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, outfmt.KeysPayload(keys))
+		return outfmt.WriteJSONContext(ctx, os.Stdout, outfmt.KeysPayload(keys))
 	}
 	for _, key := range keys {
 		fmt.Fprintln(os.Stdout, key)
@@ -85,7 +87,7 @@ func (c *ConfigSetCmd) Run(ctx context.Context) error {
 	if outfmt.IsJSON(ctx) {
 		payload := outfmt.KeyValuePayload(key.String(), c.Value)
 		payload["saved"] = true
-		return outfmt.WriteJSON(os.Stdout, payload)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 	fmt.Fprintf(os.Stdout, "Set %s = %s\n", c.Key, c.Value)
 	return nil
@@ -117,7 +119,7 @@ func (c *ConfigUnsetCmd) Run(ctx context.Context) error {
 	if outfmt.IsJSON(ctx) {
 		payload := outfmt.KeyValuePayload(key.String(), "")
 		payload["removed"] = true
-		return outfmt.WriteJSON(os.Stdout, payload)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 	fmt.Fprintf(os.Stdout, "Unset %s\n", c.Key)
 	return nil
@@ -139,7 +141,7 @@ func (c *ConfigListCmd) Run(ctx context.Context) error {
 		for _, key := range keys {
 			payload[key.String()] = config.GetValue(cfg, key)
 		}
-		return outfmt.WriteJSON(os.Stdout, payload)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 
 	fmt.Fprintf(os.Stdout, "Config file: %s\n", path)
@@ -159,12 +161,62 @@ func (c *ConfigPathCmd) Run(ctx context.Context) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, outfmt.PathPayload(path))
+		return outfmt.WriteJSONContext(ctx, os.Stdout, outfmt.PathPayload(path))
 	}
 	fmt.Fprintln(os.Stdout, path)
 	return nil
 }
 
+type ConfigProfilesCmd struct{}
+
+type configProfileItem struct {
+	Name           string `json:"name"`
+	Account        string `json:"account,omitempty"`
+	Client         string `json:"client,omitempty"`
+	KeyringBackend string `json:"keyring_backend,omitempty"`
+	Active         bool   `json:"active"`
+}
+
+func (c *ConfigProfilesCmd) Run(ctx context.Context, flags *RootFlags) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	active := strings.TrimSpace(flags.Profile)
+	items := make([]configProfileItem, 0, len(cfg.Profiles))
+	for _, name := range config.ProfileNames(cfg) {
+		p := cfg.Profiles[name]
+		items = append(items, configProfileItem{
+			Name:           name,
+			Account:        p.Account,
+			Client:         p.Client,
+			KeyringBackend: p.KeyringBackend,
+			Active:         name == active,
+		})
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"profiles": items})
+	}
+	if len(items) == 0 {
+		fmt.Fprintln(os.Stderr, "No profiles (add a \"profiles\" section to the config file)")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "NAME\tACCOUNT\tCLIENT\tKEYRING\tACTIVE")
+	for _, it := range items {
+		activeMark := ""
+		if it.Active {
+			activeMark = "*"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", it.Name, it.Account, it.Client, it.KeyringBackend, activeMark)
+	}
+	return nil
+}
+
 func formatConfigValue(value string, emptyHint func() string) string {
 	if value != "" {
 		return value
@@ -23,6 +23,8 @@ type ContactsListCmd struct {
 	Page string `name:"page" help:"Page token"`
 }
 
+func (*ContactsListCmd) allAccounts() {}
+
 func (c *ContactsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -62,7 +64,7 @@ func (c *ContactsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 				Phone:    primaryPhone(p),
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"contacts":      items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -113,7 +115,7 @@ func (c *ContactsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	var p *people.Person
 	if strings.HasPrefix(identifier, "people/") {
-		p, err = svc.People.Get(identifier).PersonFields(contactsGetReadMask).Do()
+		p, err = svc.People.Get(identifier).PersonFields(contactsGetReadMask).Context(ctx).Do()
 		if err != nil {
 			return err
 		}
@@ -140,7 +142,7 @@ func (c *ContactsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		}
 		if p == nil {
 			if outfmt.IsJSON(ctx) {
-				return outfmt.WriteJSON(os.Stdout, map[string]any{"found": false})
+				return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"found": false})
 			}
 			u.Err().Println("Not found")
 			return nil
@@ -148,7 +150,7 @@ func (c *ContactsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"contact": p})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"contact": p})
 	}
 
 	u.Out().Printf("resource\t%s", p.ResourceName)
@@ -200,12 +202,12 @@ func (c *ContactsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		p.PhoneNumbers = []*people.PhoneNumber{{Value: strings.TrimSpace(c.Phone)}}
 	}
 
-	created, err := svc.People.CreateContact(p).Do()
+	created, err := svc.People.CreateContact(p).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"contact": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"contact": created})
 	}
 	u.Out().Printf("resource\t%s", created.ResourceName)
 	return nil
@@ -235,7 +237,7 @@ func (c *ContactsUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *
 		return err
 	}
 
-	existing, err := svc.People.Get(resourceName).PersonFields(contactsReadMask).Do()
+	existing, err := svc.People.Get(resourceName).PersonFields(contactsReadMask).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -287,7 +289,7 @@ func (c *ContactsUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"contact": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"contact": updated})
 	}
 	u.Out().Printf("resource\t%s", updated.ResourceName)
 	return nil
@@ -316,7 +318,7 @@ func (c *ContactsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	if _, err := svc.People.DeleteContact(resourceName).Do(); err != nil {
+	if _, err := svc.People.DeleteContact(resourceName).Context(ctx).Do(); err != nil {
 		return err
 	}
 	return writeDeleteResult(ctx, u, resourceName)
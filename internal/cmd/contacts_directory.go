@@ -70,7 +70,7 @@ func (c *ContactsDirectoryListCmd) Run(ctx context.Context, flags *RootFlags) er
 				Email:    primaryEmail(p),
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"people":        items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -148,7 +148,7 @@ func (c *ContactsDirectorySearchCmd) Run(ctx context.Context, flags *RootFlags)
 				Email:    primaryEmail(p),
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"people":        items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -226,7 +226,7 @@ func (c *ContactsOtherListCmd) Run(ctx context.Context, flags *RootFlags) error
 				Phone:    primaryPhone(p),
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"contacts":      items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -301,7 +301,7 @@ func (c *ContactsOtherSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 				Phone:    primaryPhone(p),
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"contacts": items})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"contacts": items})
 	}
 
 	if len(resp.Results) == 0 {
@@ -360,7 +360,7 @@ func deleteOtherContact(ctx context.Context, account, resourceName string) error
 	copied, err := otherSvc.OtherContacts.CopyOtherContactToMyContactsGroup(
 		resourceName,
 		&people.CopyOtherContactToMyContactsGroupRequest{},
-	).Do()
+	).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("copy to my contacts: %w", err)
 	}
@@ -376,7 +376,7 @@ func deleteOtherContact(ctx context.Context, account, resourceName string) error
 	if err != nil {
 		return err
 	}
-	if _, err := contactsSvc.People.DeleteContact(copiedResource).Do(); err != nil {
+	if _, err := contactsSvc.People.DeleteContact(copiedResource).Context(ctx).Do(); err != nil {
 		return fmt.Errorf("delete copied contact %s: %w", copiedResource, err)
 	}
 	return nil
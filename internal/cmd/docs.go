@@ -22,11 +22,20 @@ import (
 var newDocsService = googleapi.NewDocs
 
 type DocsCmd struct {
-	Export DocsExportCmd `cmd:"" name:"export" help:"Export a Google Doc (pdf|docx|txt)"`
-	Info   DocsInfoCmd   `cmd:"" name:"info" help:"Get Google Doc metadata"`
-	Create DocsCreateCmd `cmd:"" name:"create" help:"Create a Google Doc"`
-	Copy   DocsCopyCmd   `cmd:"" name:"copy" help:"Copy a Google Doc"`
-	Cat    DocsCatCmd    `cmd:"" name:"cat" help:"Print a Google Doc as plain text"`
+	Export       DocsExportCmd             `cmd:"" name:"export" help:"Export a Google Doc (pdf|docx|txt)"`
+	Info         DocsInfoCmd               `cmd:"" name:"info" help:"Get Google Doc metadata"`
+	Create       DocsCreateCmd             `cmd:"" name:"create" help:"Create a Google Doc"`
+	Import       DocsImportCmd             `cmd:"" name:"import" help:"Create a Google Doc from a Markdown file"`
+	FromTemplate DocsCreateFromTemplateCmd `cmd:"" name:"from-template" help:"Copy a template doc and fill {{key}} placeholders"`
+	Copy         DocsCopyCmd               `cmd:"" name:"copy" help:"Copy a Google Doc"`
+	Cat          DocsCatCmd                `cmd:"" name:"cat" help:"Print a Google Doc as plain text"`
+	Suggestions  DocsSuggestionsCmd        `cmd:"" name:"suggestions" help:"List pending suggested edits in a Google Doc"`
+	WordCount    DocsWordCountCmd          `cmd:"" name:"word-count" aliases:"wc" help:"Count words, characters and paragraphs in a Google Doc"`
+	Batch        DocsBatchCmd              `cmd:"" name:"batch" help:"Apply a raw Docs API batchUpdate from a JSON request file"`
+	PageBreak    DocsPageBreakCmd          `cmd:"" name:"page-break" help:"Insert a page break at an index"`
+	SectionBreak DocsSectionBreakCmd       `cmd:"" name:"section-break" help:"Insert a section break (continuous or next page) at an index"`
+	InsertImage  DocsInsertImageCmd        `cmd:"" name:"insert-image" help:"Insert an inline image at an index"`
+	ReplaceImage DocsReplaceImageCmd       `cmd:"" name:"replace-image" help:"Replace an inline image with a new URL or local file"`
 }
 
 type DocsExportCmd struct {
@@ -89,7 +98,7 @@ func (c *DocsInfoCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			strFile:    file,
 			"document": doc,
 		})
@@ -129,12 +138,32 @@ func (c *DocsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
+	created, err := createGoogleDoc(ctx, svc, title, c.Parent)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{strFile: created})
+	}
+
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("name\t%s", created.Name)
+	u.Out().Printf("mime\t%s", created.MimeType)
+	if created.WebViewLink != "" {
+		u.Out().Printf("link\t%s", created.WebViewLink)
+	}
+	return nil
+}
+
+// createGoogleDoc creates an empty Google Doc through Drive so it can be
+// placed in a folder (the Docs API create call has no parent).
+func createGoogleDoc(ctx context.Context, svc *drive.Service, title, parent string) (*drive.File, error) {
 	f := &drive.File{
 		Name:     title,
 		MimeType: "application/vnd.google-apps.document",
 	}
-	parent := strings.TrimSpace(c.Parent)
-	if parent != "" {
+	if parent = strings.TrimSpace(parent); parent != "" {
 		f.Parents = []string{parent}
 	}
 
@@ -144,23 +173,12 @@ func (c *DocsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		Context(ctx).
 		Do()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if created == nil {
-		return errors.New("create failed")
-	}
-
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: created})
-	}
-
-	u.Out().Printf("id\t%s", created.Id)
-	u.Out().Printf("name\t%s", created.Name)
-	u.Out().Printf("mime\t%s", created.MimeType)
-	if created.WebViewLink != "" {
-		u.Out().Printf("link\t%s", created.WebViewLink)
+		return nil, errors.New("create failed")
 	}
-	return nil
+	return created, nil
 }
 
 type DocsCopyCmd struct {
@@ -178,8 +196,9 @@ func (c *DocsCopyCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type DocsCatCmd struct {
-	DocID    string `arg:"" name:"docId" help:"Doc ID"`
-	MaxBytes int64  `name:"max-bytes" help:"Max bytes to read (0 = unlimited)" default:"2000000"`
+	DocID           string `arg:"" name:"docId" help:"Doc ID"`
+	MaxBytes        int64  `name:"max-bytes" help:"Max bytes to read (0 = unlimited)" default:"2000000"`
+	SuggestionsMode string `name:"suggestions-mode" help:"How to show pending suggestions: inline (suggested and deleted text both shown), accepted, original (default: API default)"`
 }
 
 func (c *DocsCatCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -193,14 +212,21 @@ func (c *DocsCatCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty docId")
 	}
 
+	viewMode, err := docsSuggestionsViewMode(c.SuggestionsMode)
+	if err != nil {
+		return err
+	}
+
 	svc, err := newDocsService(ctx, account)
 	if err != nil {
 		return err
 	}
 
-	doc, err := svc.Documents.Get(id).
-		Context(ctx).
-		Do()
+	call := svc.Documents.Get(id)
+	if viewMode != "" {
+		call = call.SuggestionsViewMode(viewMode)
+	}
+	doc, err := call.Context(ctx).Do()
 	if err != nil {
 		if isDocsNotFound(err) {
 			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", id)
@@ -214,7 +240,7 @@ func (c *DocsCatCmd) Run(ctx context.Context, flags *RootFlags) error {
 	text := docsPlainText(doc, c.MaxBytes)
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"text": text})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"text": text})
 	}
 	_, err = io.WriteString(os.Stdout, text)
 	return err
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsBatchCmd struct {
+	DocID        string `arg:"" name:"docId" help:"Doc ID"`
+	RequestsFile string `name:"requests-file" help:"JSON array of Docs API requests ('-' for stdin)" required:""`
+}
+
+func (c *DocsBatchCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+
+	b, err := readInputFile(c.RequestsFile)
+	if err != nil {
+		return err
+	}
+	requests, err := parseDocsRequests(b)
+	if err != nil {
+		return err
+	}
+
+	if stop, dryErr := dryRunExit(ctx, flags, "docs.batch", map[string]any{
+		"documentId": id,
+		"requests":   len(requests),
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	resp, err := svc.Documents.BatchUpdate(id, &docs.BatchUpdateDocumentRequest{Requests: requests}).
+		Context(ctx).
+		Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", id)
+		}
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"documentId": resp.DocumentId,
+			"replies":    resp.Replies,
+		})
+	}
+	u.Out().Printf("id\t%s", resp.DocumentId)
+	u.Out().Printf("replies\t%d", len(resp.Replies))
+	return nil
+}
+
+// parseDocsRequests decodes a JSON array of docs.Request. Unknown fields are
+// rejected so typos in hand-written payloads fail before reaching the API.
+func parseDocsRequests(b []byte) ([]*docs.Request, error) {
+	if len(bytes.TrimSpace(b)) == 0 {
+		return nil, usage("empty --requests-file")
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	var requests []*docs.Request
+	if err := dec.Decode(&requests); err != nil {
+		return nil, usagef("invalid --requests-file (expected a JSON array of Docs API requests): %v", err)
+	}
+	if len(requests) == 0 {
+		return nil, usage("--requests-file contains no requests")
+	}
+	for i, req := range requests {
+		if req == nil {
+			return nil, usagef("request %d is null", i)
+		}
+	}
+	return requests, nil
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDocsBatchCmd_SubmitsRequests(t *testing.T) {
+	origDocs := newDocsService
+	t.Cleanup(func() { newDocsService = origDocs })
+
+	var got docs.BatchUpdateDocumentRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/documents/doc1:batchUpdate" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"documentId": "doc1",
+			"replies":    []any{map[string]any{}, map[string]any{}},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := docs.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return svc, nil }
+
+	path := filepath.Join(t.TempDir(), "requests.json")
+	payload := `[
+		{"insertText": {"location": {"index": 1}, "text": "Hello"}},
+		{"updateTextStyle": {"range": {"startIndex": 1, "endIndex": 6}, "textStyle": {"bold": true}, "fields": "bold"}}
+	]`
+	if err := os.WriteFile(path, []byte(payload), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		u, uiErr := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+		if uiErr != nil {
+			t.Fatalf("ui.New: %v", uiErr)
+		}
+		ctx := ui.WithUI(context.Background(), u)
+		if err := runKong(t, &DocsBatchCmd{}, []string{"doc1", "--requests-file", path}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("batch: %v", err)
+		}
+	})
+
+	if len(got.Requests) != 2 || got.Requests[0].InsertText == nil || got.Requests[0].InsertText.Text != "Hello" {
+		t.Fatalf("unexpected requests: %#v", got.Requests)
+	}
+	if !strings.Contains(out, "replies\t2") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestParseDocsRequests_Validation(t *testing.T) {
+	cases := map[string]string{
+		"empty":      "  ",
+		"object":     `{"requests": []}`,
+		"unknown":    `[{"insertTxt": {}}]`,
+		"none":       `[]`,
+		"null entry": `[null]`,
+	}
+	for name, in := range cases {
+		if _, err := parseDocsRequests([]byte(in)); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+	reqs, err := parseDocsRequests([]byte(`[{"deleteContentRange": {"range": {"startIndex": 1, "endIndex": 3}}}]`))
+	if err != nil || len(reqs) != 1 || reqs[0].DeleteContentRange == nil {
+		t.Fatalf("unexpected result: %#v %v", reqs, err)
+	}
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// uploadedImage is a temporary Drive copy of a local image. It is readable by
+// anyone with the link until cleanupUploadedImages revokes the share and
+// deletes it.
+type uploadedImage struct {
+	FileID       string
+	PermissionID string
+	URL          string
+}
+
+// uploadLocalImage uploads a local image to Drive and shares it by link so the
+// Docs/Slides APIs can fetch it during insertion. Callers must pass the result
+// to cleanupUploadedImages as soon as the batch update is done. Images larger
+// than one chunk go out as resumable uploads, like drive upload.
+func uploadLocalImage(ctx context.Context, svc *drive.Service, path, chunkSize string) (*uploadedImage, error) {
+	path, err := config.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	mimeType := guessMimeType(path)
+	if !strings.HasPrefix(mimeType, "image/") {
+		return nil, usagef("unsupported image type: %s (expected .png, .jpg or .gif)", filepath.Ext(path))
+	}
+	mediaOpts, chunk, err := uploadChunkOptions(chunkSize, mimeType)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path) //nolint:gosec // user-provided path
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	name := filepath.Base(path)
+	call := svc.Files.Create(&drive.File{Name: name, MimeType: mimeType}).
+		Media(f, mediaOpts...).
+		Fields("id").
+		Context(ctx)
+	if st, statErr := f.Stat(); statErr == nil && st.Size() > int64(chunk) {
+		call = call.ProgressUpdater(uploadProgress(ui.FromContext(ctx), name, st.Size()))
+	}
+	created, err := call.Do()
+	if err != nil {
+		return nil, fmt.Errorf("upload image: %w", err)
+	}
+
+	img := &uploadedImage{FileID: created.Id, URL: "https://drive.google.com/uc?export=download&id=" + created.Id}
+	perm, err := svc.Permissions.Create(created.Id, &drive.Permission{Type: "anyone", Role: "reader"}).
+		Fields("id").
+		Context(ctx).
+		Do()
+	if err != nil {
+		cleanupUploadedImages(ctx, svc, []*uploadedImage{img})
+		return nil, fmt.Errorf("share uploaded image: %w", err)
+	}
+	img.PermissionID = perm.Id
+	return img, nil
+}
+
+// cleanupUploadedImages revokes the link share of temporary images and then
+// deletes them, warning on stderr instead of failing the command that created
+// them. The share goes first so a failed delete never leaves a public file.
+func cleanupUploadedImages(ctx context.Context, svc *drive.Service, imgs []*uploadedImage) {
+	u := ui.FromContext(ctx)
+	for _, img := range imgs {
+		if img == nil || strings.TrimSpace(img.FileID) == "" {
+			continue
+		}
+		if img.PermissionID != "" {
+			if err := svc.Permissions.Delete(img.FileID, img.PermissionID).SupportsAllDrives(true).Context(ctx).Do(); err != nil && u != nil {
+				u.Err().Printf("Warning: failed to unshare temporary Drive file %s: %v", img.FileID, err)
+			}
+		}
+		if err := svc.Files.Delete(img.FileID).SupportsAllDrives(true).Context(ctx).Do(); err != nil && u != nil {
+			u.Err().Printf("Warning: failed to delete temporary Drive file %s: %v", img.FileID, err)
+		}
+	}
+}
@@ -0,0 +1,486 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const docsCodeFontFamily = "Courier New"
+
+type DocsImportCmd struct {
+	File   string `arg:"" name:"file" help:"Markdown file to import ('-' for stdin)"`
+	Title  string `name:"title" help:"Doc title (default: file name without extension)"`
+	Parent string `name:"parent" help:"Destination folder ID"`
+}
+
+func (c *DocsImportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	file := strings.TrimSpace(c.File)
+	title := strings.TrimSpace(c.Title)
+	if title == "" && file != "-" {
+		base := filepath.Base(file)
+		title = strings.TrimSpace(strings.TrimSuffix(base, filepath.Ext(base)))
+	}
+	if title == "" {
+		return usage("--title is required when importing from stdin")
+	}
+
+	b, err := readInputFile(file)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(string(b)) == "" {
+		return usage("empty markdown input")
+	}
+	requests := MarkdownToDocsRequests(ParseMarkdown(string(b)), 1)
+
+	if stop, dryErr := dryRunExit(ctx, flags, "docs.import", map[string]any{
+		"title":    title,
+		"parent":   strings.TrimSpace(c.Parent),
+		"requests": len(requests),
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	driveSvc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+	created, err := createGoogleDoc(ctx, driveSvc, title, c.Parent)
+	if err != nil {
+		return err
+	}
+
+	docsSvc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+	if err := docsBatchUpdate(ctx, docsSvc, created.Id, requests...); err != nil {
+		return fmt.Errorf("created doc %s but import failed: %w", created.Id, err)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			strFile:    created,
+			"requests": len(requests),
+		})
+	}
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("name\t%s", created.Name)
+	if created.WebViewLink != "" {
+		u.Out().Printf("link\t%s", created.WebViewLink)
+	}
+	u.Out().Printf("requests\t%d", len(requests))
+	return nil
+}
+
+type mdBlockKind int
+
+const (
+	mdParagraph mdBlockKind = iota
+	mdHeading
+	mdCodeBlock
+	mdListItem
+)
+
+type mdStyle int
+
+const (
+	mdBold mdStyle = iota
+	mdItalic
+	mdInlineCode
+)
+
+// mdSpan marks inline formatting as byte offsets into mdBlock.Text.
+type mdSpan struct {
+	Start, End int
+	Style      mdStyle
+}
+
+// mdBlock is one Docs paragraph (or, for code blocks, a run of paragraphs)
+// with the Markdown syntax already stripped from Text.
+type mdBlock struct {
+	Kind    mdBlockKind
+	Level   int // heading level 1-6
+	Depth   int // list nesting level, 0 for top-level items
+	Ordered bool
+	Text    string
+	Spans   []mdSpan
+}
+
+// maxDocsListDepth is the deepest nesting level Docs bullets support.
+const maxDocsListDepth = 8
+
+// ParseMarkdown splits Markdown into blocks: ATX headings, paragraphs, list
+// items, and fenced or indented code blocks. Inline **bold**, *italic* and
+// `code` become spans; everything else is kept as literal text.
+func ParseMarkdown(src string) []mdBlock {
+	lines := strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n")
+
+	var blocks []mdBlock
+	var para []string
+	// listIndents holds the marker indentation of each open nesting level.
+	var listIndents []int
+	flush := func() {
+		if len(para) > 0 {
+			blocks = append(blocks, newMarkdownBlock(mdParagraph, 0, strings.Join(para, " ")))
+			para = nil
+		}
+	}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		if fence := markdownFence(trimmed); fence != "" {
+			flush()
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence); i++ {
+				code = append(code, lines[i])
+			}
+			blocks = append(blocks, mdBlock{Kind: mdCodeBlock, Text: strings.Join(code, "\n")})
+			continue
+		}
+
+		// Indented code only starts a block on its own; inside a paragraph or
+		// right after a list item the indentation is a continuation.
+		if len(para) == 0 && trimmed != "" && markdownIndented(line) && !lastBlockIsList(blocks) {
+			var code []string
+			for ; i < len(lines) && (markdownIndented(lines[i]) || strings.TrimSpace(lines[i]) == ""); i++ {
+				code = append(code, markdownUnindent(lines[i]))
+			}
+			i--
+			for len(code) > 0 && strings.TrimSpace(code[len(code)-1]) == "" {
+				code = code[:len(code)-1]
+			}
+			blocks = append(blocks, mdBlock{Kind: mdCodeBlock, Text: strings.Join(code, "\n")})
+			continue
+		}
+
+		if trimmed == "" {
+			flush()
+			continue
+		}
+		if level, text, ok := markdownHeading(trimmed); ok {
+			flush()
+			blocks = append(blocks, newMarkdownBlock(mdHeading, level, text))
+			continue
+		}
+		if indent, ordered, text, ok := markdownListItem(line); ok {
+			flush()
+			if !lastBlockIsList(blocks) {
+				listIndents = nil
+			}
+			for len(listIndents) > 0 && listIndents[len(listIndents)-1] > indent {
+				listIndents = listIndents[:len(listIndents)-1]
+			}
+			if len(listIndents) == 0 || listIndents[len(listIndents)-1] < indent {
+				listIndents = append(listIndents, indent)
+			}
+			block := newMarkdownBlock(mdListItem, 0, text)
+			block.Depth = min(len(listIndents)-1, maxDocsListDepth)
+			block.Ordered = ordered
+			blocks = append(blocks, block)
+			continue
+		}
+		para = append(para, trimmed)
+	}
+	flush()
+	return blocks
+}
+
+// MarkdownToDocsRequests inserts all blocks as one text run at index and then
+// styles it. Every range refers to the document after the insert, so the
+// requests must be applied in order within a single batchUpdate.
+//
+// List items are inserted with one leading tab per nesting level; Docs turns
+// those into the nesting level and strips them when the bullets are created.
+// That shifts later indexes, so bullets come last and in reverse order.
+func MarkdownToDocsRequests(blocks []mdBlock, index int64) []*docs.Request {
+	if len(blocks) == 0 {
+		return nil
+	}
+
+	var text strings.Builder
+	var styles, bullets []*docs.Request
+	listStart, listEnd, listOrdered := int64(-1), int64(0), false
+	closeList := func() {
+		if listStart >= 0 {
+			preset := "BULLET_DISC_CIRCLE_SQUARE"
+			if listOrdered {
+				preset = "NUMBERED_DECIMAL_ALPHA_ROMAN"
+			}
+			bullets = append([]*docs.Request{{CreateParagraphBullets: &docs.CreateParagraphBulletsRequest{
+				Range:        &docs.Range{StartIndex: listStart, EndIndex: listEnd},
+				BulletPreset: preset,
+			}}}, bullets...)
+			listStart = -1
+		}
+	}
+
+	pos := index
+	for _, block := range blocks {
+		if block.Kind == mdListItem {
+			// A Docs list has one preset for all levels, so a top-level item of
+			// the other kind starts a new list; nested items follow their list.
+			if listStart >= 0 && block.Depth == 0 && block.Ordered != listOrdered {
+				closeList()
+			}
+			if listStart < 0 {
+				listStart, listOrdered = pos, block.Ordered
+			}
+			tabs := strings.Repeat("\t", block.Depth)
+			text.WriteString(tabs)
+			pos += int64(len(tabs))
+		} else {
+			closeList()
+		}
+
+		start := pos
+		end := start + utf16Len(block.Text)
+		text.WriteString(block.Text)
+		text.WriteByte('\n')
+		pos = end + 1
+		if block.Kind == mdListItem {
+			listEnd = end + 1
+		}
+
+		switch block.Kind {
+		case mdHeading:
+			styles = append(styles, &docs.Request{UpdateParagraphStyle: &docs.UpdateParagraphStyleRequest{
+				Range:          &docs.Range{StartIndex: start, EndIndex: end + 1},
+				ParagraphStyle: &docs.ParagraphStyle{NamedStyleType: fmt.Sprintf("HEADING_%d", block.Level)},
+				Fields:         "namedStyleType",
+			}})
+		case mdCodeBlock:
+			if end > start {
+				styles = append(styles, docsTextStyleRequest(start, end, mdInlineCode))
+			}
+			styles = append(styles, &docs.Request{UpdateParagraphStyle: &docs.UpdateParagraphStyleRequest{
+				Range: &docs.Range{StartIndex: start, EndIndex: end + 1},
+				ParagraphStyle: &docs.ParagraphStyle{Shading: &docs.Shading{BackgroundColor: &docs.OptionalColor{
+					Color: &docs.Color{RgbColor: &docs.RgbColor{Red: 0.95, Green: 0.95, Blue: 0.95}},
+				}}},
+				Fields: "shading.backgroundColor",
+			}})
+		}
+		for _, span := range block.Spans {
+			styles = append(styles, docsTextStyleRequest(
+				start+utf16Len(block.Text[:span.Start]),
+				start+utf16Len(block.Text[:span.End]),
+				span.Style,
+			))
+		}
+	}
+	closeList()
+
+	requests := []*docs.Request{{InsertText: &docs.InsertTextRequest{
+		Location: &docs.Location{Index: index},
+		Text:     text.String(),
+	}}}
+	requests = append(requests, styles...)
+	return append(requests, bullets...)
+}
+
+func docsTextStyleRequest(start, end int64, style mdStyle) *docs.Request {
+	req := &docs.UpdateTextStyleRequest{Range: &docs.Range{StartIndex: start, EndIndex: end}}
+	switch style {
+	case mdBold:
+		req.TextStyle, req.Fields = &docs.TextStyle{Bold: true}, "bold"
+	case mdItalic:
+		req.TextStyle, req.Fields = &docs.TextStyle{Italic: true}, "italic"
+	case mdInlineCode:
+		req.TextStyle = &docs.TextStyle{WeightedFontFamily: &docs.WeightedFontFamily{FontFamily: docsCodeFontFamily}}
+		req.Fields = "weightedFontFamily"
+	}
+	return &docs.Request{UpdateTextStyle: req}
+}
+
+func newMarkdownBlock(kind mdBlockKind, level int, raw string) mdBlock {
+	text, spans := parseMarkdownInline(raw)
+	return mdBlock{Kind: kind, Level: level, Text: text, Spans: spans}
+}
+
+const markdownEscapable = "\\`*_{}[]()#+-.!|~>"
+
+func parseMarkdownInline(s string) (string, []mdSpan) {
+	var b strings.Builder
+	var spans []mdSpan
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte(markdownEscapable, s[i+1]) >= 0:
+			b.WriteByte(s[i+1])
+			i += 2
+			continue
+		case c == '`':
+			if n := strings.IndexByte(s[i+1:], '`'); n > 0 {
+				start := b.Len()
+				b.WriteString(s[i+1 : i+1+n])
+				spans = append(spans, mdSpan{Start: start, End: b.Len(), Style: mdInlineCode})
+				i += n + 2
+				continue
+			}
+		case c == '*' || c == '_':
+			if c == '_' && i > 0 && isMarkdownWordByte(s[i-1]) {
+				break // snake_case, not emphasis
+			}
+			marker, style := s[i:i+1], mdItalic
+			if i+1 < len(s) && s[i+1] == c {
+				marker, style = s[i:i+2], mdBold
+			}
+			rest := s[i+len(marker):]
+			if n := markdownClosing(rest, marker); n > 0 {
+				inner, innerSpans := parseMarkdownInline(rest[:n])
+				start := b.Len()
+				b.WriteString(inner)
+				for _, sp := range innerSpans {
+					spans = append(spans, mdSpan{Start: sp.Start + start, End: sp.End + start, Style: sp.Style})
+				}
+				spans = append(spans, mdSpan{Start: start, End: b.Len(), Style: style})
+				i += 2*len(marker) + n
+				continue
+			}
+		}
+		b.WriteByte(c)
+		i++
+	}
+	return b.String(), spans
+}
+
+// markdownClosing returns the offset of the delimiter closing marker in s, or
+// -1. Emphasis must hug its content, and a single marker skips doubled ones so
+// "*a **b** c*" closes at the last star.
+func markdownClosing(s, marker string) int {
+	if s == "" || s[0] == ' ' {
+		return -1
+	}
+	c := marker[0]
+	for j := 0; j < len(s); j++ {
+		if s[j] == '`' {
+			if n := strings.IndexByte(s[j+1:], '`'); n >= 0 {
+				j += n + 1
+			}
+			continue
+		}
+		if s[j] != c {
+			continue
+		}
+		doubled := j+1 < len(s) && s[j+1] == c
+		if len(marker) == 1 && doubled {
+			j++
+			continue
+		}
+		if len(marker) == 2 && !doubled {
+			continue
+		}
+		if j == 0 || s[j-1] == ' ' {
+			continue
+		}
+		after := j + len(marker)
+		if c == '_' && after < len(s) && isMarkdownWordByte(s[after]) {
+			continue
+		}
+		return j
+	}
+	return -1
+}
+
+func isMarkdownWordByte(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// markdownFence returns the opening fence run (``` or ~~~, possibly longer)
+// of a fenced code block line, or "".
+func markdownFence(trimmed string) string {
+	for _, c := range []byte{'`', '~'} {
+		n := 0
+		for n < len(trimmed) && trimmed[n] == c {
+			n++
+		}
+		if n >= 3 {
+			return trimmed[:n]
+		}
+	}
+	return ""
+}
+
+func markdownIndented(line string) bool {
+	return strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "    ")
+}
+
+func markdownUnindent(line string) string {
+	if strings.HasPrefix(line, "\t") {
+		return line[1:]
+	}
+	return strings.TrimPrefix(line, "    ")
+}
+
+func markdownHeading(trimmed string) (int, string, bool) {
+	level := 0
+	for level < len(trimmed) && trimmed[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return 0, "", false
+	}
+	if level < len(trimmed) && trimmed[level] != ' ' && trimmed[level] != '\t' {
+		return 0, "", false
+	}
+	return level, strings.TrimSpace(trimmed[level:]), true
+}
+
+// markdownListItem recognises "- ", "* ", "+ " and "1. "/"1) " items and
+// returns the marker's indentation in columns (tabs count as four).
+func markdownListItem(line string) (int, bool, string, bool) {
+	s := strings.TrimLeft(line, " \t")
+	indent := 0
+	for _, c := range line[:len(line)-len(s)] {
+		if c == '\t' {
+			indent += 4
+		} else {
+			indent++
+		}
+	}
+	if len(s) >= 2 && strings.IndexByte("-*+", s[0]) >= 0 && (s[1] == ' ' || s[1] == '\t') {
+		return indent, false, strings.TrimSpace(s[2:]), true
+	}
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	if n > 0 && n <= 9 && n+1 < len(s) && (s[n] == '.' || s[n] == ')') && (s[n+1] == ' ' || s[n+1] == '\t') {
+		return indent, true, strings.TrimSpace(s[n+2:]), true
+	}
+	return 0, false, "", false
+}
+
+func lastBlockIsList(blocks []mdBlock) bool {
+	return len(blocks) > 0 && blocks[len(blocks)-1].Kind == mdListItem
+}
+
+// utf16Len measures s in UTF-16 code units, the unit of Docs API indexes.
+func utf16Len(s string) int64 {
+	var n int64
+	for _, r := range s {
+		if r >= 0x10000 {
+			n += 2
+		} else {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,278 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestParseMarkdown_Blocks(t *testing.T) {
+	src := strings.Join([]string{
+		"# Title",
+		"Some **bold** and *italic* with `x := 1`.",
+		"continued line",
+		"",
+		"```go",
+		"func main() {",
+		"",
+		"}",
+		"```",
+		"",
+		"    indented code",
+		"    second",
+		"",
+		"- one",
+		"- two",
+		"",
+		"snake_case_name stays \\*literal\\*",
+	}, "\n")
+
+	blocks := ParseMarkdown(src)
+	want := []struct {
+		kind mdBlockKind
+		text string
+	}{
+		{mdHeading, "Title"},
+		{mdParagraph, "Some bold and italic with x := 1. continued line"},
+		{mdCodeBlock, "func main() {\n\n}"},
+		{mdCodeBlock, "indented code\nsecond"},
+		{mdListItem, "one"},
+		{mdListItem, "two"},
+		{mdParagraph, "snake_case_name stays *literal*"},
+	}
+	if len(blocks) != len(want) {
+		t.Fatalf("got %d blocks: %#v", len(blocks), blocks)
+	}
+	for i, w := range want {
+		if blocks[i].Kind != w.kind || blocks[i].Text != w.text {
+			t.Fatalf("block %d = %#v, want kind %d text %q", i, blocks[i], w.kind, w.text)
+		}
+	}
+	if blocks[0].Level != 1 {
+		t.Fatalf("heading level = %d", blocks[0].Level)
+	}
+	wantSpans := []mdSpan{
+		{Start: 5, End: 9, Style: mdBold},
+		{Start: 14, End: 20, Style: mdItalic},
+		{Start: 26, End: 32, Style: mdInlineCode},
+	}
+	if !reflect.DeepEqual(blocks[1].Spans, wantSpans) {
+		t.Fatalf("spans = %#v", blocks[1].Spans)
+	}
+	if len(blocks[6].Spans) != 0 {
+		t.Fatalf("expected no spans for escaped/snake_case text, got %#v", blocks[6].Spans)
+	}
+}
+
+func TestMarkdownToDocsRequests_CodeStyles(t *testing.T) {
+	// "Run go test now\n" occupies 1..16; the fenced "a\nb" starts at 17.
+	reqs := MarkdownToDocsRequests(ParseMarkdown("Run `go test` now\n\n```\na\nb\n```\n"), 1)
+
+	if len(reqs) == 0 || reqs[0].InsertText == nil || reqs[0].InsertText.Text != "Run go test now\na\nb\n" {
+		t.Fatalf("unexpected insert: %#v", reqs)
+	}
+
+	var fonts []docs.Range
+	var shaded []docs.Range
+	for _, r := range reqs[1:] {
+		if ts := r.UpdateTextStyle; ts != nil && ts.TextStyle.WeightedFontFamily != nil {
+			if ts.TextStyle.WeightedFontFamily.FontFamily != "Courier New" || ts.Fields != "weightedFontFamily" {
+				t.Fatalf("unexpected code text style: %#v", ts)
+			}
+			fonts = append(fonts, *ts.Range)
+		}
+		if ps := r.UpdateParagraphStyle; ps != nil && ps.ParagraphStyle.Shading != nil {
+			if ps.Fields != "shading.backgroundColor" || ps.ParagraphStyle.Shading.BackgroundColor.Color.RgbColor == nil {
+				t.Fatalf("unexpected shading: %#v", ps)
+			}
+			shaded = append(shaded, *ps.Range)
+		}
+	}
+	wantFonts := []docs.Range{{StartIndex: 5, EndIndex: 12}, {StartIndex: 17, EndIndex: 20}}
+	if !reflect.DeepEqual(fonts, wantFonts) {
+		t.Fatalf("monospace ranges = %#v", fonts)
+	}
+	if !reflect.DeepEqual(shaded, []docs.Range{{StartIndex: 17, EndIndex: 21}}) {
+		t.Fatalf("shaded ranges = %#v", shaded)
+	}
+}
+
+func TestMarkdownToDocsRequests_HeadingsAndUTF16(t *testing.T) {
+	// The emoji is two UTF-16 code units, which shifts the bold range.
+	reqs := MarkdownToDocsRequests(ParseMarkdown("## 🚀 **Go**"), 1)
+	if len(reqs) != 3 {
+		t.Fatalf("unexpected requests: %#v", reqs)
+	}
+	if ps := reqs[1].UpdateParagraphStyle; ps == nil || ps.ParagraphStyle.NamedStyleType != "HEADING_2" || ps.Range.EndIndex != 7 {
+		t.Fatalf("unexpected heading style: %#v", reqs[1])
+	}
+	if ts := reqs[2].UpdateTextStyle; ts == nil || !ts.TextStyle.Bold || ts.Range.StartIndex != 4 || ts.Range.EndIndex != 6 {
+		t.Fatalf("unexpected bold style: %#v", reqs[2])
+	}
+}
+
+func TestDocsImportCmd_JSON(t *testing.T) {
+	origDrive := newDriveService
+	origDocs := newDocsService
+	t.Cleanup(func() {
+		newDriveService = origDrive
+		newDocsService = origDocs
+	})
+
+	var created drive.File
+	var batch docs.BatchUpdateDocumentRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.TrimPrefix(r.URL.Path, "/drive/v3") == "/files":
+			_ = json.NewDecoder(r.Body).Decode(&created)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "doc1", "name": created.Name, "mimeType": created.MimeType})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/documents/doc1:batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{"documentId": "doc1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	driveSvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("drive.NewService: %v", err)
+	}
+	docsSvc, err := docs.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("docs.NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return driveSvc, nil }
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return docsSvc, nil }
+
+	path := filepath.Join(t.TempDir(), "notes.md")
+	if err := os.WriteFile(path, []byte("# Notes\n\n```\nmake test\n```\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsImportCmd{}, []string{path, "--parent", "folder1"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("import: %v", err)
+		}
+	})
+
+	if created.Name != "notes" || created.MimeType != "application/vnd.google-apps.document" || !reflect.DeepEqual(created.Parents, []string{"folder1"}) {
+		t.Fatalf("unexpected create: %#v", created)
+	}
+	if len(batch.Requests) == 0 || batch.Requests[0].InsertText == nil || batch.Requests[0].InsertText.Text != "Notes\nmake test\n" {
+		t.Fatalf("unexpected batch: %#v", batch.Requests)
+	}
+	if !strings.Contains(out, `"id": "doc1"`) || !strings.Contains(out, `"requests": 4`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	if err := runKong(t, &DocsImportCmd{}, []string{"-"}, ctx, &RootFlags{Account: "a@b.com"}); err == nil || ExitCode(err) != 2 {
+		t.Fatalf("expected usage error without --title for stdin, got %v", err)
+	}
+}
+
+func TestParseMarkdown_NestedLists(t *testing.T) {
+	src := strings.Join([]string{
+		"1. First",
+		"   - sub a",
+		"   - sub b",
+		"     1. deep",
+		"2. Second",
+		"- other list",
+		"\t- tab nested",
+		"",
+		"Para",
+		"  * after para",
+	}, "\n")
+
+	type item struct {
+		text    string
+		depth   int
+		ordered bool
+	}
+	var got []item
+	for _, b := range ParseMarkdown(src) {
+		if b.Kind == mdListItem {
+			got = append(got, item{b.Text, b.Depth, b.Ordered})
+		}
+	}
+	want := []item{
+		{"First", 0, true},
+		{"sub a", 1, false},
+		{"sub b", 1, false},
+		{"deep", 2, true},
+		{"Second", 0, true},
+		{"other list", 0, false},
+		{"tab nested", 1, false},
+		{"after para", 0, false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("list items = %#v", got)
+	}
+}
+
+func TestMarkdownToDocsRequests_Lists(t *testing.T) {
+	src := "1. First\n   - sub a\n   - sub b\n     1. deep\n2. Second\n- other list\n  - **nested**\n\nPara\n- after para\n"
+	reqs := MarkdownToDocsRequests(ParseMarkdown(src), 1)
+
+	wantText := "First\n\tsub a\n\tsub b\n\t\tdeep\nSecond\nother list\n\tnested\nPara\nafter para\n"
+	if reqs[0].InsertText == nil || reqs[0].InsertText.Text != wantText {
+		t.Fatalf("unexpected insert: %#v", reqs[0].InsertText)
+	}
+
+	type bullet struct {
+		start, end int64
+		preset     string
+	}
+	var bullets []bullet
+	var boldRange *docs.Range
+	for i, r := range reqs[1:] {
+		if b := r.CreateParagraphBullets; b != nil {
+			bullets = append(bullets, bullet{b.Range.StartIndex, b.Range.EndIndex, b.BulletPreset})
+		} else if len(bullets) > 0 {
+			t.Fatalf("request %d after bullets would use shifted indexes: %#v", i+1, r)
+		}
+		if ts := r.UpdateTextStyle; ts != nil && ts.TextStyle.Bold {
+			boldRange = ts.Range
+		}
+	}
+	// Bullets run last-to-first so stripping tabs never shifts a pending range.
+	want := []bullet{
+		{59, 70, "BULLET_DISC_CIRCLE_SQUARE"},
+		{35, 54, "BULLET_DISC_CIRCLE_SQUARE"},
+		{1, 35, "NUMBERED_DECIMAL_ALPHA_ROMAN"},
+	}
+	if !reflect.DeepEqual(bullets, want) {
+		t.Fatalf("bullets = %#v", bullets)
+	}
+	if boldRange == nil || boldRange.StartIndex != 47 || boldRange.EndIndex != 53 {
+		t.Fatalf("bold range should skip the nesting tab, got %#v", boldRange)
+	}
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsInsertImageCmd struct {
+	DocID     string  `arg:"" name:"docId" help:"Doc ID"`
+	Index     int64   `name:"index" help:"Document index to insert the image at (>= 1)" required:""`
+	URL       string  `name:"url" help:"Publicly reachable image URL"`
+	File      string  `name:"file" help:"Local image file (uploaded to Drive and readable by link until the image is inserted, then deleted)"`
+	Width     float64 `name:"width" help:"Image width in points"`
+	Height    float64 `name:"height" help:"Image height in points"`
+	ChunkSize string  `name:"chunk-size" help:"Resumable upload chunk size for --file (e.g. 8MB)" default:"${upload_chunk_size}"`
+}
+
+func (c *DocsInsertImageCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+	if err := validateDocsIndex(c.Index); err != nil {
+		return err
+	}
+	imageURL := strings.TrimSpace(c.URL)
+	imageFile := strings.TrimSpace(c.File)
+	if (imageURL == "") == (imageFile == "") {
+		return usage("provide exactly one of --url or --file")
+	}
+	if c.Width < 0 || c.Height < 0 {
+		return usage("--width and --height must be positive")
+	}
+
+	if imageFile != "" {
+		driveSvc, driveErr := newDriveService(ctx, account)
+		if driveErr != nil {
+			return driveErr
+		}
+		img, uploadErr := uploadLocalImage(ctx, driveSvc, imageFile, c.ChunkSize)
+		if uploadErr != nil {
+			return uploadErr
+		}
+		defer cleanupUploadedImages(ctx, driveSvc, []*uploadedImage{img})
+		imageURL = img.URL
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	req := &docs.InsertInlineImageRequest{
+		Location:   &docs.Location{Index: c.Index},
+		Uri:        imageURL,
+		ObjectSize: docsImageSize(c.Width, c.Height),
+	}
+	resp, err := svc.Documents.BatchUpdate(id, &docs.BatchUpdateDocumentRequest{
+		Requests: []*docs.Request{{InsertInlineImage: req}},
+	}).Context(ctx).Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", id)
+		}
+		return err
+	}
+
+	objectID := ""
+	if len(resp.Replies) > 0 && resp.Replies[0].InsertInlineImage != nil {
+		objectID = resp.Replies[0].InsertInlineImage.ObjectId
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"documentId": id,
+			"objectId":   objectID,
+			"atIndex":    c.Index,
+		})
+	}
+	u.Out().Printf("id\t%s", id)
+	u.Out().Printf("objectId\t%s", objectID)
+	u.Out().Printf("atIndex\t%d", c.Index)
+	return nil
+}
+
+// docsImageSize builds an ObjectSize in points; Docs keeps the aspect ratio
+// when only one dimension is set.
+func docsImageSize(width, height float64) *docs.Size {
+	if width <= 0 && height <= 0 {
+		return nil
+	}
+	size := &docs.Size{}
+	if width > 0 {
+		size.Width = &docs.Dimension{Magnitude: width, Unit: "PT"}
+	}
+	if height > 0 {
+		size.Height = &docs.Dimension{Magnitude: height, Unit: "PT"}
+	}
+	return size
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDocsInsertImageCmd_FileUploadAndCleanup(t *testing.T) {
+	origDocs, origDrive := newDocsService, newDriveService
+	t.Cleanup(func() {
+		newDocsService = origDocs
+		newDriveService = origDrive
+	})
+
+	var (
+		mu       sync.Mutex
+		shared   bool
+		unshared bool
+		deleted  []string
+		gotBatch docs.BatchUpdateDocumentRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/files") && strings.Contains(r.URL.Path, "upload"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "img1"})
+		case r.Method == http.MethodPost && path == "/files/img1/permissions":
+			shared = true
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "perm1"})
+		case r.Method == http.MethodDelete && path == "/files/img1/permissions/perm1":
+			if len(deleted) > 0 {
+				t.Errorf("share must be revoked before the file is deleted")
+			}
+			unshared = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && path == "/files/img1":
+			deleted = append(deleted, "img1")
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/documents/doc1:batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&gotBatch)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"documentId": "doc1",
+				"replies":    []any{map[string]any{"insertInlineImage": map[string]any{"objectId": "kix.obj1"}}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	docsSvc, err := docs.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("docs.NewService: %v", err)
+	}
+	driveSvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("drive.NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return docsSvc, nil }
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return driveSvc, nil }
+
+	imgPath := filepath.Join(t.TempDir(), "chart.png")
+	if err := os.WriteFile(imgPath, []byte("\x89PNG fake"), 0o600); err != nil {
+		t.Fatalf("write image: %v", err)
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		args := []string{"doc1", "--index", "5", "--file", imgPath, "--width", "200"}
+		if err := runKong(t, &DocsInsertImageCmd{}, args, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("insert-image: %v", err)
+		}
+	})
+
+	if !shared || !unshared || len(deleted) != 1 {
+		t.Fatalf("expected temporary upload to be shared, unshared and deleted, shared=%v unshared=%v deleted=%v", shared, unshared, deleted)
+	}
+	req := gotBatch.Requests[0].InsertInlineImage
+	if req == nil || req.Location.Index != 5 || !strings.Contains(req.Uri, "id=img1") {
+		t.Fatalf("unexpected request: %#v", gotBatch.Requests[0])
+	}
+	if req.ObjectSize == nil || req.ObjectSize.Width.Magnitude != 200 || req.ObjectSize.Height != nil {
+		t.Fatalf("unexpected size: %#v", req.ObjectSize)
+	}
+	if !strings.Contains(out, `"objectId": "kix.obj1"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestDocsInsertImageCmd_Validation(t *testing.T) {
+	flags := &RootFlags{Account: "a@b.com"}
+	if err := (&DocsInsertImageCmd{DocID: "d", Index: 1}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected error without --url/--file")
+	}
+	if err := (&DocsInsertImageCmd{DocID: "d", Index: 1, URL: "https://x/y.png", File: "y.png"}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected error with both --url and --file")
+	}
+	if err := (&DocsInsertImageCmd{DocID: "d", Index: 0, URL: "https://x/y.png"}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected index error")
+	}
+
+	imgPath := filepath.Join(t.TempDir(), "chart.png")
+	if err := os.WriteFile(imgPath, []byte("\x89PNG fake"), 0o600); err != nil {
+		t.Fatalf("write image: %v", err)
+	}
+	origDrive := newDriveService
+	t.Cleanup(func() { newDriveService = origDrive })
+	newDriveService = func(context.Context, string) (*drive.Service, error) {
+		return drive.NewService(context.Background(), option.WithoutAuthentication(), option.WithEndpoint("http://127.0.0.1:0/"))
+	}
+	err := runKong(t, &DocsInsertImageCmd{}, []string{"d", "--index", "1", "--file", imgPath, "--chunk-size", "64KB"}, context.Background(), flags)
+	if ExitCode(err) != 2 || !strings.Contains(err.Error(), "chunk-size") {
+		t.Fatalf("expected --chunk-size usage error, got %v", err)
+	}
+}
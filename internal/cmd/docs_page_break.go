@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsPageBreakCmd struct {
+	DocID string `arg:"" name:"docId" help:"Doc ID"`
+	Index int64  `name:"index" help:"Document index to insert the page break at (>= 1)" required:""`
+}
+
+func (c *DocsPageBreakCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+	if err := validateDocsIndex(c.Index); err != nil {
+		return err
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	if err := docsBatchUpdate(ctx, svc, id, &docs.Request{
+		InsertPageBreak: &docs.InsertPageBreakRequest{
+			Location: &docs.Location{Index: c.Index},
+		},
+	}); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"documentId": id,
+			"atIndex":    c.Index,
+		})
+	}
+	u.Out().Printf("id\t%s", id)
+	u.Out().Printf("atIndex\t%d", c.Index)
+	return nil
+}
+
+// validateDocsIndex rejects indexes before the start of the body; index 0 is
+// the body's section break and cannot hold content.
+func validateDocsIndex(index int64) error {
+	if index < 1 {
+		return usage("--index must be >= 1")
+	}
+	return nil
+}
+
+func docsBatchUpdate(ctx context.Context, svc *docs.Service, docID string, requests ...*docs.Request) error {
+	_, err := svc.Documents.BatchUpdate(docID, &docs.BatchUpdateDocumentRequest{Requests: requests}).
+		Context(ctx).
+		Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", docID)
+		}
+		return err
+	}
+	return nil
+}
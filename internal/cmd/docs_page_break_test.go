@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDocsPageBreakCmd_JSON(t *testing.T) {
+	origDocs := newDocsService
+	t.Cleanup(func() { newDocsService = origDocs })
+
+	var got docs.BatchUpdateDocumentRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/documents/doc1:batchUpdate" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"documentId": "doc1"})
+	}))
+	defer srv.Close()
+
+	svc, err := docs.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsPageBreakCmd{}, []string{"doc1", "--index", "42"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("page-break: %v", err)
+		}
+	})
+
+	if len(got.Requests) != 1 || got.Requests[0].InsertPageBreak == nil || got.Requests[0].InsertPageBreak.Location.Index != 42 {
+		t.Fatalf("unexpected request: %#v", got.Requests)
+	}
+	if !strings.Contains(out, `"atIndex": 42`) || !strings.Contains(out, `"documentId": "doc1"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestDocsPageBreakCmd_InvalidIndex(t *testing.T) {
+	err := (&DocsPageBreakCmd{DocID: "doc1", Index: 0}).Run(context.Background(), &RootFlags{Account: "a@b.com"})
+	if err == nil || !strings.Contains(err.Error(), "--index must be >= 1") {
+		t.Fatalf("expected index error, got %v", err)
+	}
+}
+
+func TestDocsSectionBreakCmd_JSON(t *testing.T) {
+	origDocs := newDocsService
+	t.Cleanup(func() { newDocsService = origDocs })
+
+	var got docs.BatchUpdateDocumentRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/documents/doc1:batchUpdate" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"documentId": "doc1"})
+	}))
+	defer srv.Close()
+
+	svc, err := docs.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsSectionBreakCmd{}, []string{"doc1", "--index", "7", "--type", "continuous"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("section-break: %v", err)
+		}
+	})
+
+	req := got.Requests[0].InsertSectionBreak
+	if req == nil || req.Location.Index != 7 || req.SectionType != "CONTINUOUS" {
+		t.Fatalf("unexpected request: %#v", got.Requests)
+	}
+	if !strings.Contains(out, `"type": "CONTINUOUS"`) || !strings.Contains(out, `"atIndex": 7`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	if err := runKong(t, &DocsSectionBreakCmd{}, []string{"doc1", "--index", "0"}, ctx, &RootFlags{Account: "a@b.com"}); err == nil {
+		t.Fatal("expected index error")
+	}
+}
@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsReplaceImageCmd struct {
+	DocID     string `arg:"" name:"docId" help:"Doc ID"`
+	ObjectID  string `name:"object-id" help:"Inline object ID of the image to replace (see 'gog docs replace-image --list')"`
+	Nth       int    `name:"nth" help:"Replace the Nth inline image in document order (1-based)"`
+	List      bool   `name:"list" help:"List the doc's inline images instead of replacing one"`
+	URL       string `name:"url" help:"Publicly reachable image URL"`
+	File      string `name:"file" help:"Local image file (uploaded to Drive and readable by link until the image is inserted, then deleted)"`
+	ChunkSize string `name:"chunk-size" help:"Resumable upload chunk size for --file (e.g. 8MB)" default:"${upload_chunk_size}"`
+}
+
+func (c *DocsReplaceImageCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+	objectID := strings.TrimSpace(c.ObjectID)
+	imageURL := strings.TrimSpace(c.URL)
+	imageFile := strings.TrimSpace(c.File)
+	if !c.List {
+		if objectID != "" && c.Nth != 0 {
+			return usage("use either --object-id or --nth, not both")
+		}
+		if c.Nth < 0 {
+			return usage("--nth must be >= 1")
+		}
+		if (imageURL == "") == (imageFile == "") {
+			return usage("provide exactly one of --url or --file")
+		}
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	doc, err := svc.Documents.Get(id).Context(ctx).Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", id)
+		}
+		return err
+	}
+	images := docsInlineImageIDs(doc)
+
+	if c.List {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"documentId": id, "images": images})
+		}
+		if len(images) == 0 {
+			u.Err().Println("No inline images")
+			return nil
+		}
+		w, flush := tableWriter(ctx)
+		defer flush()
+		fmt.Fprintln(w, "N\tOBJECT_ID")
+		for i, img := range images {
+			fmt.Fprintf(w, "%d\t%s\n", i+1, img)
+		}
+		return nil
+	}
+
+	if len(images) == 0 {
+		return fmt.Errorf("no image found in doc %s", id)
+	}
+	objectID, err = pickDocsInlineImage(images, objectID, c.Nth)
+	if err != nil {
+		return err
+	}
+
+	if imageFile != "" {
+		driveSvc, driveErr := newDriveService(ctx, account)
+		if driveErr != nil {
+			return driveErr
+		}
+		img, uploadErr := uploadLocalImage(ctx, driveSvc, imageFile, c.ChunkSize)
+		if uploadErr != nil {
+			return uploadErr
+		}
+		defer cleanupUploadedImages(ctx, driveSvc, []*uploadedImage{img})
+		imageURL = img.URL
+	}
+
+	_, err = svc.Documents.BatchUpdate(id, &docs.BatchUpdateDocumentRequest{
+		Requests: []*docs.Request{{ReplaceImage: &docs.ReplaceImageRequest{
+			ImageObjectId: objectID,
+			Uri:           imageURL,
+		}}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"documentId": id,
+			"objectId":   objectID,
+		})
+	}
+	u.Out().Printf("id\t%s", id)
+	u.Out().Printf("objectId\t%s", objectID)
+	return nil
+}
+
+// pickDocsInlineImage resolves --object-id/--nth against the doc's images;
+// with neither, the doc must contain exactly one image.
+func pickDocsInlineImage(images []string, objectID string, nth int) (string, error) {
+	switch {
+	case objectID != "":
+		for _, img := range images {
+			if img == objectID {
+				return objectID, nil
+			}
+		}
+		return "", usagef("no image found with object ID %q", objectID)
+	case nth > 0:
+		if nth > len(images) {
+			return "", usagef("--nth %d out of range (doc has %d images)", nth, len(images))
+		}
+		return images[nth-1], nil
+	case len(images) == 1:
+		return images[0], nil
+	default:
+		return "", usagef("doc has %d images; pick one with --nth or --object-id (see --list)", len(images))
+	}
+}
+
+// docsInlineImageIDs returns the inline object IDs of images (not drawings or
+// charts) in body order, including images inside tables.
+func docsInlineImageIDs(doc *docs.Document) []string {
+	if doc == nil || doc.Body == nil {
+		return nil
+	}
+	var ids []string
+	seen := map[string]bool{}
+	var walk func(els []*docs.StructuralElement)
+	walk = func(els []*docs.StructuralElement) {
+		for _, el := range els {
+			switch {
+			case el == nil:
+			case el.Paragraph != nil:
+				for _, p := range el.Paragraph.Elements {
+					if p.InlineObjectElement == nil {
+						continue
+					}
+					objID := p.InlineObjectElement.InlineObjectId
+					obj, ok := doc.InlineObjects[objID]
+					if !ok || seen[objID] || obj.InlineObjectProperties == nil ||
+						obj.InlineObjectProperties.EmbeddedObject == nil ||
+						obj.InlineObjectProperties.EmbeddedObject.ImageProperties == nil {
+						continue
+					}
+					seen[objID] = true
+					ids = append(ids, objID)
+				}
+			case el.Table != nil:
+				for _, row := range el.Table.TableRows {
+					for _, cell := range row.TableCells {
+						walk(cell.Content)
+					}
+				}
+			}
+		}
+	}
+	walk(doc.Body.Content)
+	return ids
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func docsImageParagraph(objectIDs ...string) map[string]any {
+	elements := make([]any, 0, len(objectIDs))
+	for _, id := range objectIDs {
+		elements = append(elements, map[string]any{"inlineObjectElement": map[string]any{"inlineObjectId": id}})
+	}
+	return map[string]any{"paragraph": map[string]any{"elements": elements}}
+}
+
+func docsImageObject(id string) map[string]any {
+	return map[string]any{
+		"objectId": id,
+		"inlineObjectProperties": map[string]any{
+			"embeddedObject": map[string]any{"imageProperties": map[string]any{"contentUri": "https://x/" + id}},
+		},
+	}
+}
+
+func TestDocsReplaceImageCmd(t *testing.T) {
+	origDocs := newDocsService
+	t.Cleanup(func() { newDocsService = origDocs })
+
+	var gotBatch *docs.BatchUpdateDocumentRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/documents/doc1":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"documentId": "doc1",
+				"body": map[string]any{"content": []any{
+					docsImageParagraph("kix.a"),
+					map[string]any{"table": map[string]any{"tableRows": []any{
+						map[string]any{"tableCells": []any{
+							map[string]any{"content": []any{docsImageParagraph("kix.b", "kix.drawing")}},
+						}},
+					}}},
+				}},
+				"inlineObjects": map[string]any{
+					"kix.a":       docsImageObject("kix.a"),
+					"kix.b":       docsImageObject("kix.b"),
+					"kix.drawing": map[string]any{"objectId": "kix.drawing", "inlineObjectProperties": map[string]any{"embeddedObject": map[string]any{}}},
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/documents/empty":
+			_ = json.NewEncoder(w).Encode(map[string]any{"documentId": "empty", "body": map[string]any{}})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/documents/doc1:batchUpdate":
+			gotBatch = &docs.BatchUpdateDocumentRequest{}
+			_ = json.NewDecoder(r.Body).Decode(gotBatch)
+			_ = json.NewEncoder(w).Encode(map[string]any{"documentId": "doc1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := docs.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("docs.NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsReplaceImageCmd{}, []string{"doc1", "--nth", "2", "--url", "https://example.com/new.png"}, ctx, flags); err != nil {
+			t.Fatalf("replace-image: %v", err)
+		}
+	})
+	req := gotBatch.Requests[0].ReplaceImage
+	if req == nil || req.ImageObjectId != "kix.b" || req.Uri != "https://example.com/new.png" {
+		t.Fatalf("unexpected request: %#v", gotBatch.Requests[0])
+	}
+	if !strings.Contains(out, `"objectId": "kix.b"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	out = captureStdout(t, func() {
+		if err := runKong(t, &DocsReplaceImageCmd{}, []string{"doc1", "--list"}, ctx, flags); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"kix.a"`) || !strings.Contains(out, `"kix.b"`) || strings.Contains(out, "kix.drawing") {
+		t.Fatalf("unexpected list output: %s", out)
+	}
+
+	// Two images and no selector is ambiguous.
+	if err := runKong(t, &DocsReplaceImageCmd{}, []string{"doc1", "--url", "https://example.com/new.png"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "--nth") {
+		t.Fatalf("expected ambiguity error, got %v", err)
+	}
+	if err := runKong(t, &DocsReplaceImageCmd{}, []string{"doc1", "--object-id", "kix.drawing", "--url", "https://example.com/new.png"}, ctx, flags); err == nil {
+		t.Fatal("expected error for non-image object")
+	}
+	if err := runKong(t, &DocsReplaceImageCmd{}, []string{"empty", "--url", "https://example.com/new.png"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "no image found") {
+		t.Fatalf("expected no image error, got %v", err)
+	}
+}
+
+func TestDocsReplaceImageCmd_Validation(t *testing.T) {
+	flags := &RootFlags{Account: "a@b.com"}
+	if err := (&DocsReplaceImageCmd{DocID: "d", Nth: 1}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected error without --url/--file")
+	}
+	if err := (&DocsReplaceImageCmd{DocID: "d", Nth: 1, ObjectID: "kix.a", URL: "https://x/y.png"}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected error with both --nth and --object-id")
+	}
+}
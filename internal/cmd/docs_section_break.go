@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsSectionBreakCmd struct {
+	DocID string `arg:"" name:"docId" help:"Doc ID"`
+	Index int64  `name:"index" help:"Document index to insert the section break at (>= 1)" required:""`
+	Type  string `name:"type" help:"Break type: continuous (same page) or next-page" enum:"continuous,next-page" default:"next-page"`
+}
+
+func (c *DocsSectionBreakCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+	if err := validateDocsIndex(c.Index); err != nil {
+		return err
+	}
+	sectionType, err := docsSectionType(c.Type)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	if err := docsBatchUpdate(ctx, svc, id, &docs.Request{
+		InsertSectionBreak: &docs.InsertSectionBreakRequest{
+			Location:    &docs.Location{Index: c.Index},
+			SectionType: sectionType,
+		},
+	}); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"documentId": id,
+			"atIndex":    c.Index,
+			"type":       sectionType,
+		})
+	}
+	u.Out().Printf("id\t%s", id)
+	u.Out().Printf("atIndex\t%d", c.Index)
+	u.Out().Printf("type\t%s", sectionType)
+	return nil
+}
+
+func docsSectionType(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "next-page":
+		return "NEXT_PAGE", nil
+	case "continuous":
+		return "CONTINUOUS", nil
+	default:
+		return "", usagef("invalid --type %q (expected continuous or next-page)", value)
+	}
+}
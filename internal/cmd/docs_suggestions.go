@@ -0,0 +1,165 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const docsSuggestionsInline = "SUGGESTIONS_INLINE"
+
+// docsSuggestionsViewMode maps the --suggestions-mode values to the Docs API
+// SuggestionsViewMode. An empty value keeps the API default.
+func docsSuggestionsViewMode(raw string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "":
+		return "", nil
+	case "inline":
+		return docsSuggestionsInline, nil
+	case "accepted":
+		return "PREVIEW_SUGGESTIONS_ACCEPTED", nil
+	case "original", "rejected":
+		return "PREVIEW_WITHOUT_SUGGESTIONS", nil
+	default:
+		return "", usagef("invalid --suggestions-mode %q (expected inline, accepted, or original)", raw)
+	}
+}
+
+type DocsSuggestionsCmd struct {
+	DocID string `arg:"" name:"docId" help:"Doc ID"`
+}
+
+func (c *DocsSuggestionsCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	doc, err := svc.Documents.Get(id).
+		SuggestionsViewMode(docsSuggestionsInline).
+		Context(ctx).
+		Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", id)
+		}
+		return err
+	}
+	if doc == nil {
+		return errors.New("doc not found")
+	}
+
+	suggestions := docsSuggestions(doc)
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"documentId": id, "suggestions": suggestions})
+	}
+	if len(suggestions) == 0 {
+		u.Err().Println("No pending suggestions")
+		return nil
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tTYPE\tINDEX\tTEXT")
+	for _, s := range suggestions {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", s.ID, s.Type, s.StartIndex, docsSuggestionDisplayText(s.Text))
+	}
+	return nil
+}
+
+type docsSuggestion struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"` // insertion, deletion, or formatting
+	StartIndex int64  `json:"startIndex"`
+	EndIndex   int64  `json:"endIndex"`
+	Text       string `json:"text"`
+}
+
+// docsSuggestions collects the pending suggestions of a doc fetched with
+// SUGGESTIONS_INLINE, joining the text runs that share a suggestion ID. The
+// Docs API does not say who made a suggestion, so there is no author.
+func docsSuggestions(doc *docs.Document) []docsSuggestion {
+	if doc == nil || doc.Body == nil {
+		return []docsSuggestion{}
+	}
+	byKey := map[string]*docsSuggestion{}
+	var order []string
+	add := func(id, kind string, el *docs.ParagraphElement) {
+		key := kind + "\x00" + id
+		s, ok := byKey[key]
+		if !ok {
+			s = &docsSuggestion{ID: id, Type: kind, StartIndex: el.StartIndex}
+			byKey[key] = s
+			order = append(order, key)
+		}
+		s.EndIndex = el.EndIndex
+		s.Text += el.TextRun.Content
+	}
+
+	var walk func(els []*docs.StructuralElement)
+	walk = func(els []*docs.StructuralElement) {
+		for _, el := range els {
+			switch {
+			case el == nil:
+			case el.Paragraph != nil:
+				for _, p := range el.Paragraph.Elements {
+					if p == nil || p.TextRun == nil {
+						continue
+					}
+					for _, sid := range p.TextRun.SuggestedInsertionIds {
+						add(sid, "insertion", p)
+					}
+					for _, sid := range p.TextRun.SuggestedDeletionIds {
+						add(sid, "deletion", p)
+					}
+					styleIDs := make([]string, 0, len(p.TextRun.SuggestedTextStyleChanges))
+					for sid := range p.TextRun.SuggestedTextStyleChanges {
+						styleIDs = append(styleIDs, sid)
+					}
+					sort.Strings(styleIDs)
+					for _, sid := range styleIDs {
+						add(sid, "formatting", p)
+					}
+				}
+			case el.Table != nil:
+				for _, row := range el.Table.TableRows {
+					for _, cell := range row.TableCells {
+						walk(cell.Content)
+					}
+				}
+			case el.TableOfContents != nil:
+				walk(el.TableOfContents.Content)
+			}
+		}
+	}
+	walk(doc.Body.Content)
+
+	out := make([]docsSuggestion, 0, len(order))
+	for _, key := range order {
+		out = append(out, *byKey[key])
+	}
+	return out
+}
+
+func docsSuggestionDisplayText(s string) string {
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return truncateRunes(sanitizeTab(s), 80)
+}
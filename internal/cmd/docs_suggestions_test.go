@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func docsTextRun(start, end int, text string, extra map[string]any) map[string]any {
+	run := map[string]any{"content": text}
+	for k, v := range extra {
+		run[k] = v
+	}
+	return map[string]any{"startIndex": start, "endIndex": end, "textRun": run}
+}
+
+func TestDocsSuggestionsCmd(t *testing.T) {
+	origDocs := newDocsService
+	t.Cleanup(func() { newDocsService = origDocs })
+
+	var viewModes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/documents/doc1" {
+			http.NotFound(w, r)
+			return
+		}
+		viewModes = append(viewModes, r.URL.Query().Get("suggestionsViewMode"))
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"documentId": "doc1",
+			"body": map[string]any{"content": []any{
+				map[string]any{"paragraph": map[string]any{"elements": []any{
+					docsTextRun(1, 7, "Hello ", nil),
+					docsTextRun(7, 12, "brave", map[string]any{"suggestedInsertionIds": []string{"suggest.ins"}}),
+					docsTextRun(12, 14, " n", map[string]any{"suggestedInsertionIds": []string{"suggest.ins"}}),
+					docsTextRun(14, 19, "world", map[string]any{"suggestedDeletionIds": []string{"suggest.del"}}),
+				}}},
+				map[string]any{"table": map[string]any{"tableRows": []any{
+					map[string]any{"tableCells": []any{
+						map[string]any{"content": []any{
+							map[string]any{"paragraph": map[string]any{"elements": []any{
+								docsTextRun(22, 26, "bold", map[string]any{"suggestedTextStyleChanges": map[string]any{"suggest.fmt": map[string]any{}}}),
+							}}},
+						}},
+					}},
+				}}},
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := docs.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("docs.NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsSuggestionsCmd{}, []string{"doc1"}, ctx, flags); err != nil {
+			t.Fatalf("suggestions: %v", err)
+		}
+	})
+	var parsed struct {
+		Suggestions []docsSuggestion `json:"suggestions"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	want := []docsSuggestion{
+		{ID: "suggest.ins", Type: "insertion", StartIndex: 7, EndIndex: 14, Text: "brave n"},
+		{ID: "suggest.del", Type: "deletion", StartIndex: 14, EndIndex: 19, Text: "world"},
+		{ID: "suggest.fmt", Type: "formatting", StartIndex: 22, EndIndex: 26, Text: "bold"},
+	}
+	if len(parsed.Suggestions) != len(want) {
+		t.Fatalf("unexpected suggestions: %+v", parsed.Suggestions)
+	}
+	for i := range want {
+		if parsed.Suggestions[i] != want[i] {
+			t.Fatalf("suggestion %d = %+v, want %+v", i, parsed.Suggestions[i], want[i])
+		}
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &DocsCatCmd{}, []string{"doc1", "--suggestions-mode", "accepted"}, ctx, flags); err != nil {
+			t.Fatalf("cat: %v", err)
+		}
+	})
+	if len(viewModes) != 2 || viewModes[0] != "SUGGESTIONS_INLINE" || viewModes[1] != "PREVIEW_SUGGESTIONS_ACCEPTED" {
+		t.Fatalf("unexpected view modes: %v", viewModes)
+	}
+	if err := runKong(t, &DocsCatCmd{}, []string{"doc1", "--suggestions-mode", "bogus"}, ctx, flags); err == nil {
+		t.Fatal("expected error for unknown suggestions mode")
+	}
+}
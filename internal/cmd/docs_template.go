@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsCreateFromTemplateCmd struct {
+	TemplateID string             `arg:"" name:"templateId" help:"Doc ID of the template"`
+	Title      string             `name:"title" help:"Title of the new doc" required:""`
+	Parent     string             `name:"parent" help:"Destination folder ID"`
+	Values     TemplateValueFlags `embed:""`
+}
+
+func (c *DocsCreateFromTemplateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	templateID := strings.TrimSpace(c.TemplateID)
+	if templateID == "" {
+		return usage("empty templateId")
+	}
+	title := strings.TrimSpace(c.Title)
+	if title == "" {
+		return usage("empty title")
+	}
+	values, err := c.Values.values()
+	if err != nil {
+		return err
+	}
+	keys := sortedTemplateKeys(values)
+
+	if stop, dryErr := dryRunExit(ctx, flags, "docs.create-from-template", map[string]any{
+		"templateId": templateID,
+		"title":      title,
+		"parent":     strings.TrimSpace(c.Parent),
+		"keys":       keys,
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	driveSvc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	meta, err := driveSvc.Files.Get(templateID).
+		SupportsAllDrives(true).
+		Fields("id, mimeType").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if meta.MimeType != driveMimeGoogleDoc {
+		return fmt.Errorf("file is not a Google Doc (mimeType=%q)", meta.MimeType)
+	}
+
+	req := &drive.File{Name: title}
+	if parent := strings.TrimSpace(c.Parent); parent != "" {
+		req.Parents = []string{parent}
+	}
+	created, err := driveSvc.Files.Copy(templateID, req).
+		SupportsAllDrives(true).
+		Fields("id, name, webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if created == nil {
+		return errors.New("copy failed")
+	}
+
+	replacements := make(map[string]int64, len(keys))
+	if len(keys) > 0 {
+		docsSvc, svcErr := newDocsService(ctx, account)
+		if svcErr != nil {
+			return svcErr
+		}
+		requests := make([]*docs.Request, 0, len(keys))
+		for _, k := range keys {
+			requests = append(requests, &docs.Request{ReplaceAllText: &docs.ReplaceAllTextRequest{
+				ContainsText: &docs.SubstringMatchCriteria{Text: templatePlaceholder(k), MatchCase: true},
+				ReplaceText:  values[k],
+			}})
+		}
+		resp, batchErr := docsSvc.Documents.BatchUpdate(created.Id, &docs.BatchUpdateDocumentRequest{Requests: requests}).
+			Context(ctx).
+			Do()
+		if batchErr != nil {
+			return fmt.Errorf("doc %s created, but filling placeholders failed: %w", created.Id, batchErr)
+		}
+		for i, k := range keys {
+			if i < len(resp.Replies) && resp.Replies[i] != nil && resp.Replies[i].ReplaceAllText != nil {
+				replacements[k] = resp.Replies[i].ReplaceAllText.OccurrencesChanged
+			} else {
+				replacements[k] = 0
+			}
+		}
+	}
+
+	link := created.WebViewLink
+	if link == "" {
+		link = docsWebViewLink(created.Id)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"documentId":   created.Id,
+			"title":        created.Name,
+			"link":         link,
+			"replacements": replacements,
+		})
+	}
+
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("title\t%s", created.Name)
+	u.Out().Printf("link\t%s", link)
+	for _, k := range keys {
+		u.Out().Printf("replaced\t%s\t%d", templatePlaceholder(k), replacements[k])
+		if replacements[k] == 0 {
+			u.Err().Printf("warning: %s not found in template", templatePlaceholder(k))
+		}
+	}
+	return nil
+}
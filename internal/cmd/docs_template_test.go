@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDocsCreateFromTemplateCmd(t *testing.T) {
+	origDocs, origDrive := newDocsService, newDriveService
+	t.Cleanup(func() {
+		newDocsService = origDocs
+		newDriveService = origDrive
+	})
+
+	var copied drive.File
+	var batch docs.BatchUpdateDocumentRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/files/tpl"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "tpl", "mimeType": driveMimeGoogleDoc})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/files/tpl/copy"):
+			_ = json.NewDecoder(r.Body).Decode(&copied)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "doc2", "name": copied.Name})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/documents/doc2:batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"documentId": "doc2",
+				"replies": []any{
+					map[string]any{"replaceAllText": map[string]any{"occurrencesChanged": 3}},
+					map[string]any{"replaceAllText": map[string]any{}},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	docsSvc, err := docs.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("docs.NewService: %v", err)
+	}
+	driveSvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("drive.NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return docsSvc, nil }
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return driveSvc, nil }
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsCreateFromTemplateCmd{}, []string{
+			"tpl", "--title", "Contract - Acme", "--parent", "folder1", "--set", "client=Acme", "--set", "date=2026-01-05",
+		}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("from-template: %v", err)
+		}
+	})
+
+	if copied.Name != "Contract - Acme" || len(copied.Parents) != 1 || copied.Parents[0] != "folder1" {
+		t.Fatalf("unexpected copy request: %#v", copied)
+	}
+	if len(batch.Requests) != 2 {
+		t.Fatalf("unexpected batch: %#v", batch.Requests)
+	}
+	first := batch.Requests[0].ReplaceAllText
+	if first == nil || first.ContainsText.Text != "{{client}}" || !first.ContainsText.MatchCase || first.ReplaceText != "Acme" {
+		t.Fatalf("unexpected first request: %#v", first)
+	}
+	if batch.Requests[1].ReplaceAllText.ContainsText.Text != "{{date}}" {
+		t.Fatalf("unexpected second request: %#v", batch.Requests[1].ReplaceAllText)
+	}
+
+	var parsed struct {
+		DocumentID   string           `json:"documentId"`
+		Replacements map[string]int64 `json:"replacements"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.DocumentID != "doc2" || parsed.Replacements["client"] != 3 || parsed.Replacements["date"] != 0 {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+
+}
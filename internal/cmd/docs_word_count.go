@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"google.golang.org/api/docs/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DocsWordCountCmd struct {
+	DocID    string `arg:"" name:"docId" help:"Doc ID"`
+	Tab      string `name:"tab" help:"Only count this tab (tab ID or title)"`
+	MaxBytes int64  `name:"max-bytes" help:"Max bytes of text to analyse (0 = unlimited)" default:"2000000"`
+}
+
+type docsTextStats struct {
+	Words              int  `json:"words"`
+	Characters         int  `json:"characters"`
+	CharactersNoSpaces int  `json:"charactersNoSpaces"`
+	Paragraphs         int  `json:"paragraphs"`
+	Truncated          bool `json:"truncated,omitempty"`
+}
+
+func (c *DocsWordCountCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	id := strings.TrimSpace(c.DocID)
+	if id == "" {
+		return usage("empty docId")
+	}
+
+	svc, err := newDocsService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	tabQuery := strings.TrimSpace(c.Tab)
+	call := svc.Documents.Get(id).Context(ctx)
+	if tabQuery != "" {
+		call = call.IncludeTabsContent(true)
+	}
+	doc, err := call.Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return fmt.Errorf("doc not found or not a Google Doc (id=%s)", id)
+		}
+		return err
+	}
+	if doc == nil {
+		return errors.New("doc not found")
+	}
+
+	if tabQuery != "" {
+		tab := findDocsTab(doc.Tabs, tabQuery)
+		if tab == nil || tab.DocumentTab == nil {
+			return usagef("tab not found: %q", tabQuery)
+		}
+		doc = &docs.Document{DocumentId: doc.DocumentId, Body: tab.DocumentTab.Body}
+	}
+
+	text := docsPlainText(doc, c.MaxBytes)
+	stats := textStats(text)
+	stats.Truncated = c.MaxBytes > 0 && int64(len(text)) >= c.MaxBytes
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, stats)
+	}
+	u.Out().Printf("words\t%d", stats.Words)
+	u.Out().Printf("characters\t%d", stats.Characters)
+	u.Out().Printf("characters_no_spaces\t%d", stats.CharactersNoSpaces)
+	u.Out().Printf("paragraphs\t%d", stats.Paragraphs)
+	if stats.Truncated {
+		u.Err().Printf("Hint: counts cover the first %d bytes; raise --max-bytes (0 = unlimited) for the full doc", c.MaxBytes)
+	}
+	return nil
+}
+
+// findDocsTab looks up a tab (including nested child tabs) by ID, then by
+// case-insensitive title.
+func findDocsTab(tabs []*docs.Tab, query string) *docs.Tab {
+	var byTitle *docs.Tab
+	var walk func([]*docs.Tab) *docs.Tab
+	walk = func(list []*docs.Tab) *docs.Tab {
+		for _, tab := range list {
+			if tab == nil {
+				continue
+			}
+			if props := tab.TabProperties; props != nil {
+				if props.TabId == query {
+					return tab
+				}
+				if byTitle == nil && strings.EqualFold(strings.TrimSpace(props.Title), query) {
+					byTitle = tab
+				}
+			}
+			if found := walk(tab.ChildTabs); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	if found := walk(tabs); found != nil {
+		return found
+	}
+	return byTitle
+}
+
+// textStats counts words, characters and non-empty paragraphs in the plain
+// text produced by docsPlainText. Line breaks are not counted as characters.
+func textStats(text string) docsTextStats {
+	var stats docsTextStats
+	stats.Words = len(strings.Fields(text))
+	for _, r := range text {
+		if r == '\n' || r == '\r' {
+			continue
+		}
+		stats.Characters++
+		if !unicode.IsSpace(r) {
+			stats.CharactersNoSpaces++
+		}
+	}
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) != "" {
+			stats.Paragraphs++
+		}
+	}
+	return stats
+}
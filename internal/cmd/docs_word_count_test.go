@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/docs/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func docsParagraph(text string) map[string]any {
+	return map[string]any{
+		"paragraph": map[string]any{
+			"elements": []any{
+				map[string]any{"textRun": map[string]any{"content": text}},
+			},
+		},
+	}
+}
+
+func TestDocsWordCountCmd_JSONWithTab(t *testing.T) {
+	origDocs := newDocsService
+	t.Cleanup(func() { newDocsService = origDocs })
+
+	var includeTabs string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/v1/documents/") {
+			http.NotFound(w, r)
+			return
+		}
+		includeTabs = r.URL.Query().Get("includeTabsContent")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"documentId": "doc1",
+			"tabs": []any{
+				map[string]any{
+					"tabProperties": map[string]any{"tabId": "t.0", "title": "Main"},
+					"documentTab":   map[string]any{"body": map[string]any{"content": []any{docsParagraph("ignored words here\n")}}},
+					"childTabs": []any{
+						map[string]any{
+							"tabProperties": map[string]any{"tabId": "t.1", "title": "Notes"},
+							"documentTab": map[string]any{"body": map[string]any{"content": []any{
+								docsParagraph("Hello big world\n"),
+								docsParagraph("\n"),
+								docsParagraph("Bye now\n"),
+							}}},
+						},
+					},
+				},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := docs.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDocsService = func(context.Context, string) (*docs.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DocsWordCountCmd{}, []string{"doc1", "--tab", "notes"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("word-count: %v", err)
+		}
+	})
+	if includeTabs != "true" {
+		t.Fatalf("expected includeTabsContent=true, got %q", includeTabs)
+	}
+
+	var stats docsTextStats
+	if err := json.Unmarshal([]byte(out), &stats); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	want := docsTextStats{Words: 5, Characters: 22, CharactersNoSpaces: 19, Paragraphs: 2}
+	if stats != want {
+		t.Fatalf("stats = %#v, want %#v", stats, want)
+	}
+}
+
+func TestTextStats_Truncation(t *testing.T) {
+	doc := &docs.Document{Body: &docs.Body{Content: []*docs.StructuralElement{
+		{Paragraph: &docs.Paragraph{Elements: []*docs.ParagraphElement{{TextRun: &docs.TextRun{Content: "one two three four\n"}}}}},
+	}}}
+	stats := textStats(docsPlainText(doc, 7))
+	if stats.Words != 2 || stats.Characters != 7 {
+		t.Fatalf("unexpected truncated stats: %#v", stats)
+	}
+}
+
+func TestFindDocsTab(t *testing.T) {
+	tabs := []*docs.Tab{
+		{TabProperties: &docs.TabProperties{TabId: "t.0", Title: "Intro"}, ChildTabs: []*docs.Tab{
+			{TabProperties: &docs.TabProperties{TabId: "t.1", Title: "Appendix"}},
+		}},
+	}
+	if got := findDocsTab(tabs, "t.1"); got == nil || got.TabProperties.Title != "Appendix" {
+		t.Fatalf("lookup by id failed: %#v", got)
+	}
+	if got := findDocsTab(tabs, "INTRO"); got == nil || got.TabProperties.TabId != "t.0" {
+		t.Fatalf("lookup by title failed: %#v", got)
+	}
+	if got := findDocsTab(tabs, "missing"); got != nil {
+		t.Fatalf("expected nil, got %#v", got)
+	}
+}
@@ -11,7 +11,6 @@ import (
 	"strings"
 
 	"google.golang.org/api/drive/v3"
-	gapi "google.golang.org/api/googleapi"
 
 	"github.com/steipete/gogcli/internal/config"
 	"github.com/steipete/gogcli/internal/googleapi"
@@ -19,15 +18,20 @@ import (
 	"github.com/steipete/gogcli/internal/ui"
 )
 
-var newDriveService = googleapi.NewDrive
+var (
+	newDriveService    = googleapi.NewDrive
+	newDriveHTTPClient = googleapi.NewDriveHTTPClient
+)
 
 const (
 	driveMimeGoogleDoc     = "application/vnd.google-apps.document"
 	driveMimeGoogleSheet   = "application/vnd.google-apps.spreadsheet"
 	driveMimeGoogleSlides  = "application/vnd.google-apps.presentation"
 	driveMimeGoogleDrawing = "application/vnd.google-apps.drawing"
+	driveMimeFolder        = "application/vnd.google-apps.folder"
 	mimePDF                = "application/pdf"
 	mimeCSV                = "text/csv"
+	mimeTSV                = "text/tab-separated-values"
 	mimeDocx               = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
 	mimeXlsx               = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
 	mimePptx               = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
@@ -35,6 +39,7 @@ const (
 	mimeTextPlain          = "text/plain"
 	extPDF                 = ".pdf"
 	extCSV                 = ".csv"
+	extTSV                 = ".tsv"
 	extXlsx                = ".xlsx"
 	extDocx                = ".docx"
 	extPptx                = ".pptx"
@@ -43,22 +48,31 @@ const (
 )
 
 type DriveCmd struct {
-	Ls          DriveLsCmd          `cmd:"" name:"ls" help:"List files in a folder (default: root)"`
-	Search      DriveSearchCmd      `cmd:"" name:"search" help:"Full-text search across Drive"`
-	Get         DriveGetCmd         `cmd:"" name:"get" help:"Get file metadata"`
-	Download    DriveDownloadCmd    `cmd:"" name:"download" help:"Download a file (exports Google Docs formats)"`
-	Copy        DriveCopyCmd        `cmd:"" name:"copy" help:"Copy a file"`
-	Upload      DriveUploadCmd      `cmd:"" name:"upload" help:"Upload a file"`
-	Mkdir       DriveMkdirCmd       `cmd:"" name:"mkdir" help:"Create a folder"`
-	Delete      DriveDeleteCmd      `cmd:"" name:"delete" help:"Delete a file (moves to trash)" aliases:"rm,del"`
-	Move        DriveMoveCmd        `cmd:"" name:"move" help:"Move a file to a different folder"`
-	Rename      DriveRenameCmd      `cmd:"" name:"rename" help:"Rename a file or folder"`
-	Share       DriveShareCmd       `cmd:"" name:"share" help:"Share a file or folder"`
-	Unshare     DriveUnshareCmd     `cmd:"" name:"unshare" help:"Remove a permission from a file"`
-	Permissions DrivePermissionsCmd `cmd:"" name:"permissions" help:"List permissions on a file"`
-	URL         DriveURLCmd         `cmd:"" name:"url" help:"Print web URLs for files"`
-	Comments    DriveCommentsCmd    `cmd:"" name:"comments" help:"Manage comments on files"`
-	Drives      DriveDrivesCmd      `cmd:"" name:"drives" help:"List shared drives (Team Drives)"`
+	Ls             DriveLsCmd             `cmd:"" name:"ls" help:"List files in a folder (default: root)"`
+	Search         DriveSearchCmd         `cmd:"" name:"search" help:"Full-text search across Drive"`
+	Get            DriveGetCmd            `cmd:"" name:"get" help:"Get file metadata"`
+	Download       DriveDownloadCmd       `cmd:"" name:"download" help:"Download a file (exports Google Docs formats)"`
+	DownloadFolder DriveDownloadFolderCmd `cmd:"" name:"download-folder" help:"Recursively download a folder (exports Google Docs formats)"`
+	Copy           DriveCopyCmd           `cmd:"" name:"copy" help:"Copy a file"`
+	CopyFolder     DriveCopyFolderCmd     `cmd:"" name:"copy-folder" help:"Recursively copy a folder and its contents"`
+	Upload         DriveUploadCmd         `cmd:"" name:"upload" help:"Upload a file"`
+	Mkdir          DriveMkdirCmd          `cmd:"" name:"mkdir" help:"Create a folder"`
+	Delete         DriveDeleteCmd         `cmd:"" name:"delete" help:"Permanently delete a file (use trash for recoverable deletes)" aliases:"rm,del"`
+	Trash          DriveTrashCmd          `cmd:"" name:"trash" help:"Move a file to the trash"`
+	Restore        DriveRestoreCmd        `cmd:"" name:"restore" aliases:"untrash" help:"Restore a file from the trash"`
+	EmptyTrash     DriveEmptyTrashCmd     `cmd:"" name:"empty-trash" help:"Permanently delete all trashed files"`
+	Star           DriveStarCmd           `cmd:"" name:"star" help:"Star a file"`
+	Unstar         DriveUnstarCmd         `cmd:"" name:"unstar" help:"Remove the star from a file"`
+	Move           DriveMoveCmd           `cmd:"" name:"move" help:"Move a file to a different folder and/or rename it"`
+	Rename         DriveRenameCmd         `cmd:"" name:"rename" help:"Rename a file or folder"`
+	Share          DriveShareCmd          `cmd:"" name:"share" help:"Share a file or folder"`
+	Unshare        DriveUnshareCmd        `cmd:"" name:"unshare" help:"Remove a permission from a file"`
+	Permissions    DrivePermissionsCmd    `cmd:"" name:"permissions" help:"List permissions on a file"`
+	URL            DriveURLCmd            `cmd:"" name:"url" help:"Print web URLs for files"`
+	Comments       DriveCommentsCmd       `cmd:"" name:"comments" help:"Manage comments on files"`
+	Drives         DriveDrivesCmd         `cmd:"" name:"drives" help:"List shared drives (Team Drives)"`
+	About          DriveAboutCmd          `cmd:"" name:"about" help:"Show storage quota and the authenticated user"`
+	Changes        DriveChangesCmd        `cmd:"" name:"changes" help:"List files added, modified, or trashed since a start page token"`
 }
 
 type DriveLsCmd struct {
@@ -66,8 +80,13 @@ type DriveLsCmd struct {
 	Page   string `name:"page" help:"Page token"`
 	Query  string `name:"query" help:"Drive query filter"`
 	Parent string `name:"parent" help:"Folder ID to list (default: root)"`
+	Human  bool   `name:"human" help:"Append a TOTAL row summing file sizes (Google-native files have no size and are skipped); with --plain/--csv the total goes to stderr"`
 }
 
+func (*DriveLsCmd) csvTable()              {}
+func (*DriveLsCmd) allAccounts()           {}
+func (*DriveLsCmd) fieldsResource() string { return "files" }
+
 func (c *DriveLsCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -102,7 +121,7 @@ func (c *DriveLsCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"files":         resp.Files,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -113,88 +132,51 @@ func (c *DriveLsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return nil
 	}
 
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSIZE\tMODIFIED")
-	for _, f := range resp.Files {
-		fmt.Fprintf(
-			w,
-			"%s\t%s\t%s\t%s\t%s\n",
-			f.Id,
-			f.Name,
-			driveType(f.MimeType),
-			formatDriveSize(f.Size),
-			formatDateTime(f.ModifiedTime),
-		)
+	rows := driveFileRows(resp.Files)
+	// The TOTAL row is for people: --plain/--csv rows stay data-only and the
+	// total goes to stderr instead.
+	tableTotal := c.Human && !outfmt.IsPlain(ctx) && !outfmt.IsCSV(ctx)
+	total, counted := driveFilesTotalSize(resp.Files)
+	if tableTotal {
+		rows = append(rows, []string{"TOTAL", fmt.Sprintf("%d files", counted), "", formatDriveSize(total), ""})
 	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
-}
-
-type DriveSearchCmd struct {
-	Query []string `arg:"" name:"query" help:"Search query"`
-	Max   int64    `name:"max" aliases:"limit" help:"Max results" default:"20"`
-	Page  string   `name:"page" help:"Page token"`
-}
-
-func (c *DriveSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
-	account, err := requireAccount(flags)
-	if err != nil {
+	if err := writeTable(ctx, []string{"ID", "NAME", "TYPE", "SIZE", "MODIFIED"}, rows); err != nil {
 		return err
 	}
-	query := strings.TrimSpace(strings.Join(c.Query, " "))
-	if query == "" {
-		return usage("missing query")
-	}
-
-	svc, err := newDriveService(ctx, account)
-	if err != nil {
-		return err
-	}
-
-	resp, err := svc.Files.List().
-		Q(buildDriveSearchQuery(query)).
-		PageSize(c.Max).
-		PageToken(c.Page).
-		OrderBy("modifiedTime desc").
-		SupportsAllDrives(true).
-		IncludeItemsFromAllDrives(true).
-		Fields("nextPageToken, files(id, name, mimeType, size, modifiedTime, parents, webViewLink)").
-		Context(ctx).
-		Do()
-	if err != nil {
-		return err
-	}
-
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"files":         resp.Files,
-			"nextPageToken": resp.NextPageToken,
-		})
+	if c.Human && !tableTotal {
+		u.Err().Printf("Total: %d files, %s", counted, formatDriveSize(total))
 	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
 
-	if len(resp.Files) == 0 {
-		u.Err().Println("No results")
-		return nil
+// driveFilesTotalSize sums the sizes of files that have one; folders and
+// Google-native files (Docs, Sheets, ...) are not counted.
+func driveFilesTotalSize(files []*drive.File) (int64, int) {
+	var total int64
+	counted := 0
+	for _, f := range files {
+		if f == nil || strings.HasPrefix(f.MimeType, "application/vnd.google-apps.") {
+			continue
+		}
+		total += f.Size
+		counted++
 	}
+	return total, counted
+}
 
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "ID\tNAME\tTYPE\tSIZE\tMODIFIED")
-	for _, f := range resp.Files {
-		fmt.Fprintf(
-			w,
-			"%s\t%s\t%s\t%s\t%s\n",
+func driveFileRows(files []*drive.File) [][]string {
+	rows := make([][]string, 0, len(files))
+	for _, f := range files {
+		rows = append(rows, []string{
 			f.Id,
 			f.Name,
 			driveType(f.MimeType),
 			formatDriveSize(f.Size),
 			formatDateTime(f.ModifiedTime),
-		)
+		})
 	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
+	return rows
 }
 
 type DriveGetCmd struct {
@@ -227,7 +209,7 @@ func (c *DriveGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: f})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{strFile: f})
 	}
 
 	u.Out().Printf("id\t%s", f.Id)
@@ -250,6 +232,7 @@ type DriveDownloadCmd struct {
 	FileID string         `arg:"" name:"fileId" help:"File ID"`
 	Output OutputPathFlag `embed:""`
 	Format string         `name:"format" help:"Export format for Google Docs files: pdf|csv|xlsx|pptx|txt|png|docx (default: auto)"`
+	Verify bool           `name:"verify" help:"Compare the downloaded file's MD5 against Drive's md5Checksum (binary files only)"`
 }
 
 func (c *DriveDownloadCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -271,7 +254,7 @@ func (c *DriveDownloadCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	meta, err := svc.Files.Get(fileID).
 		SupportsAllDrives(true).
-		Fields("id, name, mimeType").
+		Fields("id, name, mimeType, md5Checksum, size").
 		Context(ctx).
 		Do()
 	if err != nil {
@@ -291,15 +274,34 @@ func (c *DriveDownloadCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
+	verified := false
+	if c.Verify {
+		if meta.Md5Checksum == "" {
+			u.Err().Println("verify: Drive has no md5Checksum for this file (Google-native export); skipped")
+		} else {
+			if err := verifyDriveMD5(downloadedPath, meta.Md5Checksum); err != nil {
+				return err
+			}
+			verified = true
+		}
+	}
+
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		payload := map[string]any{
 			"path": downloadedPath,
 			"size": size,
-		})
+		}
+		if c.Verify {
+			payload["verified"] = verified
+		}
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 
 	u.Out().Printf("path\t%s", downloadedPath)
 	u.Out().Printf("size\t%s", formatDriveSize(size))
+	if verified {
+		u.Out().Printf("md5\t%s", meta.Md5Checksum)
+	}
 	return nil
 }
 
@@ -316,9 +318,12 @@ func (c *DriveCopyCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type DriveUploadCmd struct {
-	LocalPath string `arg:"" name:"localPath" help:"Path to local file"`
-	Name      string `name:"name" help:"Override filename"`
-	Parent    string `name:"parent" help:"Destination folder ID"`
+	LocalPath  string `arg:"" name:"localPath" help:"Path to local file"`
+	Name       string `name:"name" help:"Override filename"`
+	Parent     string `name:"parent" help:"Destination folder ID"`
+	ParentPath string `name:"parent-path" help:"Destination folder as a path from My Drive (e.g. /Projects/2024)"`
+	Mkdir      bool   `name:"mkdir" help:"Create missing folders in --parent-path"`
+	ChunkSize  string `name:"chunk-size" help:"Resumable upload chunk size; larger files upload in chunks with progress (e.g. 8MB)" default:"${upload_chunk_size}"`
 }
 
 func (c *DriveUploadCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -332,11 +337,24 @@ func (c *DriveUploadCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if localPath == "" {
 		return usage("empty localPath")
 	}
+	parentPath := strings.TrimSpace(c.ParentPath)
+	if parentPath != "" && strings.TrimSpace(c.Parent) != "" {
+		return usage("use either --parent or --parent-path, not both")
+	}
+	if c.Mkdir && parentPath == "" {
+		return usage("--mkdir requires --parent-path")
+	}
 	localPath, err = config.ExpandPath(localPath)
 	if err != nil {
 		return err
 	}
 
+	mimeType := guessMimeType(localPath)
+	mediaOpts, chunkSize, err := uploadChunkOptions(c.ChunkSize, mimeType)
+	if err != nil {
+		return err
+	}
+
 	f, err := os.Open(localPath) //nolint:gosec // user-provided path
 	if err != nil {
 		return err
@@ -355,23 +373,44 @@ func (c *DriveUploadCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	meta := &drive.File{Name: fileName}
 	parent := strings.TrimSpace(c.Parent)
+	var createdFolders []*drive.File
+	if parentPath != "" {
+		folder, made, resolveErr := resolveDriveFolderPath(ctx, svc, "root", parentPath, c.Mkdir)
+		if errors.Is(resolveErr, errDriveFolderNotFound) {
+			return fmt.Errorf("%w (use --mkdir to create it)", resolveErr)
+		}
+		if resolveErr != nil {
+			return resolveErr
+		}
+		parent, createdFolders = folder.Id, made
+		for _, folder := range createdFolders {
+			u.Err().Printf("Created folder %s (%s)", folder.Name, folder.Id)
+		}
+	}
 	if parent != "" {
 		meta.Parents = []string{parent}
 	}
 
-	mimeType := guessMimeType(localPath)
-	created, err := svc.Files.Create(meta).
+	call := svc.Files.Create(meta).
 		SupportsAllDrives(true).
-		Media(f, gapi.ContentType(mimeType)).
+		Media(f, mediaOpts...).
 		Fields("id, name, mimeType, size, webViewLink").
-		Context(ctx).
-		Do()
+		Context(ctx)
+	// Small files go out in a single request; only chunked uploads report progress.
+	if st, statErr := f.Stat(); statErr == nil && st.Size() > int64(chunkSize) {
+		call = call.ProgressUpdater(uploadProgress(u, fileName, st.Size()))
+	}
+	created, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: created})
+		payload := map[string]any{strFile: created}
+		if len(createdFolders) > 0 {
+			payload["createdFolders"] = createdFolders
+		}
+		return outfmt.WriteJSONContext(ctx, os.Stdout, payload)
 	}
 
 	u.Out().Printf("id\t%s", created.Id)
@@ -383,8 +422,10 @@ func (c *DriveUploadCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type DriveMkdirCmd struct {
-	Name   string `arg:"" name:"name" help:"Folder name"`
-	Parent string `name:"parent" help:"Parent folder ID"`
+	Name       string `arg:"" name:"name" help:"Folder name (with -p, a slash-delimited path such as Projects/2024/Q1)"`
+	Parent     string `name:"parent" help:"Parent folder ID"`
+	ParentPath string `name:"parent-path" help:"Parent folder as a path from My Drive (e.g. /Projects)"`
+	Parents    bool   `name:"parents" short:"p" help:"Create intermediate folders as needed and reuse existing ones (like mkdir -p)"`
 }
 
 func (c *DriveMkdirCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -398,37 +439,80 @@ func (c *DriveMkdirCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if name == "" {
 		return usage("empty name")
 	}
+	if c.Parents && len(splitDriveFolderPath(name)) == 0 {
+		return usage("empty path")
+	}
+	parent := strings.TrimSpace(c.Parent)
+	parentPath := strings.TrimSpace(c.ParentPath)
+	if parent != "" && parentPath != "" {
+		return usage("use either --parent or --parent-path, not both")
+	}
 
 	svc, err := newDriveService(ctx, account)
 	if err != nil {
 		return err
 	}
 
-	f := &drive.File{
-		Name:     name,
-		MimeType: "application/vnd.google-apps.folder",
-	}
-	if strings.TrimSpace(c.Parent) != "" {
-		f.Parents = []string{strings.TrimSpace(c.Parent)}
+	var created []*drive.File
+	if parentPath != "" {
+		folder, made, resolveErr := resolveDriveFolderPath(ctx, svc, "root", parentPath, c.Parents)
+		if errors.Is(resolveErr, errDriveFolderNotFound) {
+			return fmt.Errorf("%w (use -p to create it)", resolveErr)
+		}
+		if resolveErr != nil {
+			return resolveErr
+		}
+		parent, created = folder.Id, made
 	}
 
-	created, err := svc.Files.Create(f).
-		SupportsAllDrives(true).
-		Fields("id, name, webViewLink").
-		Context(ctx).
-		Do()
-	if err != nil {
-		return err
+	var folder *drive.File
+	if c.Parents {
+		if parent == "" {
+			parent = "root"
+		}
+		var made []*drive.File
+		if folder, made, err = resolveDriveFolderPath(ctx, svc, parent, name, true); err != nil {
+			return err
+		}
+		created = append(created, made...)
+	} else {
+		f := &drive.File{
+			Name:     name,
+			MimeType: driveMimeFolder,
+		}
+		if parent != "" {
+			f.Parents = []string{parent}
+		}
+		folder, err = svc.Files.Create(f).
+			SupportsAllDrives(true).
+			Fields("id, name, webViewLink").
+			Context(ctx).
+			Do()
+		if err != nil {
+			return err
+		}
+		created = append(created, folder)
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"folder": created})
+		if created == nil {
+			created = []*drive.File{}
+		}
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"folder": folder, "created": created})
 	}
 
-	u.Out().Printf("id\t%s", created.Id)
-	u.Out().Printf("name\t%s", created.Name)
-	if created.WebViewLink != "" {
-		u.Out().Printf("link\t%s", created.WebViewLink)
+	if len(created) == 0 {
+		u.Err().Println("Folder already exists")
+	}
+	for _, f := range created {
+		if f != folder {
+			u.Err().Printf("Created folder %s (%s)", f.Name, f.Id)
+		}
+	}
+	u.Out().Printf("id\t%s", folder.Id)
+	u.Out().Printf("name\t%s", folder.Name)
+	if folder.WebViewLink != "" {
+		u.Out().Printf("link\t%s", folder.WebViewLink)
 	}
 	return nil
 }
@@ -448,7 +532,7 @@ func (c *DriveDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty fileId")
 	}
 
-	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete drive file %s", fileID)); confirmErr != nil {
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("permanently delete drive file %s", fileID)); confirmErr != nil {
 		return confirmErr
 	}
 
@@ -461,7 +545,7 @@ func (c *DriveDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted": true,
 			"id":      fileID,
 		})
@@ -473,7 +557,8 @@ func (c *DriveDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 type DriveMoveCmd struct {
 	FileID string `arg:"" name:"fileId" help:"File ID"`
-	Parent string `name:"parent" help:"New parent folder ID (required)"`
+	Parent string `name:"parent" aliases:"to-folder" help:"Destination folder ID"`
+	Name   string `name:"name" help:"New name (rename while moving, or on its own)"`
 }
 
 func (c *DriveMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -487,8 +572,9 @@ func (c *DriveMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty fileId")
 	}
 	parent := strings.TrimSpace(c.Parent)
-	if parent == "" {
-		return usage("missing --parent")
+	name := strings.TrimSpace(c.Name)
+	if parent == "" && name == "" {
+		return usage("missing --parent (--to-folder) or --name")
 	}
 
 	svc, err := newDriveService(ctx, account)
@@ -496,21 +582,28 @@ func (c *DriveMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	meta, err := svc.Files.Get(fileID).
+	call := svc.Files.Update(fileID, &drive.File{Name: name}).
 		SupportsAllDrives(true).
-		Fields("id, name, parents").
-		Context(ctx).
-		Do()
-	if err != nil {
-		return err
-	}
-
-	call := svc.Files.Update(fileID, &drive.File{}).
-		SupportsAllDrives(true).
-		AddParents(parent).
 		Fields("id, name, parents, webViewLink")
-	if len(meta.Parents) > 0 {
-		call = call.RemoveParents(strings.Join(meta.Parents, ","))
+	if parent != "" {
+		meta, getErr := svc.Files.Get(fileID).
+			SupportsAllDrives(true).
+			Fields("id, name, parents").
+			Context(ctx).
+			Do()
+		if getErr != nil {
+			return getErr
+		}
+		call = call.AddParents(parent)
+		remove := make([]string, 0, len(meta.Parents))
+		for _, p := range meta.Parents {
+			if p != parent {
+				remove = append(remove, p)
+			}
+		}
+		if len(remove) > 0 {
+			call = call.RemoveParents(strings.Join(remove, ","))
+		}
 	}
 
 	updated, err := call.Context(ctx).Do()
@@ -519,11 +612,12 @@ func (c *DriveMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{strFile: updated})
 	}
 
 	u.Out().Printf("id\t%s", updated.Id)
 	u.Out().Printf("name\t%s", updated.Name)
+	u.Out().Printf("parents\t%s", strings.Join(updated.Parents, ","))
 	return nil
 }
 
@@ -562,7 +656,7 @@ func (c *DriveRenameCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{strFile: updated})
 	}
 
 	u.Out().Printf("id\t%s", updated.Id)
@@ -630,7 +724,7 @@ func (c *DriveShareCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"link":         link,
 			"permissionId": created.Id,
 			"permission":   created,
@@ -676,7 +770,7 @@ func (c *DriveUnshareCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"removed":      true,
 			"fileId":       fileID,
 			"permissionId": permissionID,
@@ -689,70 +783,6 @@ func (c *DriveUnshareCmd) Run(ctx context.Context, flags *RootFlags) error {
 	return nil
 }
 
-type DrivePermissionsCmd struct {
-	FileID string `arg:"" name:"fileId" help:"File ID"`
-	Max    int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
-	Page   string `name:"page" help:"Page token"`
-}
-
-func (c *DrivePermissionsCmd) Run(ctx context.Context, flags *RootFlags) error {
-	u := ui.FromContext(ctx)
-	account, err := requireAccount(flags)
-	if err != nil {
-		return err
-	}
-	fileID := strings.TrimSpace(c.FileID)
-	if fileID == "" {
-		return usage("empty fileId")
-	}
-
-	svc, err := newDriveService(ctx, account)
-	if err != nil {
-		return err
-	}
-
-	call := svc.Permissions.List(fileID).
-		SupportsAllDrives(true).
-		Fields("nextPageToken, permissions(id, type, role, emailAddress)").
-		Context(ctx)
-	if c.Max > 0 {
-		call = call.PageSize(c.Max)
-	}
-	if strings.TrimSpace(c.Page) != "" {
-		call = call.PageToken(c.Page)
-	}
-
-	resp, err := call.Do()
-	if err != nil {
-		return err
-	}
-	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"fileId":          fileID,
-			"permissions":     resp.Permissions,
-			"permissionCount": len(resp.Permissions),
-			"nextPageToken":   resp.NextPageToken,
-		})
-	}
-	if len(resp.Permissions) == 0 {
-		u.Err().Println("No permissions")
-		return nil
-	}
-
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "ID\tTYPE\tROLE\tEMAIL")
-	for _, p := range resp.Permissions {
-		email := p.EmailAddress
-		if email == "" {
-			email = "-"
-		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Id, p.Type, p.Role, email)
-	}
-	printNextPageHint(u, resp.NextPageToken)
-	return nil
-}
-
 type DriveURLCmd struct {
 	FileIDs []string `arg:"" name:"fileId" help:"File IDs"`
 }
@@ -789,7 +819,7 @@ func (c *DriveURLCmd) Run(ctx context.Context, flags *RootFlags) error {
 			}
 			urls = append(urls, map[string]string{"id": id, "url": link})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"urls": urls})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"urls": urls})
 	}
 	return nil
 }
@@ -808,11 +838,6 @@ func buildDriveListQuery(folderID string, userQuery string) string {
 	return q
 }
 
-func buildDriveSearchQuery(text string) string {
-	q := fmt.Sprintf("fullText contains '%s'", escapeDriveQueryString(text))
-	return q + " and trashed = false"
-}
-
 func escapeDriveQueryString(s string) string {
 	// Escape backslashes first, then single quotes
 	s = strings.ReplaceAll(s, "\\", "\\\\")
@@ -821,7 +846,7 @@ func escapeDriveQueryString(s string) string {
 }
 
 func driveType(mimeType string) string {
-	if mimeType == "application/vnd.google-apps.folder" {
+	if mimeType == driveMimeFolder {
 		return "folder"
 	}
 	return strFile
@@ -928,24 +953,29 @@ func downloadDriveFile(ctx context.Context, svc *drive.Service, meta *drive.File
 	if err != nil {
 		return "", 0, err
 	}
+	n, err := writeDownloadResponse(resp, outPath)
+	if err != nil {
+		return "", 0, err
+	}
+	return outPath, n, nil
+}
+
+// writeDownloadResponse saves a successful download to outPath and closes the body.
+func writeDownloadResponse(resp *http.Response, outPath string) (int64, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return "", 0, fmt.Errorf("download failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+		return 0, fmt.Errorf("download failed: %s: %s", resp.Status, strings.TrimSpace(string(body)))
 	}
 
 	f, err := os.Create(outPath) //nolint:gosec // user-provided path
 	if err != nil {
-		return "", 0, err
+		return 0, err
 	}
 	defer f.Close()
 
-	n, err := io.Copy(f, resp.Body)
-	if err != nil {
-		return "", 0, err
-	}
-	return outPath, n, nil
+	return io.Copy(f, resp.Body)
 }
 
 var driveDownload = func(ctx context.Context, svc *drive.Service, fileID string) (*http.Response, error) {
@@ -1000,10 +1030,12 @@ func driveExportMimeTypeForFormat(googleMimeType string, format string) (string,
 			return mimePDF, nil
 		case "csv":
 			return mimeCSV, nil
+		case "tsv":
+			return mimeTSV, nil
 		case "xlsx":
 			return mimeXlsx, nil
 		default:
-			return "", fmt.Errorf("invalid --format %q for Google Sheet (use pdf|csv|xlsx)", format)
+			return "", fmt.Errorf("invalid --format %q for Google Sheet (use pdf|csv|tsv|xlsx)", format)
 		}
 	case driveMimeGoogleSlides:
 		switch format {
@@ -1037,6 +1069,8 @@ func driveExportExtension(mimeType string) string {
 		return extPDF
 	case mimeCSV:
 		return extCSV
+	case mimeTSV:
+		return extTSV
 	case mimeXlsx:
 		return extXlsx
 	case mimeDocx:
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DriveAboutCmd struct{}
+
+func (c *DriveAboutCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	about, err := svc.About.Get().Fields("storageQuota,user").Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, about)
+	}
+
+	if about.User != nil {
+		u.Out().Printf("user\t%s", about.User.DisplayName)
+		u.Out().Printf("email\t%s", about.User.EmailAddress)
+	}
+	if q := about.StorageQuota; q != nil {
+		limit := "unlimited"
+		if q.Limit > 0 {
+			limit = formatDriveSize(q.Limit)
+		}
+		u.Out().Printf("limit\t%s", limit)
+		u.Out().Printf("usage\t%s", formatDriveSize(q.Usage))
+		u.Out().Printf("usage_in_drive\t%s", formatDriveSize(q.UsageInDrive))
+		u.Out().Printf("usage_in_trash\t%s", formatDriveSize(q.UsageInDriveTrash))
+		if q.Limit > 0 {
+			u.Out().Printf("used_percent\t%.1f%%", float64(q.Usage)*100/float64(q.Limit))
+		}
+	}
+	return nil
+}
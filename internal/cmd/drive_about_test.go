@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDriveAboutCmd(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/about") {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.URL.Query().Get("fields"); got != "storageQuota,user" {
+			http.Error(w, "unexpected fields "+got, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"user": map[string]any{"displayName": "Ada", "emailAddress": "a@b.com"},
+			"storageQuota": map[string]any{
+				"limit":             "16106127360",
+				"usage":             "13207024435",
+				"usageInDrive":      "12884901888",
+				"usageInDriveTrash": "1048576",
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		u, uiErr := ui.New(ui.Options{Stdout: os.Stdout, Stderr: io.Discard, Color: "never"})
+		if uiErr != nil {
+			t.Fatalf("ui.New: %v", uiErr)
+		}
+		ctx := ui.WithUI(context.Background(), u)
+		if execErr := runKong(t, &DriveAboutCmd{}, []string{}, ctx, flags); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+	for _, want := range []string{"Ada", "a@b.com", "15.0 GB", "12.3 GB", "1.0 MB", "82.0%"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %q in output: %q", want, out)
+		}
+	}
+
+	jsonOut := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveAboutCmd{}, []string{}, outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true}), flags); execErr != nil {
+			t.Fatalf("execute json: %v", execErr)
+		}
+	})
+	var about drive.About
+	if err := json.Unmarshal([]byte(jsonOut), &about); err != nil {
+		t.Fatalf("json parse: %v\n%s", err, jsonOut)
+	}
+	if about.User == nil || about.User.EmailAddress != "a@b.com" || about.StorageQuota == nil || about.StorageQuota.Limit != 16106127360 {
+		t.Fatalf("unexpected about: %q", jsonOut)
+	}
+}
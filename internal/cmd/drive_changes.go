@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DriveChangesCmd struct {
+	Token string `name:"token" help:"Start page token from a previous run (omit to record the current position)"`
+	Drive string `name:"drive" help:"Only changes in this shared drive ID"`
+	Max   int64  `name:"max" aliases:"limit" help:"Changes per API page" default:"100"`
+}
+
+func (c *DriveChangesCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.Max < 1 || c.Max > 1000 {
+		return usage("--max must be between 1 and 1000")
+	}
+	token := strings.TrimSpace(c.Token)
+	driveID := strings.TrimSpace(c.Drive)
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	// Without a token there is nothing to diff against yet: hand back the
+	// current position so the next run only sees what changes after it.
+	if token == "" {
+		call := svc.Changes.GetStartPageToken().SupportsAllDrives(true).Context(ctx)
+		if driveID != "" {
+			call = call.DriveId(driveID)
+		}
+		start, startErr := call.Do()
+		if startErr != nil {
+			return startErr
+		}
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+				"changes":           []*drive.Change{},
+				"newStartPageToken": start.StartPageToken,
+			})
+		}
+		u.Err().Println("No --token given; recorded the current position")
+		printDriveChangesTokenHint(u, start.StartPageToken)
+		return nil
+	}
+
+	newStartPageToken := ""
+	changes, err := collectAllPages(ctx, token, func(pageToken string) ([]*drive.Change, string, error) {
+		call := svc.Changes.List(pageToken).
+			PageSize(c.Max).
+			IncludeRemoved(true).
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Fields("nextPageToken, newStartPageToken, changes(changeType, time, removed, fileId, driveId, file(id, name, mimeType, trashed, createdTime, modifiedTime), drive(id, name))").
+			Context(ctx)
+		if driveID != "" {
+			call = call.DriveId(driveID)
+		}
+		resp, listErr := call.Do()
+		if listErr != nil {
+			return nil, "", listErr
+		}
+		if resp.NewStartPageToken != "" {
+			newStartPageToken = resp.NewStartPageToken
+		}
+		return resp.Changes, resp.NextPageToken, nil
+	})
+	if err != nil {
+		return err
+	}
+	if changes == nil {
+		changes = []*drive.Change{}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"changes":           changes,
+			"newStartPageToken": newStartPageToken,
+		})
+	}
+
+	if len(changes) == 0 {
+		u.Err().Println("No changes")
+		printDriveChangesTokenHint(u, newStartPageToken)
+		return nil
+	}
+
+	rows := make([][]string, 0, len(changes))
+	for _, ch := range changes {
+		id, name, mime := ch.FileId, "", ""
+		if ch.File != nil {
+			name, mime = ch.File.Name, ch.File.MimeType
+		}
+		if ch.ChangeType == "drive" {
+			id = ch.DriveId
+			if ch.Drive != nil {
+				name = ch.Drive.Name
+			}
+		}
+		rows = append(rows, []string{formatDateTime(ch.Time), driveChangeAction(ch), id, name, mime})
+	}
+	if err := writeTable(ctx, []string{"TIME", "ACTION", "ID", "NAME", "MIME"}, rows); err != nil {
+		return err
+	}
+	printDriveChangesTokenHint(u, newStartPageToken)
+	return nil
+}
+
+// driveChangeAction summarizes a change. The Changes API does not flag new
+// files, so a file whose created and modified times match is reported as
+// added.
+func driveChangeAction(ch *drive.Change) string {
+	switch {
+	case ch.Removed:
+		return "removed"
+	case ch.ChangeType == "drive":
+		return "drive"
+	case ch.File == nil:
+		return "modified"
+	case ch.File.Trashed:
+		return "trashed"
+	case ch.File.CreatedTime != "" && ch.File.CreatedTime == ch.File.ModifiedTime:
+		return "added"
+	default:
+		return "modified"
+	}
+}
+
+func printDriveChangesTokenHint(u *ui.UI, token string) {
+	if u == nil || token == "" {
+		return
+	}
+	u.Err().Printf("# Next run: --token %s", token)
+}
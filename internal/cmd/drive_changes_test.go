@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDriveChangesCmd(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	var listTokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/changes/startPageToken"):
+			if r.URL.Query().Get("driveId") != "d1" {
+				http.Error(w, "missing driveId", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"startPageToken": "100"})
+		case strings.HasSuffix(r.URL.Path, "/changes"):
+			if r.URL.Query().Get("includeItemsFromAllDrives") != "true" || r.URL.Query().Get("supportsAllDrives") != "true" {
+				http.Error(w, "missing shared drive flags", http.StatusBadRequest)
+				return
+			}
+			token := r.URL.Query().Get("pageToken")
+			listTokens = append(listTokens, token)
+			if token == "42" {
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"changes": []map[string]any{
+						{"changeType": "file", "fileId": "f1", "time": "2026-03-01T10:00:00Z", "file": map[string]any{"id": "f1", "name": "New", "mimeType": "text/plain", "createdTime": "2026-03-01T10:00:00Z", "modifiedTime": "2026-03-01T10:00:00Z"}},
+						{"changeType": "file", "fileId": "f2", "time": "2026-03-01T11:00:00Z", "file": map[string]any{"id": "f2", "name": "Edited", "createdTime": "2025-01-01T00:00:00Z", "modifiedTime": "2026-03-01T11:00:00Z"}},
+					},
+					"nextPageToken": "43",
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"changes": []map[string]any{
+					{"changeType": "file", "fileId": "f3", "time": "2026-03-01T12:00:00Z", "file": map[string]any{"id": "f3", "name": "Old", "trashed": true}},
+					{"changeType": "file", "fileId": "f4", "time": "2026-03-01T13:00:00Z", "removed": true},
+				},
+				"newStartPageToken": "44",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	flags := &RootFlags{Account: "a@b.com"}
+
+	var errBuf bytes.Buffer
+	u, uiErr := ui.New(ui.Options{Stdout: os.Stdout, Stderr: &errBuf, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	textOut := captureStdout(t, func() {
+		if err := runKong(t, &DriveChangesCmd{}, []string{"--token", "42"}, ctx, flags); err != nil {
+			t.Fatalf("changes: %v", err)
+		}
+	})
+	normalized := strings.Join(strings.Fields(textOut), " ")
+	for _, want := range []string{"added f1 New", "modified f2 Edited", "trashed f3 Old", "removed f4"} {
+		if !strings.Contains(normalized, want) {
+			t.Fatalf("missing %q in output: %q", want, textOut)
+		}
+	}
+	if !strings.Contains(errBuf.String(), "--token 44") {
+		t.Fatalf("missing next token hint: %q", errBuf.String())
+	}
+	if strings.Join(listTokens, ",") != "42,43" {
+		t.Fatalf("unexpected page tokens: %v", listTokens)
+	}
+
+	jsonCtx := outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+	jsonOut := captureStdout(t, func() {
+		if err := runKong(t, &DriveChangesCmd{}, []string{"--drive", "d1"}, jsonCtx, flags); err != nil {
+			t.Fatalf("changes start: %v", err)
+		}
+	})
+	var parsed struct {
+		Changes           []any  `json:"changes"`
+		NewStartPageToken string `json:"newStartPageToken"`
+	}
+	if err := json.Unmarshal([]byte(jsonOut), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, jsonOut)
+	}
+	if parsed.NewStartPageToken != "100" || parsed.Changes == nil || len(parsed.Changes) != 0 {
+		t.Fatalf("unexpected start output: %#v", parsed)
+	}
+
+	u2, _ := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err := runKong(t, &DriveChangesCmd{}, []string{"--token", "42", "--max", "0"}, ui.WithUI(context.Background(), u2), flags); err == nil {
+		t.Fatalf("expected usage error for --max 0")
+	}
+}
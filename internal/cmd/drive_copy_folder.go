@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DriveCopyFolderCmd struct {
+	FolderID    string `arg:"" name:"folderId" help:"Folder ID to copy"`
+	Parent      string `name:"parent" help:"Destination folder ID (default: the source folder's parent)"`
+	Name        string `name:"name" help:"Name for the new folder (default: the source folder's name)"`
+	Concurrency int    `name:"concurrency" help:"Parallel file copies" default:"4"`
+}
+
+type driveCopyFolderNode struct {
+	ID       string
+	Name     string
+	ParentID string
+}
+
+type driveCopyFolderTree struct {
+	Folders []driveCopyFolderNode // parents before children
+	Files   []driveCopyFolderNode
+}
+
+func (c *DriveCopyFolderCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	folderID := strings.TrimSpace(c.FolderID)
+	if folderID == "" {
+		return usage("empty folderId")
+	}
+	if c.Concurrency < 1 {
+		return usage("--concurrency must be >= 1")
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	meta, err := svc.Files.Get(folderID).
+		SupportsAllDrives(true).
+		Fields("id, name, mimeType, parents").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if meta.MimeType != driveMimeFolder {
+		return usagef("%s is not a folder (mimeType %q); use drive copy", folderID, meta.MimeType)
+	}
+
+	parent := strings.TrimSpace(c.Parent)
+	if parent == "" && len(meta.Parents) > 0 {
+		parent = meta.Parents[0]
+	}
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		name = meta.Name
+	}
+
+	// Snapshot the whole tree before creating anything, so copying into a
+	// folder inside the source never picks up its own copies.
+	tree, err := walkDriveCopyFolderTree(ctx, svc, folderID, u.Progress("scan", 0))
+	if err != nil {
+		return err
+	}
+
+	root := &drive.File{Name: name, MimeType: driveMimeFolder}
+	if parent != "" {
+		root.Parents = []string{parent}
+	}
+	created, err := svc.Files.Create(root).
+		SupportsAllDrives(true).
+		Fields("id, name, webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return fmt.Errorf("create folder %q: %w", name, err)
+	}
+
+	newIDs := map[string]string{folderID: created.Id}
+	for _, f := range tree.Folders {
+		sub, err := svc.Files.Create(&drive.File{
+			Name:     f.Name,
+			MimeType: driveMimeFolder,
+			Parents:  []string{newIDs[f.ParentID]},
+		}).
+			SupportsAllDrives(true).
+			Fields("id").
+			Context(ctx).
+			Do()
+		if err != nil {
+			return fmt.Errorf("create folder %q: %w", f.Name, err)
+		}
+		newIDs[f.ID] = sub.Id
+	}
+
+	errs := copyDriveFolderFiles(ctx, svc, tree.Files, newIDs, c.Concurrency, u.Progress("copy", len(tree.Files)))
+	var failures []map[string]string
+	for i, e := range errs {
+		if e != nil {
+			failures = append(failures, map[string]string{"id": tree.Files[i].ID, "name": tree.Files[i].Name, "error": e.Error()})
+			u.Err().Error(fmt.Sprintf("copy %s (%s): %v", tree.Files[i].Name, tree.Files[i].ID, e))
+		}
+	}
+	failed := len(failures)
+	copiedFiles := len(tree.Files) - failed
+	items := 1 + len(tree.Folders) + copiedFiles
+
+	if outfmt.IsJSON(ctx) {
+		// Failures are part of the one result document; the error returned
+		// below only sets the exit code.
+		result := map[string]any{
+			"source":   folderID,
+			"folderId": created.Id,
+			"name":     created.Name,
+			"folders":  1 + len(tree.Folders),
+			"files":    copiedFiles,
+			"failed":   failed,
+			"items":    items,
+		}
+		if failed > 0 {
+			result["errors"] = failures
+		}
+		if err := outfmt.WriteJSONContext(ctx, os.Stdout, result); err != nil {
+			return err
+		}
+	} else {
+		u.Out().Printf("id\t%s", created.Id)
+		u.Out().Printf("name\t%s", created.Name)
+		u.Out().Printf("folders\t%d", 1+len(tree.Folders))
+		u.Out().Printf("files\t%d", copiedFiles)
+		if created.WebViewLink != "" {
+			u.Out().Printf("link\t%s", created.WebViewLink)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d file(s) failed to copy", failed)
+	}
+	return nil
+}
+
+// walkDriveCopyFolderTree lists the tree under folderID breadth-first. Folders
+// reachable through more than one parent are visited once.
+func walkDriveCopyFolderTree(ctx context.Context, svc *drive.Service, folderID string, progress *ui.Progress) (*driveCopyFolderTree, error) {
+	defer progress.Done()
+	tree := &driveCopyFolderTree{}
+	queue := []string{folderID}
+	seen := map[string]bool{folderID: true}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		pageToken := ""
+		for {
+			resp, err := svc.Files.List().
+				Q(buildDriveListQuery(cur, "")).
+				PageSize(1000).
+				PageToken(pageToken).
+				OrderBy("name").
+				SupportsAllDrives(true).
+				IncludeItemsFromAllDrives(true).
+				Fields("nextPageToken, files(id, name, mimeType)").
+				Context(ctx).
+				Do()
+			if err != nil {
+				return nil, fmt.Errorf("list folder %s: %w", cur, err)
+			}
+
+			for _, f := range resp.Files {
+				node := driveCopyFolderNode{ID: f.Id, Name: f.Name, ParentID: cur}
+				if f.MimeType != driveMimeFolder {
+					tree.Files = append(tree.Files, node)
+					progress.Add(1)
+					continue
+				}
+				if seen[f.Id] {
+					continue
+				}
+				seen[f.Id] = true
+				tree.Folders = append(tree.Folders, node)
+				queue = append(queue, f.Id)
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return tree, nil
+}
+
+// copyDriveFolderFiles copies files into their new parent folders and returns
+// one error slot per file.
+func copyDriveFolderFiles(ctx context.Context, svc *drive.Service, files []driveCopyFolderNode, newIDs map[string]string, concurrency int, progress *ui.Progress) []error {
+	defer progress.Done()
+	errs := make([]error, len(files))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, f := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, f driveCopyFolderNode) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer progress.Add(1)
+			_, errs[i] = svc.Files.Copy(f.ID, &drive.File{
+				Name:    f.Name,
+				Parents: []string{newIDs[f.ParentID]},
+			}).
+				SupportsAllDrives(true).
+				Fields("id").
+				Context(ctx).
+				Do()
+		}(i, f)
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+func TestDriveCopyFolderCmd(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	// src contains doc1 and sub; sub contains bin1 and a link back to src.
+	children := map[string][]map[string]any{
+		"src": {
+			{"id": "doc1", "name": "Doc", "mimeType": driveMimeGoogleDoc},
+			{"id": "sub", "name": "Sub", "mimeType": driveMimeFolder},
+		},
+		"sub": {
+			{"id": "bin1", "name": "a.bin", "mimeType": "application/octet-stream"},
+			{"id": "src", "name": "Loop", "mimeType": driveMimeFolder},
+		},
+	}
+
+	var mu sync.Mutex
+	var created []string
+	failCopy := ""
+	copies := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && path == "/files/src":
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "src", "name": "Source", "mimeType": driveMimeFolder, "parents": []string{"home"}})
+		case r.Method == http.MethodGet && path == "/files":
+			q := r.URL.Query().Get("q")
+			for id, files := range children {
+				if strings.Contains(q, "'"+id+"' in parents") {
+					_ = json.NewEncoder(w).Encode(map[string]any{"files": files})
+					return
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"files": []any{}})
+		case r.Method == http.MethodPost && path == "/files":
+			var f drive.File
+			_ = json.NewDecoder(r.Body).Decode(&f)
+			id := fmt.Sprintf("new%d", len(created)+1)
+			created = append(created, f.Name+"@"+strings.Join(f.Parents, ","))
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "name": f.Name})
+		case r.Method == http.MethodPost && strings.HasSuffix(path, "/copy"):
+			var f drive.File
+			_ = json.NewDecoder(r.Body).Decode(&f)
+			src := strings.TrimSuffix(strings.TrimPrefix(path, "/files/"), "/copy")
+			if src == failCopy {
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": map[string]any{"code": 403, "message": "cannot copy"}})
+				return
+			}
+			copies[src] = f.Name + "@" + strings.Join(f.Parents, ",")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "copy-" + src})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "drive", "copy-folder", "src", "--parent", "dest", "--name", "Backup"}); err != nil {
+			t.Fatalf("copy-folder: %v", err)
+		}
+	})
+
+	if strings.Join(created, "|") != "Backup@dest|Sub@new1" {
+		t.Fatalf("unexpected folders created: %v", created)
+	}
+	if copies["doc1"] != "Doc@new1" || copies["bin1"] != "a.bin@new2" || len(copies) != 2 {
+		t.Fatalf("unexpected copies: %v", copies)
+	}
+
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if parsed["folderId"] != "new1" || parsed["items"] != float64(4) || parsed["files"] != float64(2) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	// A failed file copy is reported inside the single result document, even
+	// with --json-errors.
+	failCopy = "bin1"
+	var execErr error
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"--json", "--json-errors", "--account", "a@b.com", "drive", "copy-folder", "src", "--parent", "dest"})
+		})
+	})
+	if ExitCode(execErr) != 1 {
+		t.Fatalf("expected exit code 1, got %v", execErr)
+	}
+	dec := json.NewDecoder(strings.NewReader(out))
+	var partial struct {
+		Failed int                 `json:"failed"`
+		Errors []map[string]string `json:"errors"`
+	}
+	if err := dec.Decode(&partial); err != nil || dec.More() {
+		t.Fatalf("expected one JSON document (err=%v): %s", err, out)
+	}
+	if partial.Failed != 1 || len(partial.Errors) != 1 || partial.Errors[0]["id"] != "bin1" || !strings.Contains(partial.Errors[0]["error"], "cannot copy") {
+		t.Fatalf("unexpected partial result: %s", out)
+	}
+}
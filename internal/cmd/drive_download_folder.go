@@ -0,0 +1,344 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+const (
+	driveFolderStatusDownloaded  = "downloaded"
+	driveFolderStatusSkipped     = "skipped"
+	driveFolderStatusUnsupported = "unsupported"
+	driveFolderStatusFailed      = "failed"
+)
+
+type DriveDownloadFolderCmd struct {
+	FolderID    string         `arg:"" name:"folderId" help:"Folder ID"`
+	Output      OutputPathFlag `embed:""`
+	Format      string         `name:"format" help:"Export format for Google Docs files, applied where valid: pdf|csv|xlsx|pptx|txt|png|docx (default: auto)"`
+	Concurrency int            `name:"concurrency" help:"Parallel downloads" default:"4"`
+	Overwrite   bool           `name:"overwrite" help:"Re-download files even if an up-to-date local copy exists"`
+}
+
+type driveFolderEntry struct {
+	File   *drive.File
+	Path   string
+	Format string // export format for Google Docs files ("" = default)
+	Status string
+	Size   int64
+	Err    error
+}
+
+func (c *DriveDownloadFolderCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	folderID := strings.TrimSpace(c.FolderID)
+	if folderID == "" {
+		return usage("empty folderId")
+	}
+	if c.Concurrency < 1 {
+		return usage("--concurrency must be >= 1")
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	meta, err := svc.Files.Get(folderID).
+		SupportsAllDrives(true).
+		Fields("id, name, mimeType").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if meta.MimeType != driveMimeFolder {
+		return usagef("%s is not a folder (mimeType %q); use drive download", folderID, meta.MimeType)
+	}
+
+	root, err := resolveDriveFolderRoot(meta, c.Output.Path)
+	if err != nil {
+		return err
+	}
+
+	entries, err := walkDriveFolder(ctx, svc, folderID, root, c.Format, u.Progress("scan", 0))
+	if err != nil {
+		return err
+	}
+
+	downloadDriveFolderEntries(ctx, svc, entries, c.Concurrency, c.Overwrite, u.Progress("download", len(entries)))
+
+	var downloaded, skipped, failed int
+	var total int64
+	for _, e := range entries {
+		switch e.Status {
+		case driveFolderStatusDownloaded:
+			downloaded++
+			total += e.Size
+		case driveFolderStatusSkipped, driveFolderStatusUnsupported:
+			skipped++
+		case driveFolderStatusFailed:
+			failed++
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		files := make([]map[string]any, 0, len(entries))
+		for _, e := range entries {
+			item := map[string]any{
+				"id":     e.File.Id,
+				"name":   e.File.Name,
+				"path":   e.Path,
+				"status": e.Status,
+				"size":   e.Size,
+			}
+			if e.Err != nil {
+				item["error"] = e.Err.Error()
+			}
+			files = append(files, item)
+		}
+		if err := outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"folder":     folderID,
+			"path":       root,
+			"files":      files,
+			"downloaded": downloaded,
+			"skipped":    skipped,
+			"failed":     failed,
+			"bytes":      total,
+		}); err != nil {
+			return err
+		}
+	} else {
+		rows := make([][]string, 0, len(entries))
+		for _, e := range entries {
+			status := e.Status
+			if e.Err != nil {
+				status = fmt.Sprintf("%s: %v", e.Status, e.Err)
+			}
+			rows = append(rows, []string{status, formatDriveSize(e.Size), e.Path})
+		}
+		if len(rows) == 0 {
+			u.Err().Println("No files")
+		} else if err := writeTable(ctx, []string{"STATUS", "SIZE", "PATH"}, rows); err != nil {
+			return err
+		}
+		u.Err().Printf("%d downloaded (%s), %d skipped, %d failed -> %s", downloaded, formatDriveSize(total), skipped, failed, root)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d file(s) failed to download", failed)
+	}
+	return nil
+}
+
+func resolveDriveFolderRoot(meta *drive.File, outPathFlag string) (string, error) {
+	base := strings.TrimSpace(outPathFlag)
+	if base != "" {
+		expanded, err := config.ExpandPath(base)
+		if err != nil {
+			return "", err
+		}
+		base = expanded
+	} else {
+		dir, err := config.EnsureDriveDownloadsDir()
+		if err != nil {
+			return "", err
+		}
+		base = dir
+	}
+	return filepath.Join(base, safeDriveName(meta.Name, meta.Id)), nil
+}
+
+// walkDriveFolder lists the folder tree breadth-first and returns one entry per
+// non-folder file, with its final local path (export extension included) under root.
+func walkDriveFolder(ctx context.Context, svc *drive.Service, folderID string, root string, format string, progress *ui.Progress) ([]*driveFolderEntry, error) {
+	defer progress.Done()
+	type pending struct {
+		id  string
+		dir string
+	}
+
+	var entries []*driveFolderEntry
+	queue := []pending{{id: folderID, dir: root}}
+	seen := map[string]bool{folderID: true}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		used := map[string]bool{}
+		pageToken := ""
+		for {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			resp, err := svc.Files.List().
+				Q(buildDriveListQuery(cur.id, "")).
+				PageSize(1000).
+				PageToken(pageToken).
+				OrderBy("name").
+				SupportsAllDrives(true).
+				IncludeItemsFromAllDrives(true).
+				Fields("nextPageToken, files(id, name, mimeType, size, modifiedTime)").
+				Context(ctx).
+				Do()
+			if err != nil {
+				return nil, fmt.Errorf("list folder %s: %w", cur.id, err)
+			}
+
+			for _, f := range resp.Files {
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+				name := safeDriveName(f.Name, f.Id)
+				if f.MimeType == driveMimeFolder {
+					// Drive allows duplicate names in one folder; keep both copies.
+					if used[name] {
+						name = fmt.Sprintf("%s_%s", name, f.Id)
+					}
+					used[name] = true
+					if !seen[f.Id] {
+						seen[f.Id] = true
+						queue = append(queue, pending{id: f.Id, dir: filepath.Join(cur.dir, name)})
+					}
+					continue
+				}
+
+				entry := &driveFolderEntry{File: f}
+				if driveFolderExportable(f.MimeType) {
+					// Exports get their extension now so "Report" and
+					// "Report.pdf" cannot both resolve to the same file.
+					entry.Format = driveFolderExportFormat(f.MimeType, format)
+					if mimeType, err := driveExportMimeTypeForFormat(f.MimeType, entry.Format); err == nil {
+						name = replaceExt(name, driveExportExtension(mimeType))
+					}
+				}
+				if used[name] {
+					ext := filepath.Ext(name)
+					name = fmt.Sprintf("%s_%s%s", strings.TrimSuffix(name, ext), f.Id, ext)
+				}
+				used[name] = true
+
+				entry.Path = filepath.Join(cur.dir, name)
+				entries = append(entries, entry)
+				progress.Add(1)
+			}
+
+			if resp.NextPageToken == "" {
+				break
+			}
+			pageToken = resp.NextPageToken
+		}
+	}
+	return entries, nil
+}
+
+func driveFolderExportable(mimeType string) bool {
+	switch mimeType {
+	case driveMimeGoogleDoc, driveMimeGoogleSheet, driveMimeGoogleSlides, driveMimeGoogleDrawing:
+		return true
+	default:
+		return false
+	}
+}
+
+// driveFolderExportFormat applies --format only to the Google types that
+// support it, falling back to the type's default export otherwise.
+func driveFolderExportFormat(mimeType string, format string) string {
+	if _, err := driveExportMimeTypeForFormat(mimeType, format); err != nil {
+		return ""
+	}
+	return format
+}
+
+func downloadDriveFolderEntries(ctx context.Context, svc *drive.Service, entries []*driveFolderEntry, concurrency int, overwrite bool, progress *ui.Progress) {
+	defer progress.Done()
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, e := range entries {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(e *driveFolderEntry) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer progress.Add(1)
+			downloadDriveFolderEntry(ctx, svc, e, overwrite)
+		}(e)
+	}
+	wg.Wait()
+}
+
+func downloadDriveFolderEntry(ctx context.Context, svc *drive.Service, e *driveFolderEntry, overwrite bool) {
+	f := e.File
+	isGoogleDoc := strings.HasPrefix(f.MimeType, "application/vnd.google-apps.")
+	if isGoogleDoc && !driveFolderExportable(f.MimeType) {
+		e.Status = driveFolderStatusUnsupported
+		return
+	}
+
+	modified, _ := time.Parse(time.RFC3339, f.ModifiedTime)
+	if !overwrite && driveLocalCopyCurrent(e.Path, f.Size, isGoogleDoc, modified) {
+		e.Status = driveFolderStatusSkipped
+		if st, err := os.Stat(e.Path); err == nil {
+			e.Size = st.Size()
+		}
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(e.Path), 0o755); err != nil { //nolint:gosec // user-provided path
+		e.Status, e.Err = driveFolderStatusFailed, err
+		return
+	}
+
+	outPath, n, err := downloadDriveFile(ctx, svc, f, e.Path, e.Format)
+	if err != nil {
+		e.Status, e.Err = driveFolderStatusFailed, err
+		return
+	}
+	e.Path, e.Size, e.Status = outPath, n, driveFolderStatusDownloaded
+
+	if !modified.IsZero() {
+		_ = os.Chtimes(outPath, modified, modified)
+	}
+}
+
+// driveLocalCopyCurrent reports whether path already holds an up-to-date copy:
+// same size (binary files only; exports have no remote size) and a local mtime
+// no older than the remote modification time.
+func driveLocalCopyCurrent(path string, remoteSize int64, isGoogleDoc bool, modified time.Time) bool {
+	st, err := os.Stat(path)
+	if err != nil || st.IsDir() {
+		return false
+	}
+	if !isGoogleDoc && st.Size() != remoteSize {
+		return false
+	}
+	if modified.IsZero() {
+		return !isGoogleDoc
+	}
+	return !st.ModTime().Before(modified.Truncate(time.Second))
+}
+
+func safeDriveName(name string, id string) string {
+	// Drive names may contain slashes; keep them in a single path segment.
+	safe := filepath.Base(strings.ReplaceAll(strings.TrimSpace(name), "/", "_"))
+	if safe == "" || safe == "." || safe == ".." || safe == string(filepath.Separator) {
+		return id
+	}
+	return safe
+}
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDriveDownloadFolderCmd(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	children := map[string][]map[string]any{
+		"root1": {
+			{"id": "f1", "name": "a.txt", "mimeType": "text/plain", "size": "5", "modifiedTime": "2025-01-01T00:00:00Z"},
+			{"id": "d1", "name": "Sub", "mimeType": driveMimeFolder},
+			{"id": "form1", "name": "Survey", "mimeType": "application/vnd.google-apps.form"},
+		},
+		"d1": {
+			{"id": "doc1", "name": "Notes", "mimeType": driveMimeGoogleDoc, "modifiedTime": "2025-01-02T00:00:00Z"},
+		},
+	}
+
+	var downloads atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		switch {
+		case path == "/files" && r.Method == http.MethodGet:
+			q := r.URL.Query().Get("q")
+			if !strings.Contains(q, "in parents") {
+				http.Error(w, "missing parent query", http.StatusBadRequest)
+				return
+			}
+			if r.URL.Query().Get("supportsAllDrives") != "true" || r.URL.Query().Get("includeItemsFromAllDrives") != "true" {
+				http.Error(w, "missing all-drives flags", http.StatusBadRequest)
+				return
+			}
+			id := strings.TrimSuffix(strings.TrimPrefix(q, "'"), "' in parents and trashed = false")
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"files": children[id]})
+		case path == "/files/root1" && r.URL.Query().Get("alt") != "media":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "root1", "name": "Project", "mimeType": driveMimeFolder})
+		case path == "/files/f1" && r.URL.Query().Get("alt") == "media":
+			downloads.Add(1)
+			_, _ = io.WriteString(w, "hello")
+		case path == "/files/doc1/export":
+			downloads.Add(1)
+			if r.URL.Query().Get("mimeType") != mimePDF {
+				http.Error(w, "unexpected mimeType", http.StatusBadRequest)
+				return
+			}
+			_, _ = io.WriteString(w, "%PDF")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	flags := &RootFlags{Account: "a@b.com"}
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	outDir := t.TempDir()
+	run := func() map[string]any {
+		out := captureStdout(t, func() {
+			if execErr := runKong(t, &DriveDownloadFolderCmd{}, []string{"root1", "--out", outDir, "--concurrency", "2"}, ctx, flags); execErr != nil {
+				t.Fatalf("execute: %v", execErr)
+			}
+		})
+		var parsed map[string]any
+		if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+			t.Fatalf("json parse: %v\n%s", err, out)
+		}
+		return parsed
+	}
+
+	first := run()
+	if first["downloaded"] != float64(2) || first["skipped"] != float64(1) || first["failed"] != float64(0) {
+		t.Fatalf("unexpected first summary: %#v", first)
+	}
+	if b, err := os.ReadFile(filepath.Join(outDir, "Project", "a.txt")); err != nil || string(b) != "hello" {
+		t.Fatalf("binary file: %q %v", string(b), err)
+	}
+	if b, err := os.ReadFile(filepath.Join(outDir, "Project", "Sub", "Notes.pdf")); err != nil || string(b) != "%PDF" {
+		t.Fatalf("exported file: %q %v", string(b), err)
+	}
+
+	second := run()
+	if second["downloaded"] != float64(0) || second["skipped"] != float64(3) {
+		t.Fatalf("expected everything skipped on rerun: %#v", second)
+	}
+	if got := downloads.Load(); got != 2 {
+		t.Fatalf("expected 2 downloads total, got %d", got)
+	}
+}
+
+func TestDriveDownloadFolderCmd_NotFolder(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "f1", "name": "a.txt", "mimeType": "text/plain"})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	err = runKong(t, &DriveDownloadFolderCmd{}, []string{"f1", "--out", t.TempDir()}, ctx, &RootFlags{Account: "a@b.com"})
+	if err == nil || !strings.Contains(err.Error(), "not a folder") {
+		t.Fatalf("expected not-a-folder error, got %v", err)
+	}
+}
+
+func TestWalkDriveFolder_ExportNameCollisionAndCancel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"files": []map[string]any{
+			{"id": "doc1", "name": "Report", "mimeType": driveMimeGoogleDoc},
+			{"id": "pdf1", "name": "Report.pdf", "mimeType": mimePDF},
+			{"id": "doc2", "name": "Report.pdf", "mimeType": driveMimeGoogleDoc},
+		}})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	entries, err := walkDriveFolder(context.Background(), svc, "root1", "out", "", nil)
+	if err != nil {
+		t.Fatalf("walk: %v", err)
+	}
+	paths := map[string]bool{}
+	for _, e := range entries {
+		if paths[e.Path] {
+			t.Fatalf("duplicate local path %q in %v", e.Path, entries)
+		}
+		paths[e.Path] = true
+	}
+	for _, want := range []string{"Report.pdf", "Report_pdf1.pdf", "Report_doc2.pdf"} {
+		if !paths[filepath.Join("out", want)] {
+			t.Fatalf("missing %s in %v", want, paths)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := walkDriveFolder(ctx, svc, "root1", "out", "", nil); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
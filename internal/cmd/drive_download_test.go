@@ -140,3 +140,16 @@ func TestDownloadDriveFile_CreateError(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestVerifyDriveMD5(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "f.bin")
+	if err := os.WriteFile(path, []byte("hello"), 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := verifyDriveMD5(path, "5D41402ABC4B2A76B9719D911017C592"); err != nil {
+		t.Fatalf("expected match: %v", err)
+	}
+	if err := verifyDriveMD5(path, "00000000000000000000000000000000"); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected mismatch, got %v", err)
+	}
+}
@@ -41,13 +41,13 @@ func (c *DriveDrivesCmd) Run(ctx context.Context, flags *RootFlags) error {
 		call = call.Q(q)
 	}
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"drives":        resp.Drives,
 			"nextPageToken": resp.NextPageToken,
 		})
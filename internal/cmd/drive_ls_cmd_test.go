@@ -142,3 +142,75 @@ func TestDriveLsCmd_TextAndJSON(t *testing.T) {
 		t.Fatalf("expected TSV header, got: %q", plainOut)
 	}
 }
+
+func TestDriveLsCmd_HumanTotal(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"files": []map[string]any{
+				{"id": "f1", "name": "a.pdf", "mimeType": "application/pdf", "size": "1048576"},
+				{"id": "f2", "name": "b.txt", "mimeType": "text/plain", "size": "1048576"},
+				{"id": "g1", "name": "Notes", "mimeType": "application/vnd.google-apps.document"},
+				{"id": "d1", "name": "Folder", "mimeType": "application/vnd.google-apps.folder"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveLsCmd{}, []string{"--human"}, outfmt.WithMode(ctx, outfmt.Mode{}), &RootFlags{Account: "a@b.com"}); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	last := strings.Fields(lines[len(lines)-1])
+	if len(last) < 4 || last[0] != "TOTAL" || last[1] != "2" || strings.Join(last[3:], " ") != "2.0 MB" {
+		t.Fatalf("unexpected total line: %q", lines[len(lines)-1])
+	}
+
+	// --plain stays data-only; the total goes to stderr.
+	var stderr bytes.Buffer
+	plainUI, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: &stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	out = captureStdout(t, func() {
+		if execErr := runKong(t, &DriveLsCmd{}, []string{"--human"}, outfmt.WithMode(ui.WithUI(context.Background(), plainUI), outfmt.Mode{Plain: true}), &RootFlags{Account: "a@b.com"}); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+	if strings.Contains(out, "TOTAL") || len(strings.Split(strings.TrimSpace(out), "\n")) != 5 {
+		t.Fatalf("plain output should only contain file rows: %q", out)
+	}
+	if !strings.Contains(stderr.String(), "Total: 2 files, 2.0 MB") {
+		t.Fatalf("expected total on stderr, got %q", stderr.String())
+	}
+
+	out = captureStdout(t, func() {
+		if execErr := runKong(t, &DriveLsCmd{}, []string{"--human"}, outfmt.WithMode(ctx, outfmt.Mode{JSON: true}), &RootFlags{Account: "a@b.com"}); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+	if strings.Contains(out, "TOTAL") {
+		t.Fatalf("JSON output should not include a total: %q", out)
+	}
+}
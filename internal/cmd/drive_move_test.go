@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+)
+
+func TestDriveMoveCmd_ToFolderAndName(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	var gotQuery map[string]string
+	var gotName string
+	var gets int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			gets++
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "f1", "name": "Old", "parents": []string{"p1", "keep"}})
+		case http.MethodPatch:
+			q := r.URL.Query()
+			gotQuery = map[string]string{"add": q.Get("addParents"), "remove": q.Get("removeParents"), "allDrives": q.Get("supportsAllDrives")}
+			var body drive.File
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			gotName = body.Name
+			parents := []string{"p1", "keep"}
+			if q.Get("addParents") != "" {
+				parents = []string{q.Get("addParents")}
+			}
+			name := body.Name
+			if name == "" {
+				name = "Old"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "f1", "name": name, "parents": parents})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--account", "a@b.com", "drive", "move", "f1", "--to-folder", "keep", "--name", "New"}); err != nil {
+			t.Fatalf("move: %v", err)
+		}
+	})
+	if gotQuery["add"] != "keep" || gotQuery["remove"] != "p1" || gotQuery["allDrives"] != "true" || gotName != "New" {
+		t.Fatalf("unexpected update: query=%v name=%q", gotQuery, gotName)
+	}
+	var parsed struct {
+		File struct {
+			ID      string   `json:"id"`
+			Name    string   `json:"name"`
+			Parents []string `json:"parents"`
+		} `json:"file"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if parsed.File.ID != "f1" || parsed.File.Name != "New" || len(parsed.File.Parents) != 1 {
+		t.Fatalf("unexpected output: %s", out)
+	}
+
+	// --name alone renames without looking up or touching parents.
+	gets = 0
+	_ = captureStdout(t, func() {
+		if err := Execute([]string{"--account", "a@b.com", "drive", "move", "f1", "--name", "Only"}); err != nil {
+			t.Fatalf("rename: %v", err)
+		}
+	})
+	if gets != 0 || gotQuery["add"] != "" || gotQuery["remove"] != "" || gotName != "Only" {
+		t.Fatalf("unexpected rename-only update: gets=%d query=%v name=%q", gets, gotQuery, gotName)
+	}
+}
+
+func TestDriveMoveCmd_RequiresFolderOrName(t *testing.T) {
+	err := runKong(t, &DriveMoveCmd{}, []string{"f1"}, context.Background(), &RootFlags{Account: "a@b.com"})
+	if err == nil || !strings.Contains(err.Error(), "--name") {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+)
+
+var errDriveFolderNotFound = errors.New("folder not found")
+
+// splitDriveFolderPath splits a slash-delimited folder path ("/Projects/2024")
+// into its segments. Leading, trailing, and doubled slashes are ignored.
+func splitDriveFolderPath(path string) []string {
+	var segments []string
+	for _, seg := range strings.Split(path, "/") {
+		if seg = strings.TrimSpace(seg); seg != "" {
+			segments = append(segments, seg)
+		}
+	}
+	return segments
+}
+
+// resolveDriveFolderPath walks path one segment at a time from the folder
+// startID ("root" for My Drive) and returns the last folder. With create,
+// missing segments are created and returned in order; otherwise a missing
+// segment is an errDriveFolderNotFound error. A segment matching more than one
+// folder is an error listing the candidates.
+func resolveDriveFolderPath(ctx context.Context, svc *drive.Service, startID, path string, create bool) (*drive.File, []*drive.File, error) {
+	current := &drive.File{Id: startID}
+	var created []*drive.File
+	walked := ""
+	for _, seg := range splitDriveFolderPath(path) {
+		walked += "/" + seg
+		matches, err := findDriveChildFolders(ctx, svc, current.Id, seg)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch {
+		case len(matches) == 1:
+			current = matches[0]
+		case len(matches) > 1:
+			ids := make([]string, 0, len(matches))
+			for _, m := range matches {
+				ids = append(ids, m.Id)
+			}
+			return nil, nil, fmt.Errorf("ambiguous folder path %q: %d folders named %q (%s); pass --parent with one of these IDs", walked, len(matches), seg, strings.Join(ids, ", "))
+		case create:
+			folder, err := svc.Files.Create(&drive.File{
+				Name:     seg,
+				MimeType: driveMimeFolder,
+				Parents:  []string{current.Id},
+			}).
+				SupportsAllDrives(true).
+				Fields("id, name, webViewLink").
+				Context(ctx).
+				Do()
+			if err != nil {
+				return nil, nil, fmt.Errorf("create folder %q: %w", walked, err)
+			}
+			created = append(created, folder)
+			current = folder
+		default:
+			return nil, nil, fmt.Errorf("%w: %q", errDriveFolderNotFound, walked)
+		}
+	}
+	return current, created, nil
+}
+
+func findDriveChildFolders(ctx context.Context, svc *drive.Service, parentID, name string) ([]*drive.File, error) {
+	q := buildDriveListQuery(parentID, fmt.Sprintf("name = '%s' and mimeType = '%s'", escapeDriveQueryString(name), driveMimeFolder))
+	return collectAllPages(ctx, "", func(pageToken string) ([]*drive.File, string, error) {
+		call := svc.Files.List().
+			Q(q).
+			PageSize(100).
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Fields("nextPageToken, files(id, name, webViewLink)").
+			Context(ctx)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, err := call.Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Files, resp.NextPageToken, nil
+	})
+}
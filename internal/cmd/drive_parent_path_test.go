@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type fakeDriveFolder struct {
+	ID, Name, Parent string
+}
+
+var (
+	fakeDriveNameRe   = regexp.MustCompile(`name = '([^']*)'`)
+	fakeDriveParentRe = regexp.MustCompile(`'([^']*)' in parents`)
+)
+
+// newFakeDriveFolderServer serves folder lookups and creates against an
+// in-memory tree, and records the body of every media upload.
+func newFakeDriveFolderServer(t *testing.T, folders []fakeDriveFolder) (*drive.Service, *[]fakeDriveFolder, *[]string) {
+	t.Helper()
+
+	var (
+		mu      sync.Mutex
+		created []fakeDriveFolder
+		uploads []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/files"):
+			q := r.URL.Query().Get("q")
+			name := fakeDriveNameRe.FindStringSubmatch(q)
+			parent := fakeDriveParentRe.FindStringSubmatch(q)
+			files := []map[string]any{}
+			for _, f := range folders {
+				if name != nil && parent != nil && f.Name == name[1] && f.Parent == parent[1] {
+					files = append(files, map[string]any{"id": f.ID, "name": f.Name})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"files": files})
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/upload/"):
+			body, _ := io.ReadAll(r.Body)
+			uploads = append(uploads, string(body))
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "file1", "name": "notes.txt"})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/files"):
+			var body struct {
+				Name    string   `json:"name"`
+				Parents []string `json:"parents"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			f := fakeDriveFolder{ID: fmt.Sprintf("new%d", len(created)+1), Name: body.Name, Parent: body.Parents[0]}
+			folders = append(folders, f)
+			created = append(created, f)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": f.ID, "name": f.Name})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	return svc, &created, &uploads
+}
+
+func TestResolveDriveFolderPath(t *testing.T) {
+	svc, created, _ := newFakeDriveFolderServer(t, []fakeDriveFolder{
+		{ID: "p1", Name: "Projects", Parent: "root"},
+		{ID: "y1", Name: "2024", Parent: "p1"},
+		{ID: "d1", Name: "Dup", Parent: "root"},
+		{ID: "d2", Name: "Dup", Parent: "root"},
+	})
+	ctx := context.Background()
+
+	folder, made, err := resolveDriveFolderPath(ctx, svc, "root", "/Projects//2024/", false)
+	if err != nil || folder.Id != "y1" || len(made) != 0 {
+		t.Fatalf("existing path: folder=%v made=%v err=%v", folder, made, err)
+	}
+	if folder, _, err := resolveDriveFolderPath(ctx, svc, "root", "/", false); err != nil || folder.Id != "root" {
+		t.Fatalf("root path: folder=%v err=%v", folder, err)
+	}
+	if folder, _, err := resolveDriveFolderPath(ctx, svc, "p1", "2024", false); err != nil || folder.Id != "y1" {
+		t.Fatalf("relative path: folder=%v err=%v", folder, err)
+	}
+
+	if _, _, err := resolveDriveFolderPath(ctx, svc, "root", "/Projects/2025", false); !errors.Is(err, errDriveFolderNotFound) || !strings.Contains(err.Error(), `"/Projects/2025"`) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if _, _, err := resolveDriveFolderPath(ctx, svc, "root", "/Dup/x", true); err == nil || !strings.Contains(err.Error(), "d1, d2") {
+		t.Fatalf("expected ambiguity error listing candidates, got %v", err)
+	}
+
+	folder, made, err = resolveDriveFolderPath(ctx, svc, "root", "/Projects/2025/Q1", true)
+	if err != nil || folder.Id != "new2" || len(made) != 2 {
+		t.Fatalf("mkdir path: folder=%v made=%v err=%v", folder, made, err)
+	}
+	if (*created)[0].Parent != "p1" || (*created)[1].Parent != "new1" {
+		t.Fatalf("unexpected created folders: %#v", *created)
+	}
+}
+
+func TestDriveUploadCmd_ParentPath(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	svc, created, uploads := newFakeDriveFolderServer(t, []fakeDriveFolder{{ID: "p1", Name: "Projects", Parent: "root"}})
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	path := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(path, []byte("hello"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &DriveUploadCmd{}, []string{path, "--parent-path", "/Projects/2024", "--mkdir"}, ctx, flags); err != nil {
+			t.Fatalf("upload: %v", err)
+		}
+	})
+	if len(*created) != 1 || len(*uploads) != 1 || !strings.Contains((*uploads)[0], `"parents":["new1"]`) {
+		t.Fatalf("unexpected requests: created=%v uploads=%q", *created, *uploads)
+	}
+	if !strings.Contains(out, `"createdFolders"`) {
+		t.Fatalf("expected createdFolders in output: %q", out)
+	}
+
+	for _, args := range [][]string{
+		{path, "--parent", "p1", "--parent-path", "/Projects"},
+		{path, "--mkdir"},
+	} {
+		if err := runKong(t, &DriveUploadCmd{}, args, ctx, flags); ExitCode(err) != 2 {
+			t.Fatalf("expected usage error for %v, got %v", args, err)
+		}
+	}
+}
+
+func TestDriveMkdirCmd_Parents(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	svc, created, _ := newFakeDriveFolderServer(t, []fakeDriveFolder{{ID: "p1", Name: "Projects", Parent: "root"}})
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	type result struct {
+		Folder  *drive.File   `json:"folder"`
+		Created []*drive.File `json:"created"`
+	}
+	run := func(args ...string) result {
+		t.Helper()
+		out := captureStdout(t, func() {
+			if err := runKong(t, &DriveMkdirCmd{}, args, ctx, flags); err != nil {
+				t.Fatalf("mkdir %v: %v", args, err)
+			}
+		})
+		var parsed result
+		if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+			t.Fatalf("json parse: %v\nout=%q", err, out)
+		}
+		return parsed
+	}
+
+	first := run("2024/Q1", "--parent-path", "/Projects", "-p")
+	if first.Folder.Id != "new2" || len(first.Created) != 2 || first.Created[0].Id != "new1" {
+		t.Fatalf("unexpected first run: %+v", first)
+	}
+
+	// Running it again reuses every segment.
+	again := run("Projects/2024/Q1", "-p")
+	if again.Folder.Id != "new2" || len(again.Created) != 0 || len(*created) != 2 {
+		t.Fatalf("expected idempotent run, got %+v (created=%v)", again, *created)
+	}
+
+	if err := runKong(t, &DriveMkdirCmd{}, []string{"x", "--parent-path", "/Missing"}, ctx, flags); !errors.Is(err, errDriveFolderNotFound) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+	if err := runKong(t, &DriveMkdirCmd{}, []string{"x", "--parent", "p1", "--parent-path", "/Projects"}, ctx, flags); ExitCode(err) != 2 {
+		t.Fatalf("expected usage error, got %v", err)
+	}
+}
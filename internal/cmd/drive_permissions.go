@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DrivePermissionsCmd struct {
+	List   DrivePermissionsListCmd   `cmd:"" name:"list" default:"withargs" help:"List permissions on a file"`
+	Add    DrivePermissionsAddCmd    `cmd:"" name:"add" help:"Grant a permission on a file"`
+	Remove DrivePermissionsRemoveCmd `cmd:"" name:"remove" aliases:"rm,delete" help:"Remove a permission from a file"`
+}
+
+type DrivePermissionsListCmd struct {
+	FileID string `arg:"" name:"fileId" help:"File ID"`
+	Max    int64  `name:"max" aliases:"limit" help:"Max results" default:"100"`
+	Page   string `name:"page" help:"Page token"`
+}
+
+func (c *DrivePermissionsListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	fileID := strings.TrimSpace(c.FileID)
+	if fileID == "" {
+		return usage("empty fileId")
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Permissions.List(fileID).
+		SupportsAllDrives(true).
+		Fields("nextPageToken, permissions(id, type, role, emailAddress, domain, displayName)").
+		Context(ctx)
+	if c.Max > 0 {
+		call = call.PageSize(c.Max)
+	}
+	if strings.TrimSpace(c.Page) != "" {
+		call = call.PageToken(c.Page)
+	}
+
+	resp, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"fileId":          fileID,
+			"permissions":     resp.Permissions,
+			"permissionCount": len(resp.Permissions),
+			"nextPageToken":   resp.NextPageToken,
+		})
+	}
+	if len(resp.Permissions) == 0 {
+		u.Err().Println("No permissions")
+		return nil
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tTYPE\tROLE\tPRINCIPAL")
+	for _, p := range resp.Permissions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Id, p.Type, p.Role, drivePermissionPrincipal(p))
+	}
+	printNextPageHint(u, resp.NextPageToken)
+	return nil
+}
+
+type DrivePermissionsAddCmd struct {
+	FileID string `arg:"" name:"fileId" help:"File ID"`
+	Email  string `name:"email" help:"User or group email (type user|group)"`
+	Domain string `name:"domain" help:"Domain name (type domain)"`
+	Role   string `name:"role" help:"Role: reader|commenter|writer|owner" default:"reader"`
+	Type   string `name:"type" help:"Grantee type: user|group|domain|anyone" default:"user"`
+	Notify bool   `name:"notify" help:"Send a notification email (user/group only)"`
+}
+
+func (c *DrivePermissionsAddCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	fileID := strings.TrimSpace(c.FileID)
+	if fileID == "" {
+		return usage("empty fileId")
+	}
+
+	perm, err := buildDrivePermission(c.Type, c.Role, c.Email, c.Domain)
+	if err != nil {
+		return err
+	}
+	if c.Notify && perm.Type != "user" && perm.Type != "group" {
+		return usage("--notify only applies to --type user|group")
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Permissions.Create(fileID, perm).
+		SupportsAllDrives(true).
+		Fields("id, type, role, emailAddress, domain, displayName").
+		Context(ctx)
+	if perm.Type == "user" || perm.Type == "group" {
+		call = call.SendNotificationEmail(c.Notify || perm.Role == "owner")
+	}
+	if perm.Role == "owner" {
+		call = call.TransferOwnership(true)
+	}
+
+	created, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"fileId":     fileID,
+			"permission": created,
+		})
+	}
+
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("type\t%s", created.Type)
+	u.Out().Printf("role\t%s", created.Role)
+	u.Out().Printf("principal\t%s", drivePermissionPrincipal(created))
+	return nil
+}
+
+type DrivePermissionsRemoveCmd struct {
+	FileID       string `arg:"" name:"fileId" help:"File ID"`
+	PermissionID string `arg:"" name:"permissionId" help:"Permission ID"`
+}
+
+func (c *DrivePermissionsRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return (&DriveUnshareCmd{FileID: c.FileID, PermissionID: c.PermissionID}).Run(ctx, flags)
+}
+
+func buildDrivePermission(permType, role, email, domain string) (*drive.Permission, error) {
+	permType = strings.ToLower(strings.TrimSpace(permType))
+	role = strings.ToLower(strings.TrimSpace(role))
+	email = strings.TrimSpace(email)
+	domain = strings.TrimSpace(domain)
+
+	switch role {
+	case "reader", "commenter", "writer", "owner":
+	default:
+		return nil, usagef("invalid --role %q (expected reader|commenter|writer|owner)", role)
+	}
+
+	perm := &drive.Permission{Type: permType, Role: role}
+	switch permType {
+	case "user", "group":
+		if email == "" {
+			return nil, usagef("--email is required for --type %s", permType)
+		}
+		perm.EmailAddress = email
+	case "domain":
+		if domain == "" {
+			return nil, usage("--domain is required for --type domain")
+		}
+		perm.Domain = domain
+	case "anyone":
+	default:
+		return nil, usagef("invalid --type %q (expected user|group|domain|anyone)", permType)
+	}
+	if role == "owner" && permType != "user" {
+		return nil, usage("--role owner requires --type user")
+	}
+	return perm, nil
+}
+
+func drivePermissionPrincipal(p *drive.Permission) string {
+	switch {
+	case p.EmailAddress != "":
+		return p.EmailAddress
+	case p.Domain != "":
+		return p.Domain
+	case p.Type == "anyone":
+		return "anyone"
+	default:
+		return "-"
+	}
+}
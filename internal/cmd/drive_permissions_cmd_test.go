@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
@@ -67,7 +68,7 @@ func TestDrivePermissionsCmd_TextAndJSON(t *testing.T) {
 	ctx = outfmt.WithMode(ctx, outfmt.Mode{})
 
 	textOut := captureStdout(t, func() {
-		cmd := &DrivePermissionsCmd{}
+		cmd := &DrivePermissionsListCmd{}
 		if execErr := runKong(t, cmd, []string{"--max", "1", "--page", "p1", "id1"}, ctx, flags); execErr != nil {
 			t.Fatalf("execute: %v", execErr)
 		}
@@ -92,7 +93,7 @@ func TestDrivePermissionsCmd_TextAndJSON(t *testing.T) {
 	ctx2 = outfmt.WithMode(ctx2, outfmt.Mode{JSON: true})
 
 	jsonOut := captureStdout(t, func() {
-		cmd := &DrivePermissionsCmd{}
+		cmd := &DrivePermissionsListCmd{}
 		if execErr := runKong(t, cmd, []string{"--max", "1", "--page", "p1", "id1"}, ctx2, flags); execErr != nil {
 			t.Fatalf("execute: %v", execErr)
 		}
@@ -161,7 +162,7 @@ func TestDrivePermissionsCmd_OmitsEmptyPageToken(t *testing.T) {
 	ctx = ui.WithUI(ctx, u)
 
 	out := captureStdout(t, func() {
-		cmd := &DrivePermissionsCmd{}
+		cmd := &DrivePermissionsListCmd{}
 		if execErr := runKong(t, cmd, []string{"--max", "1", "id1"}, ctx, flags); execErr != nil {
 			t.Fatalf("execute: %v", execErr)
 		}
@@ -178,3 +179,84 @@ func TestDrivePermissionsCmd_OmitsEmptyPageToken(t *testing.T) {
 		t.Fatalf("unexpected json: %#v", parsed)
 	}
 }
+
+func TestDrivePermissionsAddCmd(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	var gotBody map[string]any
+	var gotQuery url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.Contains(r.URL.Path, "/files/id1/permissions") {
+			http.NotFound(w, r)
+			return
+		}
+		gotQuery = r.URL.Query()
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "p9", "type": "group", "role": "commenter", "emailAddress": "team@b.com"})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		args := []string{"id1", "--type", "group", "--email", "team@b.com", "--role", "commenter", "--notify"}
+		if execErr := runKong(t, &DrivePermissionsAddCmd{}, args, ctx, &RootFlags{Account: "a@b.com"}); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+
+	if gotBody["type"] != "group" || gotBody["role"] != "commenter" || gotBody["emailAddress"] != "team@b.com" {
+		t.Fatalf("unexpected body: %#v", gotBody)
+	}
+	if gotQuery.Get("sendNotificationEmail") != "true" || gotQuery.Get("supportsAllDrives") != "true" {
+		t.Fatalf("unexpected query: %v", gotQuery)
+	}
+
+	var parsed struct {
+		Permission *drive.Permission `json:"permission"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Permission == nil || parsed.Permission.Id != "p9" {
+		t.Fatalf("unexpected json: %q", out)
+	}
+}
+
+func TestBuildDrivePermission(t *testing.T) {
+	if p, err := buildDrivePermission("domain", "reader", "", "example.com"); err != nil || p.Domain != "example.com" {
+		t.Fatalf("domain: %#v %v", p, err)
+	}
+	if p, err := buildDrivePermission("anyone", "reader", "", ""); err != nil || p.Type != "anyone" {
+		t.Fatalf("anyone: %#v %v", p, err)
+	}
+
+	bad := []struct{ typ, role, email, domain string }{
+		{"user", "reader", "", ""},
+		{"domain", "reader", "", ""},
+		{"robot", "reader", "", ""},
+		{"user", "admin", "a@b.com", ""},
+		{"group", "owner", "g@b.com", ""},
+	}
+	for _, tc := range bad {
+		if _, err := buildDrivePermission(tc.typ, tc.role, tc.email, tc.domain); err == nil {
+			t.Fatalf("expected error for %#v", tc)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func newDriveRevisionsTestService(t *testing.T, mimeType string, uploaded *string) {
+	t.Helper()
+
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		switch {
+		case r.Method == http.MethodGet && path == "/files/id1/revisions":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"revisions": []map[string]any{
+					{"id": "r1", "modifiedTime": "2025-01-01T10:00:00Z", "size": "2048", "lastModifyingUser": map[string]any{"emailAddress": "a@b.com"}},
+					{"id": "r2", "modifiedTime": "2025-01-02T10:00:00Z", "size": "10"},
+				},
+			})
+		case r.Method == http.MethodGet && path == "/files/id1/revisions/r1" && r.URL.Query().Get("alt") == "media":
+			_, _ = io.WriteString(w, "old content")
+		case r.Method == http.MethodGet && path == "/files/id1/revisions/r1":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "r1", "modifiedTime": "2025-01-01T10:00:00Z"})
+		case r.Method == http.MethodGet && path == "/files/id1":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "id1", "name": "report.bin", "mimeType": mimeType})
+		case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, "/files/id1"):
+			if r.URL.Query().Get("supportsAllDrives") != "true" {
+				http.Error(w, "missing supportsAllDrives", http.StatusBadRequest)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			*uploaded = string(body)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "id1", "name": "report.bin", "headRevisionId": "r3"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+}
+
+func TestDriveRevisionsListCmd(t *testing.T) {
+	var uploaded string
+	newDriveRevisionsTestService(t, "application/octet-stream", &uploaded)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveRevisionsListCmd{}, []string{"id1"}, ctx, flags); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+	if !strings.Contains(out, "r1") || !strings.Contains(out, "2.0 KB") || !strings.Contains(out, "a@b.com") || !strings.Contains(out, "r2") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+}
+
+func TestDriveRevisionsRestoreCmd(t *testing.T) {
+	var uploaded string
+	newDriveRevisionsTestService(t, "application/octet-stream", &uploaded)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveRevisionsRestoreCmd{}, []string{"id1", "r1"}, ctx, flags); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+	if !strings.Contains(uploaded, "old content") {
+		t.Fatalf("expected revision content uploaded, got %q", uploaded)
+	}
+
+	var parsed struct {
+		File     *drive.File     `json:"file"`
+		Restored *drive.Revision `json:"restored"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.File == nil || parsed.File.HeadRevisionId != "r3" || parsed.Restored == nil || parsed.Restored.Id != "r1" {
+		t.Fatalf("unexpected json: %q", out)
+	}
+}
+
+func TestDriveRevisionsRestoreCmd_GoogleDoc(t *testing.T) {
+	var uploaded string
+	newDriveRevisionsTestService(t, driveMimeGoogleDoc, &uploaded)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	err = runKong(t, &DriveRevisionsRestoreCmd{}, []string{"id1", "r1"}, ctx, &RootFlags{Account: "a@b.com"})
+	if err == nil || !strings.Contains(err.Error(), "cannot restore") {
+		t.Fatalf("expected google doc error, got %v", err)
+	}
+	if uploaded != "" {
+		t.Fatalf("expected no upload, got %q", uploaded)
+	}
+}
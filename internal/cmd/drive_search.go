@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DriveSearchCmd struct {
+	Query         []string       `arg:"" name:"query" optional:"" help:"Full-text search terms"`
+	NameContains  string         `name:"name-contains" help:"Only files whose name contains this text"`
+	Mime          string         `name:"mime" help:"MIME type or alias: folder|doc|sheet|slides|drawing|form|pdf"`
+	Parent        string         `name:"parent" help:"Only direct children of this folder ID"`
+	ModifiedAfter string         `name:"modified-after" help:"Only files modified after this time (RFC3339, YYYY-MM-DD, today, yesterday, ...)"`
+	Owner         string         `name:"owner" help:"Only files owned by this email (me for yourself)"`
+	Trashed       bool           `name:"trashed" help:"Search the trash instead of live files"`
+	Starred       bool           `name:"starred" help:"Only starred files"`
+	Max           int64          `name:"max" aliases:"limit" help:"Max results" default:"20"`
+	Page          string         `name:"page" help:"Page token"`
+	All           bool           `name:"all" help:"Fetch all pages"`
+	Limits        PageLimitFlags `embed:""`
+}
+
+func (*DriveSearchCmd) csvTable()              {}
+func (*DriveSearchCmd) ndjsonStream()          {}
+func (*DriveSearchCmd) fieldsResource() string { return "files" }
+
+func (c *DriveSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Limits.validate(); err != nil {
+		return err
+	}
+
+	q, err := c.buildQuery(time.Now())
+	if err != nil {
+		return err
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	fetch := func(pageToken string) ([]*drive.File, string, error) {
+		call := svc.Files.List().
+			Q(q).
+			PageSize(c.Max).
+			OrderBy("modifiedTime desc").
+			SupportsAllDrives(true).
+			IncludeItemsFromAllDrives(true).
+			Fields("nextPageToken, files(id, name, mimeType, size, modifiedTime, parents, webViewLink)").
+			Context(ctx)
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, err := call.Context(ctx).Do()
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Files, resp.NextPageToken, nil
+	}
+
+	if outfmt.IsNDJSON(ctx) {
+		nextPageToken, streamErr := streamPages(ctx, c.Page, streamLimits(c.All, c.Limits), fetch, func(f *drive.File) error {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, f)
+		})
+		if streamErr != nil {
+			return streamErr
+		}
+		printNextPageHint(u, nextPageToken)
+		return nil
+	}
+
+	var files []*drive.File
+	nextPageToken := ""
+	if c.All || c.Limits.set() {
+		files, nextPageToken, err = collectPages(ctx, c.Page, c.Limits, fetch)
+	} else {
+		files, nextPageToken, err = fetch(c.Page)
+	}
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"query":         q,
+			"files":         files,
+			"nextPageToken": nextPageToken,
+		})
+	}
+
+	if len(files) == 0 {
+		u.Err().Println("No results")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(files))
+	for _, f := range files {
+		rows = append(rows, []string{f.Id, f.Name, f.MimeType, formatDriveSize(f.Size), formatDateTime(f.ModifiedTime)})
+	}
+	if err := writeTable(ctx, []string{"ID", "NAME", "MIME", "SIZE", "MODIFIED"}, rows); err != nil {
+		return err
+	}
+	printNextPageHint(u, nextPageToken)
+	return nil
+}
+
+// buildQuery assembles the Drive q expression from the positional search
+// terms and filter flags, escaping every user-provided value.
+func (c *DriveSearchCmd) buildQuery(now time.Time) (string, error) {
+	var clauses []string
+
+	if text := strings.TrimSpace(strings.Join(c.Query, " ")); text != "" {
+		clauses = append(clauses, fmt.Sprintf("fullText contains '%s'", escapeDriveQueryString(text)))
+	}
+	if name := strings.TrimSpace(c.NameContains); name != "" {
+		clauses = append(clauses, fmt.Sprintf("name contains '%s'", escapeDriveQueryString(name)))
+	}
+	if mime := strings.TrimSpace(c.Mime); mime != "" {
+		resolved, err := resolveDriveMimeAlias(mime)
+		if err != nil {
+			return "", err
+		}
+		clauses = append(clauses, fmt.Sprintf("mimeType = '%s'", escapeDriveQueryString(resolved)))
+	}
+	if parent := strings.TrimSpace(c.Parent); parent != "" {
+		clauses = append(clauses, fmt.Sprintf("'%s' in parents", escapeDriveQueryString(parent)))
+	}
+	if after := strings.TrimSpace(c.ModifiedAfter); after != "" {
+		t, err := parseTimeExpr(after, now, now.Location())
+		if err != nil {
+			return "", usagef("invalid --modified-after: %v", err)
+		}
+		clauses = append(clauses, fmt.Sprintf("modifiedTime > '%s'", t.UTC().Format(time.RFC3339)))
+	}
+	if owner := strings.TrimSpace(c.Owner); owner != "" {
+		clauses = append(clauses, fmt.Sprintf("'%s' in owners", escapeDriveQueryString(owner)))
+	}
+	if c.Starred {
+		clauses = append(clauses, "starred = true")
+	}
+
+	if len(clauses) == 0 {
+		return "", usage("missing query (pass search terms or a filter flag such as --name-contains)")
+	}
+
+	clauses = append(clauses, fmt.Sprintf("trashed = %t", c.Trashed))
+	return strings.Join(clauses, " and "), nil
+}
+
+func resolveDriveMimeAlias(value string) (string, error) {
+	switch strings.ToLower(value) {
+	case "folder":
+		return driveMimeFolder, nil
+	case "doc", "docs", "document":
+		return driveMimeGoogleDoc, nil
+	case "sheet", "sheets", "spreadsheet":
+		return driveMimeGoogleSheet, nil
+	case "slides", "presentation":
+		return driveMimeGoogleSlides, nil
+	case "drawing":
+		return driveMimeGoogleDrawing, nil
+	case "form":
+		return "application/vnd.google-apps.form", nil
+	case "pdf":
+		return mimePDF, nil
+	}
+	if !strings.Contains(value, "/") {
+		return "", usagef("invalid --mime %q (use a MIME type or folder|doc|sheet|slides|drawing|form|pdf)", value)
+	}
+	return value, nil
+}
@@ -140,3 +140,108 @@ func TestDriveSearchCmd_NoResultsAndEmptyQuery(t *testing.T) {
 		t.Fatalf("expected empty query error")
 	}
 }
+
+func TestDriveSearchCmd_MaxPages(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	var tokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("pageToken")
+		tokens = append(tokens, token)
+		next := map[string]string{"": "p2", "p2": "p3", "p3": ""}[token]
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"files":         []map[string]any{{"id": "f-" + token, "name": "File " + token}},
+			"nextPageToken": next,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveSearchCmd{}, []string{"hello", "--max-pages", "2"}, ctx, &RootFlags{Account: "a@b.com"}); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+
+	var parsed struct {
+		Files         []*drive.File `json:"files"`
+		NextPageToken string        `json:"nextPageToken"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Files) != 2 || parsed.NextPageToken != "p3" || len(tokens) != 2 {
+		t.Fatalf("unexpected result: files=%d next=%q tokens=%v", len(parsed.Files), parsed.NextPageToken, tokens)
+	}
+
+	if execErr := runKong(t, &DriveSearchCmd{}, []string{"hello", "--max-total", "-1"}, ctx, &RootFlags{Account: "a@b.com"}); execErr == nil {
+		t.Fatalf("expected usage error for negative --max-total")
+	}
+}
+
+func TestExecute_DriveSearch_NDJSON(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := r.URL.Query().Get("pageToken")
+		next := map[string]string{"": "p2", "p2": ""}[token]
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"files":         []map[string]any{{"id": "f-" + token, "name": "File " + token, "mimeType": "text/plain", "size": "1"}},
+			"nextPageToken": next,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--ndjson", "--preset", "minimal", "--account", "a@b.com", "drive", "search", "hello", "--all"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	want := `{"id":"f-","mimeType":"text/plain","name":"File "}` + "\n" +
+		`{"id":"f-p2","mimeType":"text/plain","name":"File p2"}` + "\n"
+	if out != want {
+		t.Fatalf("unexpected ndjson:\n%q\nwant\n%q", out, want)
+	}
+
+	for _, args := range [][]string{
+		{"--ndjson", "--plain", "--account", "a@b.com", "drive", "search", "hello"},
+		{"--ndjson", "--account", "a@b.com", "drive", "get", "f1"},
+	} {
+		_ = captureStderr(t, func() {
+			if err := Execute(args); ExitCode(err) != 2 {
+				t.Fatalf("expected usage error for %v, got %v", args, err)
+			}
+		})
+	}
+}
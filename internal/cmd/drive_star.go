@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DriveStarCmd struct {
+	FileID string `arg:"" name:"fileId" help:"File ID"`
+}
+
+func (c *DriveStarCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return setDriveStarred(ctx, flags, c.FileID, true)
+}
+
+type DriveUnstarCmd struct {
+	FileID string `arg:"" name:"fileId" help:"File ID"`
+}
+
+func (c *DriveUnstarCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return setDriveStarred(ctx, flags, c.FileID, false)
+}
+
+func setDriveStarred(ctx context.Context, flags *RootFlags, fileID string, starred bool) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	fileID = strings.TrimSpace(fileID)
+	if fileID == "" {
+		return usage("empty fileId")
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	// ForceSendFields so unstar (false) is not dropped as a zero value.
+	updated, err := svc.Files.Update(fileID, &drive.File{Starred: starred, ForceSendFields: []string{"Starred"}}).
+		SupportsAllDrives(true).
+		Fields("id, name, starred").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"id":      updated.Id,
+			"starred": updated.Starred,
+		})
+	}
+	u.Out().Printf("id\t%s", updated.Id)
+	u.Out().Printf("name\t%s", updated.Name)
+	u.Out().Printf("starred\t%t", updated.Starred)
+	return nil
+}
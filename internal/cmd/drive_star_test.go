@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDriveStarUnstar(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	var (
+		bodies    []map[string]any
+		allDrives []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		if r.Method != http.MethodPatch || path != "/files/id1" {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		allDrives = append(allDrives, r.URL.Query().Get("supportsAllDrives"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "id1", "name": "a.txt", "starred": body["starred"]})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveStarCmd{}, []string{"id1"}, ctx, flags); execErr != nil {
+			t.Fatalf("star: %v", execErr)
+		}
+	})
+	if !strings.Contains(out, `"starred": true`) || !strings.Contains(out, `"id": "id1"`) {
+		t.Fatalf("unexpected star output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if execErr := runKong(t, &DriveUnstarCmd{}, []string{"id1"}, ctx, flags); execErr != nil {
+			t.Fatalf("unstar: %v", execErr)
+		}
+	})
+	if !strings.Contains(out, `"starred": false`) {
+		t.Fatalf("unexpected unstar output: %q", out)
+	}
+	if len(bodies) != 2 || bodies[0]["starred"] != true || bodies[1]["starred"] != false {
+		t.Fatalf("unexpected bodies: %#v", bodies)
+	}
+	if allDrives[0] != "true" || allDrives[1] != "true" {
+		t.Fatalf("expected supportsAllDrives, got %v", allDrives)
+	}
+
+	if err := runKong(t, &DriveStarCmd{}, []string{" "}, ctx, flags); err == nil {
+		t.Fatal("expected error for empty fileId")
+	}
+}
@@ -1,6 +1,9 @@
 package cmd
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestBuildDriveListQuery(t *testing.T) {
 	t.Run("adds parent and trashed", func(t *testing.T) {
@@ -26,9 +29,36 @@ func TestBuildDriveListQuery(t *testing.T) {
 }
 
 func TestBuildDriveSearchQuery(t *testing.T) {
-	got := buildDriveSearchQuery("hello world")
-	if got != "fullText contains 'hello world' and trashed = false" {
-		t.Fatalf("unexpected: %q", got)
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	got, err := (&DriveSearchCmd{Query: []string{"hello", "world"}}).buildQuery(now)
+	if err != nil || got != "fullText contains 'hello world' and trashed = false" {
+		t.Fatalf("unexpected: %q %v", got, err)
+	}
+
+	got, err = (&DriveSearchCmd{
+		NameContains:  "Q1 'plan'",
+		Mime:          "sheet",
+		Parent:        "folder1",
+		ModifiedAfter: "2025-01-02",
+		Owner:         "me",
+		Trashed:       true,
+		Starred:       true,
+	}).buildQuery(now)
+	want := "name contains 'Q1 \\'plan\\'' and mimeType = 'application/vnd.google-apps.spreadsheet' and 'folder1' in parents" +
+		" and modifiedTime > '2025-01-02T00:00:00Z' and 'me' in owners and starred = true and trashed = true"
+	if err != nil || got != want {
+		t.Fatalf("unexpected: %q %v", got, err)
+	}
+
+	if _, err := (&DriveSearchCmd{}).buildQuery(now); err == nil {
+		t.Fatalf("expected error for empty query")
+	}
+	if _, err := (&DriveSearchCmd{Mime: "bogus"}).buildQuery(now); err == nil {
+		t.Fatalf("expected error for invalid mime alias")
+	}
+	if _, err := (&DriveSearchCmd{ModifiedAfter: "someday"}).buildQuery(now); err == nil {
+		t.Fatalf("expected error for invalid --modified-after")
 	}
 }
 
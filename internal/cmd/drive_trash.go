@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type DriveTrashCmd struct {
+	FileID string `arg:"" name:"fileId" help:"File ID"`
+}
+
+func (c *DriveTrashCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return setDriveTrashed(ctx, flags, c.FileID, true)
+}
+
+type DriveRestoreCmd struct {
+	FileID string `arg:"" name:"fileId" help:"File ID"`
+}
+
+func (c *DriveRestoreCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return setDriveTrashed(ctx, flags, c.FileID, false)
+}
+
+func setDriveTrashed(ctx context.Context, flags *RootFlags, fileID string, trashed bool) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	fileID = strings.TrimSpace(fileID)
+	if fileID == "" {
+		return usage("empty fileId")
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	// ForceSendFields so restore (false) is not dropped as a zero value.
+	updated, err := svc.Files.Update(fileID, &drive.File{Trashed: trashed, ForceSendFields: []string{"Trashed"}}).
+		SupportsAllDrives(true).
+		Fields("id, name, trashed").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"id":      updated.Id,
+			"trashed": updated.Trashed,
+		})
+	}
+	u.Out().Printf("id\t%s", updated.Id)
+	u.Out().Printf("name\t%s", updated.Name)
+	u.Out().Printf("trashed\t%t", updated.Trashed)
+	return nil
+}
+
+type DriveEmptyTrashCmd struct {
+	DriveID string `name:"drive" help:"Shared drive ID (default: My Drive trash)"`
+}
+
+func (c *DriveEmptyTrashCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	driveID := strings.TrimSpace(c.DriveID)
+	target := "My Drive"
+	if driveID != "" {
+		target = "shared drive " + driveID
+	}
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("permanently delete all trashed files in %s", target)); confirmErr != nil {
+		return confirmErr
+	}
+
+	svc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	call := svc.Files.EmptyTrash().Context(ctx)
+	if driveID != "" {
+		call = call.DriveId(driveID)
+	}
+	if err := call.Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"emptied": true,
+			"driveId": driveID,
+		})
+	}
+	u.Out().Printf("emptied\ttrue")
+	if driveID != "" {
+		u.Out().Printf("drive_id\t%s", driveID)
+	}
+	return nil
+}
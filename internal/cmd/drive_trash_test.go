@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDriveTrashRestoreEmptyTrash(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	var bodies []map[string]any
+	emptied := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/drive/v3")
+		switch {
+		case r.Method == http.MethodPatch && path == "/files/id1":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			bodies = append(bodies, body)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "id1", "name": "a.txt", "trashed": body["trashed"]})
+		case r.Method == http.MethodDelete && path == "/files/trash":
+			emptied = "mydrive"
+			if id := r.URL.Query().Get("driveId"); id != "" {
+				emptied = id
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com", Force: true}
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveTrashCmd{}, []string{"id1"}, ctx, flags); execErr != nil {
+			t.Fatalf("trash: %v", execErr)
+		}
+	})
+	if !strings.Contains(out, `"trashed": true`) {
+		t.Fatalf("unexpected trash output: %q", out)
+	}
+
+	out = captureStdout(t, func() {
+		if execErr := runKong(t, &DriveRestoreCmd{}, []string{"id1"}, ctx, flags); execErr != nil {
+			t.Fatalf("restore: %v", execErr)
+		}
+	})
+	if !strings.Contains(out, `"trashed": false`) {
+		t.Fatalf("unexpected restore output: %q", out)
+	}
+	if len(bodies) != 2 || bodies[0]["trashed"] != true || bodies[1]["trashed"] != false {
+		t.Fatalf("unexpected update bodies: %#v", bodies)
+	}
+
+	_ = captureStdout(t, func() {
+		if execErr := runKong(t, &DriveEmptyTrashCmd{}, []string{"--drive", "sd1"}, ctx, flags); execErr != nil {
+			t.Fatalf("empty-trash: %v", execErr)
+		}
+	})
+	if emptied != "sd1" {
+		t.Fatalf("expected shared drive trash emptied, got %q", emptied)
+	}
+}
+
+func TestDriveEmptyTrashRequiresConfirmation(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+	newDriveService = func(context.Context, string) (*drive.Service, error) {
+		t.Fatalf("service should not be created without confirmation")
+		return nil, nil
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	if err := runKong(t, &DriveEmptyTrashCmd{}, []string{}, ctx, &RootFlags{Account: "a@b.com", NoInput: true}); err == nil {
+		t.Fatalf("expected confirmation error")
+	}
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// parseByteSize parses sizes like "8MB", "512KB", "1GB" or plain byte counts.
+// Units are binary (1KB = 1024 bytes), matching Drive's chunk granularity.
+func parseByteSize(value string) (int64, error) {
+	s := strings.ToUpper(strings.TrimSpace(value))
+	if s == "" {
+		return 0, errors.New("empty size")
+	}
+
+	multiplier := int64(1)
+	for _, unit := range []struct {
+		suffix string
+		mult   int64
+	}{
+		{"GIB", 1 << 30}, {"MIB", 1 << 20}, {"KIB", 1 << 10},
+		{"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10},
+		{"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10},
+		{"B", 1},
+	} {
+		if strings.HasSuffix(s, unit.suffix) {
+			s = strings.TrimSpace(strings.TrimSuffix(s, unit.suffix))
+			multiplier = unit.mult
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid size %q (e.g. 8MB, 512KB)", value)
+	}
+	return int64(n * float64(multiplier)), nil
+}
+
+// uploadChunkOptions returns the media options for a Drive upload. Files
+// larger than one chunk are sent as resumable uploads, which the client
+// library resumes chunk by chunk after transient network errors.
+func uploadChunkOptions(chunkSize string, mimeType string) ([]gapi.MediaOption, int, error) {
+	size, err := parseByteSize(chunkSize)
+	if err != nil {
+		return nil, 0, usagef("invalid --chunk-size: %v", err)
+	}
+	if size < gapi.MinUploadChunkSize {
+		return nil, 0, usagef("--chunk-size must be at least 256KB")
+	}
+	return []gapi.MediaOption{gapi.ContentType(mimeType), gapi.ChunkSize(int(size))}, int(size), nil
+}
+
+// uploadProgress reports resumable upload progress to stderr.
+func uploadProgress(u *ui.UI, name string, total int64) gapi.ProgressUpdater {
+	return func(current, _ int64) {
+		if u == nil || total <= 0 {
+			return
+		}
+		u.Err().Printf("Uploading %s: %s / %s (%.0f%%)", name, formatDriveSize(current), formatDriveSize(total), float64(current)*100/float64(total))
+	}
+}
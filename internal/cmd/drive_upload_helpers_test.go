@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestParseByteSize(t *testing.T) {
+	cases := map[string]int64{
+		"1024":  1024,
+		"512KB": 512 << 10,
+		"8mb":   8 << 20,
+		"1.5M":  3 << 19,
+		"1GiB":  1 << 30,
+		"256 K": 256 << 10,
+	}
+	for in, want := range cases {
+		got, err := parseByteSize(in)
+		if err != nil || got != want {
+			t.Fatalf("parseByteSize(%q) = %d, %v; want %d", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "abc", "-1MB", "0"} {
+		if _, err := parseByteSize(in); err == nil {
+			t.Fatalf("expected error for %q", in)
+		}
+	}
+	if _, _, err := uploadChunkOptions("64KB", "text/plain"); err == nil {
+		t.Fatalf("expected error for chunk below 256KB")
+	}
+}
+
+func TestDriveUploadCmd_ResumableChunks(t *testing.T) {
+	origNew := newDriveService
+	t.Cleanup(func() { newDriveService = origNew })
+
+	const chunk = 256 << 10
+	content := bytes.Repeat([]byte("x"), 2*chunk+100)
+
+	var (
+		mu        sync.Mutex
+		received  bytes.Buffer
+		puts      int
+		failedPut bool
+	)
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost && r.URL.Query().Get("uploadType") == "resumable":
+			w.Header().Set("Location", srvURL+"/upload-session")
+			w.WriteHeader(http.StatusOK)
+		case r.URL.Path == "/upload-session":
+			puts++
+			// Fail the second chunk once to exercise in-process resumption.
+			if puts == 2 && !failedPut {
+				failedPut = true
+				_, _ = io.Copy(io.Discard, r.Body)
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			received.Write(body)
+			if received.Len() < len(content) {
+				// The client asks for X-GUploader-No-308, so signal "resume incomplete" via override.
+				w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", received.Len()-1))
+				w.Header().Set("X-Http-Status-Code-Override", "308")
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "up1", "name": "big.bin"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	path := filepath.Join(t.TempDir(), "big.bin")
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	var errBuf bytes.Buffer
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: &errBuf, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if execErr := runKong(t, &DriveUploadCmd{}, []string{path, "--chunk-size", "256KB"}, ctx, &RootFlags{Account: "a@b.com"}); execErr != nil {
+			t.Fatalf("execute: %v", execErr)
+		}
+	})
+
+	if !bytes.Equal(received.Bytes(), content) {
+		t.Fatalf("uploaded %d bytes, want %d", received.Len(), len(content))
+	}
+	if !failedPut || puts < 4 {
+		t.Fatalf("expected a retried chunk, puts=%d", puts)
+	}
+	if !strings.Contains(out, "up1") {
+		t.Fatalf("unexpected output: %q", out)
+	}
+	if !strings.Contains(errBuf.String(), "Uploading big.bin") {
+		t.Fatalf("expected progress on stderr, got %q", errBuf.String())
+	}
+}
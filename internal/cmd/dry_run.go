@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"sort"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// dryRunEnvelope is the stable --json shape of a dry run: the operation name
+// (service.command, e.g. "tasks.move") and the parameters it would send.
+type dryRunEnvelope struct {
+	DryRun    bool           `json:"dryRun"`
+	Operation string         `json:"operation"`
+	Params    map[string]any `json:"params"`
+}
+
+// dryRunExit prints the request a mutating command would send when --dry-run
+// is set. It reports true when the caller should return without calling the API.
+func dryRunExit(ctx context.Context, flags *RootFlags, op string, payload map[string]any) (bool, error) {
+	if flags == nil || !flags.DryRun {
+		return false, nil
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if payload == nil {
+			payload = map[string]any{}
+		}
+		return true, outfmt.WriteJSONContext(ctx, os.Stdout, dryRunEnvelope{DryRun: true, Operation: op, Params: payload})
+	}
+
+	u := ui.FromContext(ctx)
+	if u == nil {
+		return true, nil
+	}
+	u.Out().Printf("dry-run\t%s", op)
+	keys := make([]string, 0, len(payload))
+	for k := range payload {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		u.Out().Printf("%s\t%v", k, payload[k])
+	}
+	return true, nil
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestDryRunExit_JSONEnvelope(t *testing.T) {
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+
+	var stop bool
+	out := captureStdout(t, func() {
+		var err error
+		stop, err = dryRunExit(ctx, &RootFlags{DryRun: true}, "calendar.purge", map[string]any{"count": 2})
+		if err != nil {
+			t.Fatalf("dryRunExit: %v", err)
+		}
+	})
+	if !stop {
+		t.Fatal("expected stop")
+	}
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed) != 3 || parsed["dryRun"] != true || parsed["operation"] != "calendar.purge" {
+		t.Fatalf("unexpected envelope: %#v", parsed)
+	}
+	if params, ok := parsed["params"].(map[string]any); !ok || params["count"] != float64(2) {
+		t.Fatalf("unexpected params: %#v", parsed["params"])
+	}
+
+	out = captureStdout(t, func() {
+		if _, err := dryRunExit(ctx, &RootFlags{DryRun: true}, "docs.batch", nil); err != nil {
+			t.Fatalf("dryRunExit: %v", err)
+		}
+	})
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if _, ok := parsed["params"].(map[string]any); !ok {
+		t.Fatalf("expected empty params object, got %#v", parsed["params"])
+	}
+}
+
+func TestDryRunExit_TextAndDisabled(t *testing.T) {
+	out := captureStdout(t, func() {
+		u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: io.Discard, Color: "never"})
+		if err != nil {
+			t.Fatalf("ui.New: %v", err)
+		}
+		ctx := ui.WithUI(context.Background(), u)
+		if stop, err := dryRunExit(ctx, &RootFlags{DryRun: true}, "tasks.move", map[string]any{"task": "t1"}); !stop || err != nil {
+			t.Fatalf("dryRunExit: %v %v", stop, err)
+		}
+	})
+	if out != "dry-run\ttasks.move\ntask\tt1\n" {
+		t.Fatalf("unexpected text output: %q", out)
+	}
+
+	if stop, err := dryRunExit(context.Background(), &RootFlags{}, "tasks.move", nil); stop || err != nil {
+		t.Fatalf("expected no-op without --dry-run, got %v %v", stop, err)
+	}
+}
@@ -129,7 +129,7 @@ func TestExecute_DrivePermissions_Text_WithPermissions(t *testing.T) {
 			}
 		})
 	})
-	if !strings.Contains(out, "ID") || !strings.Contains(out, "EMAIL") || !strings.Contains(out, "p1") || !strings.Contains(out, "p2") || !strings.Contains(out, "a@b.com") {
+	if !strings.Contains(out, "ID") || !strings.Contains(out, "PRINCIPAL") || !strings.Contains(out, "p1") || !strings.Contains(out, "p2") || !strings.Contains(out, "a@b.com") {
 		t.Fatalf("unexpected out=%q", out)
 	}
 }
@@ -173,7 +173,7 @@ func TestExecute_DriveSearch_Text(t *testing.T) {
 			t.Fatalf("unexpected stderr=%q", errOut)
 		}
 	})
-	if !strings.Contains(out, "ID") || !strings.Contains(out, "Doc") || !strings.Contains(out, "file") || !strings.Contains(out, "2025-12-12") {
+	if !strings.Contains(out, "ID") || !strings.Contains(out, "Doc") || !strings.Contains(out, "application/pdf") || !strings.Contains(out, "2025-12-12") {
 		t.Fatalf("unexpected out=%q", out)
 	}
 }
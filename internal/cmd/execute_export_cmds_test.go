@@ -264,3 +264,90 @@ func TestExecute_DocsExport_RejectsNonDoc(t *testing.T) {
 		t.Fatalf("export should not be called")
 	}
 }
+
+func TestExecute_SheetsExport_GIDAndTSV(t *testing.T) {
+	origNew := newDriveService
+	origExport := driveExportDownload
+	origTab := sheetsExportTabDownload
+	t.Cleanup(func() {
+		newDriveService = origNew
+		driveExportDownload = origExport
+		sheetsExportTabDownload = origTab
+	})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":       "sheet1",
+			"name":     "My Sheet",
+			"mimeType": "application/vnd.google-apps.spreadsheet",
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := drive.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return svc, nil }
+
+	var gotMime string
+	driveExportDownload = func(_ context.Context, _ *drive.Service, _ string, mimeType string) (*http.Response, error) {
+		gotMime = mimeType
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("a\tb\n"))}, nil
+	}
+	var gotTab []string
+	sheetsExportTabDownload = func(_ context.Context, account, spreadsheetID, format, gid string) (*http.Response, error) {
+		gotTab = []string{account, spreadsheetID, format, gid}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("x,y\n"))}, nil
+	}
+
+	type result struct {
+		Path   string `json:"path"`
+		Format string `json:"format"`
+		Bytes  int64  `json:"bytes"`
+	}
+	run := func(args ...string) result {
+		t.Helper()
+		out := captureStdout(t, func() {
+			_ = captureStderr(t, func() {
+				if err := Execute(append([]string{"--json", "--account", "a@b.com", "sheets", "export", "sheet1"}, args...)); err != nil {
+					t.Fatalf("Execute: %v", err)
+				}
+			})
+		})
+		var parsed result
+		if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+			t.Fatalf("json parse: %v\nout=%q", err, out)
+		}
+		return parsed
+	}
+
+	dir := t.TempDir()
+	got := run("--format", "csv", "--gid", "123", "--out", filepath.Join(dir, "tab"))
+	if strings.Join(gotTab, ",") != "a@b.com,sheet1,csv,123" {
+		t.Fatalf("unexpected tab export: %v", gotTab)
+	}
+	if !strings.HasSuffix(got.Path, ".csv") || got.Format != "csv" || got.Bytes != 4 {
+		t.Fatalf("unexpected gid result: %+v", got)
+	}
+	if gotMime != "" {
+		t.Fatalf("--gid should bypass files.export, got mime %q", gotMime)
+	}
+
+	got = run("--format", "tsv", "--out", filepath.Join(dir, "all"))
+	if gotMime != "text/tab-separated-values" || !strings.HasSuffix(got.Path, ".tsv") || got.Format != "tsv" {
+		t.Fatalf("unexpected tsv result: %+v (mime=%q)", got, gotMime)
+	}
+
+	for _, args := range [][]string{{"--gid", "123"}, {"--format", "csv", "--gid", "abc"}} {
+		err := Execute(append([]string{"--json", "--account", "a@b.com", "sheets", "export", "sheet1"}, args...))
+		if err == nil || ExitCode(err) != 2 {
+			t.Fatalf("expected usage error for %v, got %v", args, err)
+		}
+	}
+}
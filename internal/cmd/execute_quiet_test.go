@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+)
+
+func TestExecute_Quiet(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("pageToken") == "boom" {
+			http.Error(w, `{"error":{"code":400,"message":"bad page token"}}`, http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items":         []map[string]any{{"id": "l1", "title": "Inbox"}},
+			"nextPageToken": "p2",
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	for _, args := range [][]string{
+		{"--quiet", "--account", "a@b.com", "tasks", "lists"},
+		{"--quiet", "--json", "--account", "a@b.com", "tasks", "lists"},
+	} {
+		var stdout string
+		stderr := captureStderr(t, func() {
+			stdout = captureStdout(t, func() {
+				if err := Execute(args); err != nil {
+					t.Fatalf("Execute %v: %v", args, err)
+				}
+			})
+		})
+		if stderr != "" {
+			t.Fatalf("expected no stderr with --quiet, got %q", stderr)
+		}
+		if !strings.Contains(stdout, "Inbox") {
+			t.Fatalf("stdout missing results for %v: %q", args, stdout)
+		}
+	}
+
+	// Real errors are still reported.
+	stderr := captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			if err := Execute([]string{"--quiet", "--account", "a@b.com", "tasks", "lists", "--page", "boom"}); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	})
+	if !strings.Contains(stderr, "bad page token") {
+		t.Fatalf("expected error on stderr, got %q", stderr)
+	}
+}
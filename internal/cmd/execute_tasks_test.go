@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -545,3 +547,216 @@ func TestExecute_TasksClear_JSON(t *testing.T) {
 		t.Fatalf("unexpected response: %#v", parsed)
 	}
 }
+
+func TestExecute_TasksListsRenameDelete_JSON(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/tasks/v1/users/@me/lists" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{{"id": "l1", "title": "Groceries"}},
+			})
+		case r.URL.Path == "/tasks/v1/users/@me/lists/l1" && r.Method == http.MethodPatch:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "l1", "title": body["title"]})
+		case r.URL.Path == "/tasks/v1/users/@me/lists/l1" && r.Method == http.MethodDelete:
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--account", "a@b.com", "tasks", "lists", "rename", "groceries", "--title", "Shopping"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Tasklist struct {
+			ID    string `json:"id"`
+			Title string `json:"title"`
+		} `json:"tasklist"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Tasklist.ID != "l1" || parsed.Tasklist.Title != "Shopping" {
+		t.Fatalf("unexpected tasklist: %#v", parsed.Tasklist)
+	}
+
+	_ = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--force", "--account", "a@b.com", "tasks", "lists", "delete", "Groceries"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !deleted {
+		t.Fatalf("expected tasklist delete")
+	}
+}
+
+func TestExecute_TasksLists_Fields(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items":         []map[string]any{{"id": "l1", "title": "One"}},
+			"nextPageToken": "p2",
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "--fields", "tasklists.id,nope", "--account", "a@b.com", "tasks", "lists"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed) != 1 || strings.Contains(out, "title") || !strings.Contains(out, `"id": "l1"`) {
+		t.Fatalf("unexpected projected output: %s", out)
+	}
+}
+
+func TestExecute_TasksList_CSV(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{
+				{"id": "t1", "title": "Buy milk, eggs", "status": "needsAction", "position": "00000000000000000001"},
+				{"id": "t2", "title": `Say "hi"`, "status": "completed"},
+			},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--csv", "--account", "a@b.com", "tasks", "list", "l1"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	want := "ID,TITLE,STATUS,DUE,UPDATED,POSITION\r\n" +
+		"t1,\"Buy milk, eggs\",needsAction,,,00000000000000000001\r\n" +
+		"t2,\"Say \"\"hi\"\"\",completed,,,\r\n"
+	if out != want {
+		t.Fatalf("unexpected csv:\n%q\nwant\n%q", out, want)
+	}
+
+	_ = captureStderr(t, func() {
+		err := Execute([]string{"--csv", "--account", "a@b.com", "tasks", "get", "l1", "t1"})
+		if ExitCode(err) != 2 {
+			t.Fatalf("expected usage error for unsupported --csv, got %v", err)
+		}
+	})
+}
+
+func TestExecute_TasksLists_Template(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{{"id": "l1", "title": "One"}, {"id": "l2", "title": "Two"}},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--template", `{{range .tasklists}}{{.id}}={{.title}}\n{{end}}`, "--account", "a@b.com", "tasks", "lists"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if out != "l1=One\nl2=Two\n" {
+		t.Fatalf("unexpected template output: %q", out)
+	}
+
+	path := filepath.Join(t.TempDir(), "out.tmpl")
+	if err := os.WriteFile(path, []byte("{{len .tasklists}}\n"), 0o600); err != nil {
+		t.Fatalf("write template: %v", err)
+	}
+	out = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--template-file", path, "--account", "a@b.com", "tasks", "lists"}); err != nil {
+				t.Fatalf("Execute file: %v", err)
+			}
+		})
+	})
+	if out != "2\n" {
+		t.Fatalf("unexpected template file output: %q", out)
+	}
+
+	_ = captureStderr(t, func() {
+		if err := Execute([]string{"--template", "{{range .x}", "--account", "a@b.com", "tasks", "lists"}); ExitCode(err) != 2 {
+			t.Fatalf("expected usage error for bad template, got %v", err)
+		}
+	})
+}
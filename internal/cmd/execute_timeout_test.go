@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+)
+
+func TestExecute_Timeout(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	var runErr error
+	stderr := captureStderr(t, func() {
+		_ = captureStdout(t, func() {
+			runErr = Execute([]string{"--command-timeout", "50ms", "--max-retries", "0", "--account", "a@b.com", "tasks", "lists"})
+		})
+	})
+	if !errors.Is(runErr, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", runErr)
+	}
+	if !strings.Contains(stderr, "timed out after 50ms (--command-timeout)") {
+		t.Fatalf("unexpected stderr: %q", stderr)
+	}
+}
+
+func TestTimeoutError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := timeoutError(ctx, context.Canceled, 0); !errors.Is(err, context.Canceled) || strings.Contains(err.Error(), "timed out") {
+		t.Fatalf("cancellation should pass through, got %v", err)
+	}
+	other := errors.New("boom")
+	if err := timeoutError(context.Background(), other, 1); err != other {
+		t.Fatalf("unrelated error should pass through, got %v", err)
+	}
+}
@@ -2,6 +2,9 @@ package cmd
 
 import "errors"
 
+// emptyResultsExitCode is returned by list commands run with --fail-empty.
+const emptyResultsExitCode = 3
+
 type ExitError struct {
 	Code int
 	Err  error
@@ -34,3 +37,11 @@ func ExitCode(err error) int {
 	}
 	return 1
 }
+
+// failEmptyExit returns an exit error with emptyResultsExitCode when empty is true.
+func failEmptyExit(empty bool) error {
+	if !empty {
+		return nil
+	}
+	return &ExitError{Code: emptyResultsExitCode, Err: errors.New("no results")}
+}
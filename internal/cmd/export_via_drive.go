@@ -17,6 +17,8 @@ type exportViaDriveOptions struct {
 	KindLabel     string
 	DefaultFormat string
 	FormatHelp    string
+	// SheetGID exports a single sheet tab (csv/tsv only) instead of the first one.
+	SheetGID string
 }
 
 const defaultExportFormat = "pdf"
@@ -74,13 +76,26 @@ func exportViaDrive(ctx context.Context, flags *RootFlags, opts exportViaDriveOp
 		format = defaultExportFormat
 	}
 
-	downloadedPath, size, err := downloadDriveFile(ctx, svc, meta, destPath, format)
+	var (
+		downloadedPath string
+		size           int64
+	)
+	if gid := strings.TrimSpace(opts.SheetGID); gid != "" {
+		downloadedPath, size, err = downloadSheetTab(ctx, account, meta.Id, destPath, format, gid)
+	} else {
+		downloadedPath, size, err = downloadDriveFile(ctx, svc, meta, destPath, format)
+	}
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"path": downloadedPath, "size": size})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"path":   downloadedPath,
+			"format": strings.ToLower(format),
+			"bytes":  size,
+			"size":   size,
+		})
 	}
 	u.Out().Printf("path\t%s", downloadedPath)
 	u.Out().Printf("size\t%s", formatDriveSize(size))
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+)
+
+func TestExecute_FieldsPreset(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"items": []map[string]any{{
+				"id": "t1", "title": "Ship", "status": "needsAction", "due": "2026-01-01T00:00:00.000Z",
+				"notes": "long notes", "etag": "e1", "selfLink": "https://example.com/t1",
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	out := captureStdout(t, func() {
+		if err := Execute([]string{"--json", "--fields-preset", "minimal", "--account", "a@b.com", "tasks", "list", "l1"}); err != nil {
+			t.Fatalf("Execute: %v", err)
+		}
+	})
+	var parsed struct {
+		Tasks []map[string]any `json:"tasks"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if len(parsed.Tasks) != 1 || len(parsed.Tasks[0]) != 4 || parsed.Tasks[0]["title"] != "Ship" {
+		t.Fatalf("unexpected projection: %s", out)
+	}
+
+	for _, tc := range []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--json", "--preset", "huge", "--account", "a@b.com", "tasks", "list", "l1"}, "available: minimal"},
+		{[]string{"--json", "--preset", "minimal", "--account", "a@b.com", "tasks", "lists"}, "not supported"},
+	} {
+		var runErr error
+		_ = captureStderr(t, func() {
+			runErr = Execute(tc.args)
+		})
+		if runErr == nil || !strings.Contains(runErr.Error(), tc.want) {
+			t.Fatalf("%v: expected %q, got %v", tc.args, tc.want, runErr)
+		}
+	}
+}
@@ -20,10 +20,12 @@ var newGmailService = googleapi.NewGmail
 
 type GmailCmd struct {
 	Search     GmailSearchCmd     `cmd:"" name:"search" group:"Read" help:"Search threads using Gmail query syntax"`
+	List       GmailListCmd       `cmd:"" name:"list" aliases:"ls" group:"Read" help:"List messages (optionally filtered by query/label)"`
 	Messages   GmailMessagesCmd   `cmd:"" name:"messages" group:"Read" help:"Message operations"`
 	Thread     GmailThreadCmd     `cmd:"" name:"thread" aliases:"read" group:"Organize" help:"Thread operations (get, modify)"`
 	Get        GmailGetCmd        `cmd:"" name:"get" group:"Read" help:"Get a message (full|metadata|raw)"`
 	Attachment GmailAttachmentCmd `cmd:"" name:"attachment" group:"Read" help:"Download a single attachment"`
+	Export     GmailExportCmd     `cmd:"" name:"export" group:"Read" help:"Export a message as an .eml file"`
 	URL        GmailURLCmd        `cmd:"" name:"url" group:"Read" help:"Print Gmail web URLs for threads"`
 	History    GmailHistoryCmd    `cmd:"" name:"history" group:"Read" help:"Gmail history"`
 
@@ -65,6 +67,8 @@ type GmailSearchCmd struct {
 	Local    bool     `name:"local" help:"Use local timezone (default behavior, useful to override --timezone)"`
 }
 
+func (*GmailSearchCmd) allAccounts() {}
+
 func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -91,7 +95,7 @@ func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	idToName, err := fetchLabelIDToName(svc)
+	idToName, err := fetchLabelIDToName(ctx, svc)
 	if err != nil {
 		return err
 	}
@@ -108,7 +112,7 @@ func (c *GmailSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"threads":       items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -31,13 +31,13 @@ func (c *GmailAutoForwardGetCmd) Run(ctx context.Context, flags *RootFlags) erro
 		return err
 	}
 
-	autoForward, err := svc.Users.Settings.GetAutoForwarding("me").Do()
+	autoForward, err := svc.Users.Settings.GetAutoForwarding("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"autoForwarding": autoForward})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"autoForwarding": autoForward})
 	}
 
 	u.Out().Printf("enabled\t%t", autoForward.Enabled)
@@ -74,7 +74,7 @@ func (c *GmailAutoForwardUpdateCmd) Run(ctx context.Context, kctx *kong.Context,
 	}
 
 	// Get current settings first
-	current, err := svc.Users.Settings.GetAutoForwarding("me").Do()
+	current, err := svc.Users.Settings.GetAutoForwarding("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -110,13 +110,13 @@ func (c *GmailAutoForwardUpdateCmd) Run(ctx context.Context, kctx *kong.Context,
 		autoForward.Disposition = c.Disposition
 	}
 
-	updated, err := svc.Users.Settings.UpdateAutoForwarding("me", autoForward).Do()
+	updated, err := svc.Users.Settings.UpdateAutoForwarding("me", autoForward).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"autoForwarding": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"autoForwarding": updated})
 	}
 
 	u.Out().Println("Auto-forwarding settings updated successfully")
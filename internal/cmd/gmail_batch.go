@@ -34,13 +34,13 @@ func (c *GmailBatchDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 
 	err = svc.Users.Messages.BatchDelete("me", &gmail.BatchDeleteMessagesRequest{
 		Ids: c.MessageIDs,
-	}).Do()
+	}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted": c.MessageIDs,
 			"count":   len(c.MessageIDs),
 		})
@@ -74,7 +74,7 @@ func (c *GmailBatchModifyCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	idMap, err := fetchLabelNameToID(svc)
+	idMap, err := fetchLabelNameToID(ctx, svc)
 	if err != nil {
 		return err
 	}
@@ -86,13 +86,13 @@ func (c *GmailBatchModifyCmd) Run(ctx context.Context, flags *RootFlags) error {
 		Ids:            c.MessageIDs,
 		AddLabelIds:    addIDs,
 		RemoveLabelIds: removeIDs,
-	}).Do()
+	}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"modified":      c.MessageIDs,
 			"count":         len(c.MessageIDs),
 			"addedLabels":   addIDs,
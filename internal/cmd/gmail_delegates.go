@@ -34,13 +34,13 @@ func (c *GmailDelegatesListCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	resp, err := svc.Users.Settings.Delegates.List("me").Do()
+	resp, err := svc.Users.Settings.Delegates.List("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"delegates": resp.Delegates})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"delegates": resp.Delegates})
 	}
 
 	if len(resp.Delegates) == 0 {
@@ -79,13 +79,13 @@ func (c *GmailDelegatesGetCmd) Run(ctx context.Context, flags *RootFlags) error
 	if delegateEmail == "" {
 		return usage("empty delegateEmail")
 	}
-	delegate, err := svc.Users.Settings.Delegates.Get("me", delegateEmail).Do()
+	delegate, err := svc.Users.Settings.Delegates.Get("me", delegateEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"delegate": delegate})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"delegate": delegate})
 	}
 
 	u.Out().Printf("delegate_email\t%s", delegate.DelegateEmail)
@@ -117,13 +117,13 @@ func (c *GmailDelegatesAddCmd) Run(ctx context.Context, flags *RootFlags) error
 		DelegateEmail: delegateEmail,
 	}
 
-	created, err := svc.Users.Settings.Delegates.Create("me", delegate).Do()
+	created, err := svc.Users.Settings.Delegates.Create("me", delegate).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"delegate": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"delegate": created})
 	}
 
 	u.Out().Println("Delegate added successfully")
@@ -153,13 +153,13 @@ func (c *GmailDelegatesRemoveCmd) Run(ctx context.Context, flags *RootFlags) err
 	if delegateEmail == "" {
 		return usage("empty delegateEmail")
 	}
-	err = svc.Users.Settings.Delegates.Delete("me", delegateEmail).Do()
+	err = svc.Users.Settings.Delegates.Delete("me", delegateEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"success":       true,
 			"delegateEmail": delegateEmail,
 		})
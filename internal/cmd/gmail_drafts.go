@@ -40,7 +40,7 @@ func (c *GmailDraftsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	resp, err := svc.Users.Drafts.List("me").MaxResults(c.Max).PageToken(c.Page).Do()
+	resp, err := svc.Users.Drafts.List("me").MaxResults(c.Max).PageToken(c.Page).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -62,7 +62,7 @@ func (c *GmailDraftsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 			}
 			items = append(items, item{ID: d.Id, MessageID: msgID, ThreadID: threadID})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"drafts":        items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -107,13 +107,13 @@ func (c *GmailDraftsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	draft, err := svc.Users.Drafts.Get("me", draftID).Format("full").Do()
+	draft, err := svc.Users.Drafts.Get("me", draftID).Format("full").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if draft.Message == nil {
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"draft": draft})
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"draft": draft})
 		}
 		u.Err().Println("Empty draft")
 		return nil
@@ -133,7 +133,7 @@ func (c *GmailDraftsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 			}
 			out["downloaded"] = attachmentDownloadDraftOutputs(downloads)
 		}
-		return outfmt.WriteJSON(os.Stdout, out)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, out)
 	}
 
 	u.Out().Printf("Draft-ID: %s", draft.Id)
@@ -197,11 +197,11 @@ func (c *GmailDraftsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	if err := svc.Users.Drafts.Delete("me", draftID).Do(); err != nil {
+	if err := svc.Users.Drafts.Delete("me", draftID).Context(ctx).Do(); err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"deleted": true, "draftId": draftID})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"deleted": true, "draftId": draftID})
 	}
 	u.Out().Printf("deleted\ttrue")
 	u.Out().Printf("draft_id\t%s", draftID)
@@ -228,12 +228,12 @@ func (c *GmailDraftsSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	msg, err := svc.Users.Drafts.Send("me", &gmail.Draft{Id: draftID}).Do()
+	msg, err := svc.Users.Drafts.Send("me", &gmail.Draft{Id: draftID}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"messageId": msg.Id,
 			"threadId":  msg.ThreadId,
 		})
@@ -348,7 +348,7 @@ func writeDraftResult(ctx context.Context, u *ui.UI, draft *gmail.Draft, threadI
 		threadID = draft.Message.ThreadId
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"draftId":  draft.Id,
 			"message":  draft.Message,
 			"threadId": threadID,
@@ -403,7 +403,7 @@ func (c *GmailDraftsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	draft, err := svc.Users.Drafts.Create("me", &gmail.Draft{Message: msg}).Do()
+	draft, err := svc.Users.Drafts.Create("me", &gmail.Draft{Message: msg}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -451,7 +451,7 @@ func (c *GmailDraftsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error
 	existingThreadID := ""
 	existingTo := ""
 	if !toWasSet || strings.TrimSpace(c.ReplyToMessageID) == "" {
-		existing, fetchErr := svc.Users.Drafts.Get("me", draftID).Format("full").Do()
+		existing, fetchErr := svc.Users.Drafts.Get("me", draftID).Format("full").Context(ctx).Do()
 		if fetchErr != nil {
 			return fetchErr
 		}
@@ -498,7 +498,7 @@ func (c *GmailDraftsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	draft, err := svc.Users.Drafts.Update("me", draftID, &gmail.Draft{Id: draftID, Message: msg}).Do()
+	draft, err := svc.Users.Drafts.Update("me", draftID, &gmail.Draft{Id: draftID, Message: msg}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type GmailExportCmd struct {
+	MessageID string                 `arg:"" name:"messageId" help:"Message ID"`
+	Output    OutputPathRequiredFlag `embed:""`
+	Overwrite bool                   `name:"overwrite" help:"Overwrite output file if it exists"`
+}
+
+func (c *GmailExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	messageID := strings.TrimSpace(c.MessageID)
+	if messageID == "" {
+		return usage("empty messageId")
+	}
+	outPath := strings.TrimSpace(c.Output.Path)
+	if outPath == "" {
+		return usage("empty outPath")
+	}
+	outPath, err = config.ExpandPath(outPath)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	msg, err := svc.Users.Messages.Get("me", messageID).
+		Format(gmailFormatRaw).
+		Fields("id,raw").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if msg.Raw == "" {
+		return fmt.Errorf("message %s has no raw content", messageID)
+	}
+
+	n, err := writeRawMessage(outPath, msg.Raw, c.Overwrite)
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"id":    msg.Id,
+			"path":  outPath,
+			"bytes": n,
+		})
+	}
+	u.Out().Printf("id\t%s", msg.Id)
+	u.Out().Printf("path\t%s", outPath)
+	u.Out().Printf("bytes\t%d", n)
+	return nil
+}
+
+// writeRawMessage decodes Gmail's base64url "raw" field straight into the
+// output file so the decoded message is never held in memory as a second copy.
+// A partially written file is removed on failure.
+func writeRawMessage(outPath, raw string, overwrite bool) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(outPath), 0o700); err != nil {
+		return 0, err
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	}
+	f, err := os.OpenFile(outPath, flags, 0o600) //nolint:gosec // user-provided path
+	if err != nil {
+		return 0, err
+	}
+
+	// Gmail emits URL-safe base64; tolerate both padded and unpadded input.
+	dec := base64.NewDecoder(base64.RawURLEncoding, strings.NewReader(strings.TrimRight(raw, "=")))
+	n, copyErr := io.Copy(f, dec)
+	closeErr := f.Close()
+	if err := errors.Join(copyErr, closeErr); err != nil {
+		_ = os.Remove(outPath)
+		if copyErr != nil {
+			return 0, fmt.Errorf("decode raw message: %w", copyErr)
+		}
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestGmailExportCmd_WritesEML(t *testing.T) {
+	rawMsg := "From: a@example.com\r\nTo: b@example.com\r\nSubject: Hi\r\n\r\nHello?>\r\n"
+	var gotFormat string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.TrimPrefix(r.URL.Path, "/gmail/v1") != "/users/me/messages/m1" {
+			http.NotFound(w, r)
+			return
+		}
+		gotFormat = r.URL.Query().Get("format")
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"id":  "m1",
+			"raw": base64.URLEncoding.EncodeToString([]byte(rawMsg)),
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	origNew := newGmailService
+	t.Cleanup(func() { newGmailService = origNew })
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+	outPath := filepath.Join(t.TempDir(), "sub", "m1.eml")
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &GmailExportCmd{}, []string{"m1", "--out", outPath}, ctx, flags); err != nil {
+			t.Fatalf("export: %v", err)
+		}
+	})
+	if gotFormat != gmailFormatRaw {
+		t.Fatalf("expected format=raw, got %q", gotFormat)
+	}
+	data, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if string(data) != rawMsg {
+		t.Fatalf("unexpected file contents: %q", data)
+	}
+	var parsed struct {
+		ID    string `json:"id"`
+		Path  string `json:"path"`
+		Bytes int64  `json:"bytes"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if parsed.ID != "m1" || parsed.Path != outPath || parsed.Bytes != int64(len(rawMsg)) {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+
+	// Existing files are kept unless --overwrite is given.
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailExportCmd{}, []string{"m1", "--out", outPath}, ctx, flags); err == nil {
+			t.Fatal("expected error for existing file")
+		}
+		if err := runKong(t, &GmailExportCmd{}, []string{"m1", "--out", outPath, "--overwrite"}, ctx, flags); err != nil {
+			t.Fatalf("export --overwrite: %v", err)
+		}
+	})
+}
+
+func TestWriteRawMessage_InvalidBase64RemovesFile(t *testing.T) {
+	outPath := filepath.Join(t.TempDir(), "bad.eml")
+	if _, err := writeRawMessage(outPath, "!!not-base64!!", false); err == nil {
+		t.Fatal("expected decode error")
+	}
+	if _, err := os.Stat(outPath); !os.IsNotExist(err) {
+		t.Fatalf("expected partial file to be removed, stat err=%v", err)
+	}
+}
@@ -35,13 +35,13 @@ func (c *GmailFiltersListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	resp, err := svc.Users.Settings.Filters.List("me").Do()
+	resp, err := svc.Users.Settings.Filters.List("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"filters": resp.Filter})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"filters": resp.Filter})
 	}
 
 	if len(resp.Filter) == 0 {
@@ -94,13 +94,13 @@ func (c *GmailFiltersGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if filterID == "" {
 		return usage("empty filterId")
 	}
-	filter, err := svc.Users.Settings.Filters.Get("me", filterID).Do()
+	filter, err := svc.Users.Settings.Filters.Get("me", filterID).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"filter": filter})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"filter": filter})
 	}
 
 	u.Out().Printf("id\t%s", filter.Id)
@@ -212,7 +212,7 @@ func (c *GmailFiltersCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 	// Resolve label names to IDs for add/remove operations
 	var labelMap map[string]string
 	if c.AddLabel != "" || c.RemoveLabel != "" {
-		labelMap, err = fetchLabelNameToID(svc)
+		labelMap, err = fetchLabelNameToID(ctx, svc)
 		if err != nil {
 			return err
 		}
@@ -287,13 +287,13 @@ func (c *GmailFiltersCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 		Action:   action,
 	}
 
-	created, err := svc.Users.Settings.Filters.Create("me", filter).Do()
+	created, err := svc.Users.Settings.Filters.Create("me", filter).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"filter": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"filter": created})
 	}
 
 	u.Out().Println("Filter created successfully")
@@ -336,13 +336,13 @@ func (c *GmailFiltersDeleteCmd) Run(ctx context.Context, flags *RootFlags) error
 	if filterID == "" {
 		return usage("empty filterId")
 	}
-	err = svc.Users.Settings.Filters.Delete("me", filterID).Do()
+	err = svc.Users.Settings.Filters.Delete("me", filterID).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"success":  true,
 			"filterId": filterID,
 		})
@@ -34,13 +34,13 @@ func (c *GmailForwardingListCmd) Run(ctx context.Context, flags *RootFlags) erro
 		return err
 	}
 
-	resp, err := svc.Users.Settings.ForwardingAddresses.List("me").Do()
+	resp, err := svc.Users.Settings.ForwardingAddresses.List("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"forwardingAddresses": resp.ForwardingAddresses})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"forwardingAddresses": resp.ForwardingAddresses})
 	}
 
 	if len(resp.ForwardingAddresses) == 0 {
@@ -79,13 +79,13 @@ func (c *GmailForwardingGetCmd) Run(ctx context.Context, flags *RootFlags) error
 	if forwardingEmail == "" {
 		return usage("empty forwardingEmail")
 	}
-	address, err := svc.Users.Settings.ForwardingAddresses.Get("me", forwardingEmail).Do()
+	address, err := svc.Users.Settings.ForwardingAddresses.Get("me", forwardingEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"forwardingAddress": address})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"forwardingAddress": address})
 	}
 
 	u.Out().Printf("forwarding_email\t%s", address.ForwardingEmail)
@@ -117,13 +117,13 @@ func (c *GmailForwardingCreateCmd) Run(ctx context.Context, flags *RootFlags) er
 		ForwardingEmail: forwardingEmail,
 	}
 
-	created, err := svc.Users.Settings.ForwardingAddresses.Create("me", address).Do()
+	created, err := svc.Users.Settings.ForwardingAddresses.Create("me", address).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"forwardingAddress": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"forwardingAddress": created})
 	}
 
 	u.Out().Println("Forwarding address created successfully")
@@ -154,13 +154,13 @@ func (c *GmailForwardingDeleteCmd) Run(ctx context.Context, flags *RootFlags) er
 	if forwardingEmail == "" {
 		return usage("empty forwardingEmail")
 	}
-	err = svc.Users.Settings.ForwardingAddresses.Delete("me", forwardingEmail).Do()
+	err = svc.Users.Settings.ForwardingAddresses.Delete("me", forwardingEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"success":         true,
 			"forwardingEmail": forwardingEmail,
 		})
@@ -39,14 +39,14 @@ func (c *GmailHistoryCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if strings.TrimSpace(c.Page) != "" {
 		call.PageToken(c.Page)
 	}
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	ids := collectHistoryMessageIDs(resp)
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"historyId":     formatHistoryID(resp.HistoryId),
 			"messages":      ids,
 			"nextPageToken": resp.NextPageToken,
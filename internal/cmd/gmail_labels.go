@@ -17,6 +17,9 @@ type GmailLabelsCmd struct {
 	Get    GmailLabelsGetCmd    `cmd:"" name:"get" help:"Get label details (including counts)"`
 	Create GmailLabelsCreateCmd `cmd:"" name:"create" help:"Create a new label"`
 	Modify GmailLabelsModifyCmd `cmd:"" name:"modify" help:"Modify labels on threads"`
+	Delete GmailLabelsDeleteCmd `cmd:"" name:"delete" aliases:"rm" help:"Delete a user label"`
+	Apply  GmailLabelsApplyCmd  `cmd:"" name:"apply" help:"Add labels to messages"`
+	Remove GmailLabelsRemoveCmd `cmd:"" name:"remove" help:"Remove labels from messages"`
 }
 
 type GmailLabelsGetCmd struct {
@@ -34,7 +37,7 @@ func (c *GmailLabelsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	idMap, err := fetchLabelNameToID(svc)
+	idMap, err := fetchLabelNameToID(ctx, svc)
 	if err != nil {
 		return err
 	}
@@ -52,7 +55,7 @@ func (c *GmailLabelsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"label": l})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"label": l})
 	}
 	u := ui.FromContext(ctx)
 	u.Out().Printf("id\t%s", l.Id)
@@ -86,7 +89,7 @@ func (c *GmailLabelsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	err = ensureLabelNameAvailable(svc, name)
+	err = ensureLabelNameAvailable(ctx, svc, name)
 	if err != nil {
 		return err
 	}
@@ -97,7 +100,7 @@ func (c *GmailLabelsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"label": label})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"label": label})
 	}
 	u.Out().Printf("Created label: %s (id: %s)", label.Name, label.Id)
 	return nil
@@ -130,7 +133,7 @@ func (c *GmailLabelsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"labels": resp.Labels})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"labels": resp.Labels})
 	}
 	if len(resp.Labels) == 0 {
 		u.Err().Println("No labels")
@@ -170,7 +173,7 @@ func (c *GmailLabelsModifyCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	idMap, err := fetchLabelNameToID(svc)
+	idMap, err := fetchLabelNameToID(ctx, svc)
 	if err != nil {
 		return err
 	}
@@ -203,13 +206,153 @@ func (c *GmailLabelsModifyCmd) Run(ctx context.Context, flags *RootFlags) error
 		}
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"results": results})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"results": results})
 	}
 	return nil
 }
 
-func fetchLabelNameToID(svc *gmail.Service) (map[string]string, error) {
-	resp, err := svc.Users.Labels.List("me").Do()
+type GmailLabelsDeleteCmd struct {
+	Label string `arg:"" name:"labelIdOrName" help:"Label ID or name"`
+}
+
+func (c *GmailLabelsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	raw := strings.TrimSpace(c.Label)
+	if raw == "" {
+		return usage("empty label")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	idMap, err := fetchLabelNameToID(ctx, svc)
+	if err != nil {
+		return err
+	}
+	ids, err := resolveExistingLabelIDs([]string{raw}, idMap)
+	if err != nil {
+		return err
+	}
+	label, err := svc.Users.Labels.Get("me", ids[0]).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if label.Type == "system" {
+		return usagef("cannot delete system label %s", label.Name)
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete label %s", label.Name)); confirmErr != nil {
+		return confirmErr
+	}
+	if err := svc.Users.Labels.Delete("me", label.Id).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"deleted": true,
+			"id":      label.Id,
+			"name":    label.Name,
+		})
+	}
+	u.Out().Printf("deleted\ttrue")
+	u.Out().Printf("id\t%s", label.Id)
+	u.Out().Printf("name\t%s", label.Name)
+	return nil
+}
+
+type GmailLabelsApplyCmd struct {
+	MessageIDs []string `arg:"" name:"messageId" help:"Message IDs"`
+	Label      string   `name:"label" help:"Labels to add (comma-separated, name or ID)" required:""`
+}
+
+func (c *GmailLabelsApplyCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return modifyMessageLabels(ctx, flags, c.MessageIDs, c.Label, true)
+}
+
+type GmailLabelsRemoveCmd struct {
+	MessageIDs []string `arg:"" name:"messageId" help:"Message IDs"`
+	Label      string   `name:"label" help:"Labels to remove (comma-separated, name or ID)" required:""`
+}
+
+func (c *GmailLabelsRemoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	return modifyMessageLabels(ctx, flags, c.MessageIDs, c.Label, false)
+}
+
+func modifyMessageLabels(ctx context.Context, flags *RootFlags, messageIDs []string, labels string, add bool) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	names := splitCSV(labels)
+	if len(names) == 0 {
+		return usage("required: --label")
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	idMap, err := fetchLabelNameToID(ctx, svc)
+	if err != nil {
+		return err
+	}
+	ids, err := resolveExistingLabelIDs(names, idMap)
+	if err != nil {
+		return err
+	}
+
+	req := &gmail.ModifyMessageRequest{}
+	if add {
+		req.AddLabelIds = ids
+	} else {
+		req.RemoveLabelIds = ids
+	}
+
+	modified := make([]*gmail.Message, 0, len(messageIDs))
+	for _, id := range messageIDs {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			continue
+		}
+		msg, modErr := svc.Users.Messages.Modify("me", id, req).Context(ctx).Do()
+		if modErr != nil {
+			return fmt.Errorf("modify message %s (after %d modified): %w", id, len(modified), modErr)
+		}
+		modified = append(modified, msg)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"messages": modified})
+	}
+	idToName, err := fetchLabelIDToName(ctx, svc)
+	if err != nil {
+		return err
+	}
+	for _, msg := range modified {
+		names := make([]string, 0, len(msg.LabelIds))
+		for _, lid := range msg.LabelIds {
+			if n, ok := idToName[lid]; ok {
+				names = append(names, n)
+			} else {
+				names = append(names, lid)
+			}
+		}
+		u.Out().Printf("%s\t%s", msg.Id, strings.Join(names, ","))
+	}
+	return nil
+}
+
+func fetchLabelNameToID(ctx context.Context, svc *gmail.Service) (map[string]string, error) {
+	resp, err := svc.Users.Labels.List("me").Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -226,8 +369,8 @@ func fetchLabelNameToID(svc *gmail.Service) (map[string]string, error) {
 	return m, nil
 }
 
-func fetchLabelIDToName(svc *gmail.Service) (map[string]string, error) {
-	resp, err := svc.Users.Labels.List("me").Do()
+func fetchLabelIDToName(ctx context.Context, svc *gmail.Service) (map[string]string, error) {
+	resp, err := svc.Users.Labels.List("me").Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
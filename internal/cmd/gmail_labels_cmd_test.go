@@ -546,7 +546,7 @@ func TestFetchLabelIDToName(t *testing.T) {
 		t.Fatalf("NewService: %v", err)
 	}
 
-	m, err := fetchLabelIDToName(svc)
+	m, err := fetchLabelIDToName(context.Background(), svc)
 	if err != nil {
 		t.Fatalf("fetchLabelIDToName: %v", err)
 	}
@@ -561,3 +561,82 @@ func TestFetchLabelIDToName(t *testing.T) {
 		t.Fatalf("unexpected label2: %q", m["Label_2"])
 	}
 }
+
+func TestGmailLabelsApplyRemoveDeleteCmd(t *testing.T) {
+	var (
+		modifyBodies []gmail.ModifyMessageRequest
+		deletedID    string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/gmail/v1")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && path == "/users/me/labels":
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{
+				{"id": "INBOX", "name": "INBOX", "type": "system"},
+				{"id": "Label_42", "name": "Important stuff", "type": "user"},
+			}})
+		case r.Method == http.MethodGet && strings.HasPrefix(path, "/users/me/labels/"):
+			id := strings.TrimPrefix(path, "/users/me/labels/")
+			typ := "user"
+			if id == "INBOX" {
+				typ = "system"
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "name": id, "type": typ})
+		case r.Method == http.MethodDelete && strings.HasPrefix(path, "/users/me/labels/"):
+			deletedID = strings.TrimPrefix(path, "/users/me/labels/")
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && strings.HasPrefix(path, "/users/me/messages/") && strings.HasSuffix(path, "/modify"):
+			var body gmail.ModifyMessageRequest
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			modifyBodies = append(modifyBodies, body)
+			id := strings.TrimSuffix(strings.TrimPrefix(path, "/users/me/messages/"), "/modify")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "labelIds": body.AddLabelIds})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	stubGmailService(t, srv)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com", Force: true}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &GmailLabelsApplyCmd{}, []string{"m1", "m2", "--label", "important stuff"}, ctx, flags); err != nil {
+			t.Fatalf("apply: %v", err)
+		}
+	})
+	if len(modifyBodies) != 2 || modifyBodies[0].AddLabelIds[0] != "Label_42" || !strings.Contains(out, `"m2"`) {
+		t.Fatalf("unexpected apply: %#v %s", modifyBodies, out)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &GmailLabelsRemoveCmd{}, []string{"m1", "--label", "INBOX"}, ctx, flags); err != nil {
+			t.Fatalf("remove: %v", err)
+		}
+	})
+	if len(modifyBodies) != 3 || modifyBodies[2].RemoveLabelIds[0] != "INBOX" || len(modifyBodies[2].AddLabelIds) != 0 {
+		t.Fatalf("unexpected remove: %#v", modifyBodies[2])
+	}
+
+	if err := runKong(t, &GmailLabelsApplyCmd{}, []string{"m1", "--label", "missing"}, ctx, flags); err == nil {
+		t.Fatalf("expected unknown label error")
+	}
+	if err := runKong(t, &GmailLabelsDeleteCmd{}, []string{"INBOX"}, ctx, flags); err == nil {
+		t.Fatalf("expected system label error")
+	}
+
+	out = captureStdout(t, func() {
+		if err := runKong(t, &GmailLabelsDeleteCmd{}, []string{"Important stuff"}, ctx, flags); err != nil {
+			t.Fatalf("delete: %v", err)
+		}
+	})
+	if deletedID != "Label_42" || !strings.Contains(out, `"deleted": true`) {
+		t.Fatalf("unexpected delete: %q %s", deletedID, out)
+	}
+}
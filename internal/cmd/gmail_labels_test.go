@@ -21,3 +21,18 @@ func TestFetchLabelIDToNameBehavior(t *testing.T) {
 	// the helper exists and returns a map. (Compile-time coverage.)
 	_ = fetchLabelIDToName
 }
+
+func TestResolveExistingLabelIDs(t *testing.T) {
+	m := map[string]string{
+		"inbox":     "INBOX",
+		"important": "Label_42",
+		"label_42":  "Label_42",
+	}
+	got, err := resolveExistingLabelIDs([]string{"Important", " label_42 ", "inbox"}, m)
+	if err != nil || len(got) != 3 || got[0] != "Label_42" || got[1] != "Label_42" || got[2] != "INBOX" {
+		t.Fatalf("unexpected: %#v %v", got, err)
+	}
+	if _, err := resolveExistingLabelIDs([]string{"Nope"}, m); err == nil {
+		t.Fatalf("expected unknown label error")
+	}
+}
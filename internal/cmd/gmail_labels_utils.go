@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -30,8 +31,26 @@ func resolveLabelIDs(labels []string, nameToID map[string]string) []string {
 	return out
 }
 
-func ensureLabelNameAvailable(svc *gmail.Service, name string) error {
-	idMap, err := fetchLabelNameToID(svc)
+// resolveExistingLabelIDs maps label names or IDs to IDs, rejecting labels
+// that do not exist instead of passing them through to the API.
+func resolveExistingLabelIDs(labels []string, nameToID map[string]string) ([]string, error) {
+	out := make([]string, 0, len(labels))
+	for _, label := range labels {
+		trimmed := strings.TrimSpace(label)
+		if trimmed == "" {
+			continue
+		}
+		id, ok := nameToID[strings.ToLower(trimmed)]
+		if !ok {
+			return nil, usagef("unknown label: %s", trimmed)
+		}
+		out = append(out, id)
+	}
+	return out, nil
+}
+
+func ensureLabelNameAvailable(ctx context.Context, svc *gmail.Service, name string) error {
+	idMap, err := fetchLabelNameToID(ctx, svc)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/api/gmail/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type GmailListCmd struct {
+	Query     string         `name:"query" short:"q" help:"Gmail search query (e.g. 'from:alice is:unread')"`
+	Label     []string       `name:"label" help:"Only messages with this label (name or ID; repeatable)"`
+	Max       int64          `name:"max" aliases:"limit" help:"Max results per page" default:"20"`
+	Page      string         `name:"page" help:"Page token"`
+	All       bool           `name:"all" help:"Fetch all pages"`
+	Limits    PageLimitFlags `embed:""`
+	Timezone  string         `name:"timezone" short:"z" help:"Output timezone (IANA name, e.g. America/New_York, UTC). Default: local"`
+	Local     bool           `name:"local" help:"Use local timezone (default behavior, useful to override --timezone)"`
+	FailEmpty bool           `name:"fail-empty" help:"Exit with code 3 when no messages are found"`
+}
+
+func (*GmailListCmd) allAccounts()  {}
+func (*GmailListCmd) ndjsonStream() {}
+
+func (c *GmailListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	if c.Max < 1 {
+		return usage("--max must be >= 1")
+	}
+	if err := c.Limits.validate(); err != nil {
+		return err
+	}
+
+	loc, err := resolveOutputLocation(c.Timezone, c.Local)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newGmailService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	var labelIDs []string
+	if len(c.Label) > 0 {
+		nameToID, labelErr := fetchLabelNameToID(ctx, svc)
+		if labelErr != nil {
+			return labelErr
+		}
+		labelIDs = resolveLabelIDs(c.Label, nameToID)
+	}
+
+	query := strings.TrimSpace(c.Query)
+	fetch := func(pageToken string) ([]*gmail.Message, string, error) {
+		call := svc.Users.Messages.List("me").
+			MaxResults(c.Max).
+			Fields("messages(id,threadId),nextPageToken").
+			Context(ctx)
+		if query != "" {
+			call = call.Q(query)
+		}
+		if len(labelIDs) > 0 {
+			call = call.LabelIds(labelIDs...)
+		}
+		if pageToken != "" {
+			call = call.PageToken(pageToken)
+		}
+		resp, listErr := call.Context(ctx).Do()
+		if listErr != nil {
+			return nil, "", listErr
+		}
+		return resp.Messages, resp.NextPageToken, nil
+	}
+
+	if outfmt.IsNDJSON(ctx) {
+		return c.stream(ctx, u, svc, fetch, loc)
+	}
+
+	var (
+		messages      []*gmail.Message
+		nextPageToken string
+	)
+	if c.All || c.Limits.set() {
+		messages, nextPageToken, err = collectPages(ctx, c.Page, c.Limits, fetch)
+	} else {
+		messages, nextPageToken, err = fetch(c.Page)
+	}
+	if err != nil {
+		return err
+	}
+
+	idToName, err := fetchLabelIDToName(ctx, svc)
+	if err != nil {
+		return err
+	}
+	items, err := fetchMessageDetails(ctx, svc, messages, idToName, loc, false)
+	if err != nil {
+		return err
+	}
+	if items == nil {
+		items = []messageItem{}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		if err := outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"messages":      items,
+			"nextPageToken": nextPageToken,
+		}); err != nil {
+			return err
+		}
+		return failEmptyExit(c.FailEmpty && len(items) == 0)
+	}
+
+	if len(items) == 0 {
+		u.Err().Println("No messages")
+		return failEmptyExit(c.FailEmpty)
+	}
+
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, "ID\tDATE\tFROM\tSUBJECT\tLABELS")
+	for _, it := range items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", it.ID, it.Date, it.From, it.Subject, strings.Join(it.Labels, ","))
+	}
+	printNextPageHint(u, nextPageToken)
+	return nil
+}
+
+// stream writes one message per line (--ndjson), resolving each page's
+// message details as soon as the page arrives.
+func (c *GmailListCmd) stream(ctx context.Context, u *ui.UI, svc *gmail.Service, fetch func(string) ([]*gmail.Message, string, error), loc *time.Location) error {
+	idToName, err := fetchLabelIDToName(ctx, svc)
+	if err != nil {
+		return err
+	}
+	fetchItems := func(pageToken string) ([]messageItem, string, error) {
+		messages, next, fetchErr := fetch(pageToken)
+		if fetchErr != nil {
+			return nil, "", fetchErr
+		}
+		items, detailErr := fetchMessageDetails(ctx, svc, messages, idToName, loc, false)
+		if detailErr != nil {
+			return nil, "", detailErr
+		}
+		return items, next, nil
+	}
+
+	count := 0
+	nextPageToken, err := streamPages(ctx, c.Page, streamLimits(c.All, c.Limits), fetchItems, func(item messageItem) error {
+		count++
+		return outfmt.WriteJSONContext(ctx, os.Stdout, item)
+	})
+	if err != nil {
+		return err
+	}
+	printNextPageHint(u, nextPageToken)
+	return failEmptyExit(c.FailEmpty && count == 0)
+}
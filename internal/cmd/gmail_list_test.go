@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/gmail/v1"
+	"google.golang.org/api/option"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func newGmailListTestService(t *testing.T, pages map[string]map[string]any) *[]string {
+	t.Helper()
+	var listQueries []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/gmail/v1")
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case path == "/users/me/labels":
+			_ = json.NewEncoder(w).Encode(map[string]any{"labels": []map[string]any{
+				{"id": "INBOX", "name": "INBOX"},
+				{"id": "Label_42", "name": "Receipts"},
+			}})
+		case path == "/users/me/messages":
+			listQueries = append(listQueries, r.URL.RawQuery)
+			_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("pageToken")])
+		case strings.HasPrefix(path, "/users/me/messages/"):
+			id := strings.TrimPrefix(path, "/users/me/messages/")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":       id,
+				"threadId": "t-" + id,
+				"labelIds": []string{"Label_42"},
+				"payload": map[string]any{"headers": []map[string]any{
+					{"name": "From", "value": "shop@example.com"},
+					{"name": "Subject", "value": "Order " + id},
+					{"name": "Date", "value": "Mon, 02 Jan 2006 15:04:05 +0000"},
+				}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	origNew := newGmailService
+	t.Cleanup(func() { newGmailService = origNew })
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return svc, nil }
+	return &listQueries
+}
+
+func TestGmailListCmd_AllPagesWithLabel(t *testing.T) {
+	queries := newGmailListTestService(t, map[string]map[string]any{
+		"":   {"messages": []map[string]any{{"id": "m1"}}, "nextPageToken": "p2"},
+		"p2": {"messages": []map[string]any{{"id": "m2"}}},
+	})
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		args := []string{"--query", "is:unread", "--label", "receipts", "--all"}
+		if err := runKong(t, &GmailListCmd{}, args, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+
+	if len(*queries) != 2 {
+		t.Fatalf("expected 2 list calls, got %v", *queries)
+	}
+	if !strings.Contains((*queries)[0], "labelIds=Label_42") || !strings.Contains((*queries)[0], "q=is%3Aunread") {
+		t.Fatalf("unexpected list query: %s", (*queries)[0])
+	}
+
+	var parsed struct {
+		Messages []messageItem `json:"messages"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if len(parsed.Messages) != 2 || parsed.Messages[1].Subject != "Order m2" || parsed.Messages[0].Labels[0] != "Receipts" {
+		t.Fatalf("unexpected messages: %#v", parsed.Messages)
+	}
+}
+
+func TestGmailListCmd_FailEmpty(t *testing.T) {
+	newGmailListTestService(t, map[string]map[string]any{"": {}})
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	var runErr error
+	_ = captureStderr(t, func() {
+		runErr = runKong(t, &GmailListCmd{}, []string{"--fail-empty"}, ctx, &RootFlags{Account: "a@b.com"})
+	})
+	var exitErr *ExitError
+	if !errors.As(runErr, &exitErr) || exitErr.Code != emptyResultsExitCode {
+		t.Fatalf("expected empty-results exit, got %v", runErr)
+	}
+}
@@ -54,7 +54,7 @@ func (c *GmailMessagesSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 		return err
 	}
 
-	idToName, err := fetchLabelIDToName(svc)
+	idToName, err := fetchLabelIDToName(ctx, svc)
 	if err != nil {
 		return err
 	}
@@ -70,7 +70,7 @@ func (c *GmailMessagesSearchCmd) Run(ctx context.Context, flags *RootFlags) erro
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"messages":      items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -24,6 +24,7 @@ type GmailSendCmd struct {
 	Body             string   `name:"body" help:"Body (plain text; required unless --body-html is set)"`
 	BodyFile         string   `name:"body-file" help:"Body file path (plain text; '-' for stdin)"`
 	BodyHTML         string   `name:"body-html" help:"Body (HTML; optional)"`
+	HTML             bool     `name:"html" help:"Treat --body/--body-file as HTML"`
 	ReplyToMessageID string   `name:"reply-to-message-id" aliases:"in-reply-to" help:"Reply to Gmail message ID (sets In-Reply-To/References and thread)"`
 	ThreadID         string   `name:"thread-id" help:"Reply within a Gmail thread (uses latest message for headers)"`
 	ReplyAll         bool     `name:"reply-all" help:"Auto-populate recipients from original message (requires --reply-to-message-id or --thread-id)"`
@@ -74,6 +75,13 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
+	bodyHTML := c.BodyHTML
+	if c.HTML {
+		if strings.TrimSpace(bodyHTML) != "" {
+			return usage("use only one of --html or --body-html")
+		}
+		bodyHTML, body = body, ""
+	}
 
 	if replyToMessageID != "" && threadID != "" {
 		return usage("use only one of --reply-to-message-id or --thread-id")
@@ -91,7 +99,7 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if strings.TrimSpace(c.Subject) == "" {
 		return usage("required: --subject")
 	}
-	if strings.TrimSpace(body) == "" && strings.TrimSpace(c.BodyHTML) == "" {
+	if strings.TrimSpace(body) == "" && strings.TrimSpace(bodyHTML) == "" {
 		return usage("required: --body, --body-file, or --body-html")
 	}
 	if c.TrackSplit && !c.Track {
@@ -169,6 +177,10 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		atts = append(atts, mailAttachment{Path: expanded})
 	}
 
+	if stop, dryErr := dryRunExit(ctx, flags, "gmail.send", sendDryRunPayload(fromAddr, toRecipients, ccRecipients, bccRecipients, c.ReplyTo, c.Subject, replyInfo, atts, bodyHTML != "")); stop || dryErr != nil {
+		return dryErr
+	}
+
 	var trackingCfg *tracking.Config
 	if c.Track {
 		trackingCfg, err = c.resolveTrackingConfig(account, toRecipients, ccRecipients, bccRecipients)
@@ -183,7 +195,7 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		ReplyTo:     c.ReplyTo,
 		Subject:     c.Subject,
 		Body:        body,
-		BodyHTML:    c.BodyHTML,
+		BodyHTML:    bodyHTML,
 		ReplyInfo:   replyInfo,
 		Attachments: atts,
 		Track:       c.Track,
@@ -196,6 +208,41 @@ func (c *GmailSendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	return writeSendResults(ctx, u, fromAddr, results)
 }
 
+// sendDryRunPayload describes the headers the message would be sent with.
+func sendDryRunPayload(from string, to, cc, bcc []string, replyTo, subject string, reply *replyInfo, atts []mailAttachment, html bool) map[string]any {
+	payload := map[string]any{
+		"from":    from,
+		"to":      strings.Join(to, ", "),
+		"subject": subject,
+		"html":    html,
+	}
+	if len(cc) > 0 {
+		payload["cc"] = strings.Join(cc, ", ")
+	}
+	if len(bcc) > 0 {
+		payload["bcc"] = strings.Join(bcc, ", ")
+	}
+	if strings.TrimSpace(replyTo) != "" {
+		payload["reply_to"] = replyTo
+	}
+	if reply != nil {
+		if reply.InReplyTo != "" {
+			payload["in_reply_to"] = reply.InReplyTo
+		}
+		if reply.ThreadID != "" {
+			payload["thread_id"] = reply.ThreadID
+		}
+	}
+	if len(atts) > 0 {
+		paths := make([]string, 0, len(atts))
+		for _, a := range atts {
+			paths = append(paths, a.Path)
+		}
+		payload["attachments"] = paths
+	}
+	return payload
+}
+
 func (c *GmailSendCmd) resolveTrackingConfig(account string, toRecipients, ccRecipients, bccRecipients []string) (*tracking.Config, error) {
 	totalRecipients := len(toRecipients) + len(ccRecipients) + len(bccRecipients)
 	if totalRecipients != 1 && !c.TrackSplit {
@@ -324,7 +371,7 @@ func writeSendResults(ctx context.Context, u *ui.UI, fromAddr string, results []
 			if results[0].TrackingID != "" {
 				resp["tracking_id"] = results[0].TrackingID
 			}
-			return outfmt.WriteJSON(os.Stdout, resp)
+			return outfmt.WriteJSONContext(ctx, os.Stdout, resp)
 		}
 
 		items := make([]map[string]any, 0, len(results))
@@ -342,7 +389,7 @@ func writeSendResults(ctx context.Context, u *ui.UI, fromAddr string, results []
 			}
 			items = append(items, item)
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"messages": items})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"messages": items})
 	}
 
 	if len(results) == 1 {
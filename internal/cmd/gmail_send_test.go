@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -990,3 +991,72 @@ func TestReplyAllValidation(t *testing.T) {
 		t.Error("--to should be optional when --reply-all is used")
 	}
 }
+
+func TestGmailSendCmd_HTMLBodyAndDryRun(t *testing.T) {
+	origNew := newGmailService
+	t.Cleanup(func() { newGmailService = origNew })
+
+	var sentRaw string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/gmail/v1")
+		if r.Method == http.MethodPost && path == "/users/me/messages/send" {
+			var msg gmail.Message
+			_ = json.NewDecoder(r.Body).Decode(&msg)
+			sentRaw = msg.Raw
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "m1", "threadId": "t1"})
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	svc, err := gmail.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newGmailService = func(context.Context, string) (*gmail.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	cmd := &GmailSendCmd{To: "a@example.com", Cc: "c@example.com", Subject: "Hello", Body: "<p>Hi</p>", HTML: true}
+	out := captureStdout(t, func() {
+		if err := cmd.Run(ctx, &RootFlags{Account: "a@b.com", DryRun: true}); err != nil {
+			t.Fatalf("Run: %v", err)
+		}
+	})
+	if sentRaw != "" {
+		t.Fatalf("dry run sent a message")
+	}
+	for _, want := range []string{`"dryRun": true`, `"subject": "Hello"`, `"cc": "c@example.com"`, `"html": true`} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("missing %s in %s", want, out)
+		}
+	}
+
+	_ = captureStdout(t, func() {
+		if err := cmd.Run(ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("Run: %v", err)
+		}
+	})
+	raw, err := base64.RawURLEncoding.DecodeString(sentRaw)
+	if err != nil {
+		t.Fatalf("decode raw: %v", err)
+	}
+	if !strings.Contains(string(raw), "text/html") || !strings.Contains(string(raw), "<p>Hi</p>") {
+		t.Fatalf("expected HTML body, got %q", raw)
+	}
+
+	bad := &GmailSendCmd{To: "a@example.com", Subject: "S", Body: "x", BodyHTML: "<p>y</p>", HTML: true}
+	if err := bad.Run(ctx, &RootFlags{Account: "a@b.com"}); err == nil {
+		t.Fatalf("expected --html/--body-html conflict")
+	}
+}
@@ -40,13 +40,13 @@ func (c *GmailSendAsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	resp, err := svc.Users.Settings.SendAs.List("me").Do()
+	resp, err := svc.Users.Settings.SendAs.List("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"sendAs": resp.SendAs})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"sendAs": resp.SendAs})
 	}
 
 	if len(resp.SendAs) == 0 {
@@ -96,13 +96,13 @@ func (c *GmailSendAsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	sa, err := svc.Users.Settings.SendAs.Get("me", sendAsEmail).Do()
+	sa, err := svc.Users.Settings.SendAs.Get("me", sendAsEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"sendAs": sa})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"sendAs": sa})
 	}
 
 	u.Out().Printf("send_as_email\t%s", sa.SendAsEmail)
@@ -148,13 +148,13 @@ func (c *GmailSendAsCreateCmd) Run(ctx context.Context, flags *RootFlags) error
 		TreatAsAlias:   c.TreatAsAlias,
 	}
 
-	created, err := svc.Users.Settings.SendAs.Create("me", sendAs).Do()
+	created, err := svc.Users.Settings.SendAs.Create("me", sendAs).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"sendAs": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"sendAs": created})
 	}
 
 	u.Out().Printf("send_as_email\t%s", created.SendAsEmail)
@@ -183,13 +183,13 @@ func (c *GmailSendAsVerifyCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	err = svc.Users.Settings.SendAs.Verify("me", sendAsEmail).Do()
+	err = svc.Users.Settings.SendAs.Verify("me", sendAsEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"email":   sendAsEmail,
 			"message": "Verification email sent",
 		})
@@ -219,13 +219,13 @@ func (c *GmailSendAsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error
 		return err
 	}
 
-	err = svc.Users.Settings.SendAs.Delete("me", sendAsEmail).Do()
+	err = svc.Users.Settings.SendAs.Delete("me", sendAsEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"email":   sendAsEmail,
 			"deleted": true,
 		})
@@ -261,7 +261,7 @@ func (c *GmailSendAsUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flag
 	}
 
 	// Get current settings first
-	current, err := svc.Users.Settings.SendAs.Get("me", sendAsEmail).Do()
+	current, err := svc.Users.Settings.SendAs.Get("me", sendAsEmail).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -283,13 +283,13 @@ func (c *GmailSendAsUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flag
 		current.IsDefault = c.MakeDefault
 	}
 
-	updated, err := svc.Users.Settings.SendAs.Update("me", sendAsEmail, current).Do()
+	updated, err := svc.Users.Settings.SendAs.Update("me", sendAsEmail, current).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"sendAs": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"sendAs": updated})
 	}
 
 	u.Out().Printf("Updated send-as alias: %s", updated.SendAsEmail)
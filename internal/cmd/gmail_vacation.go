@@ -32,13 +32,13 @@ func (c *GmailVacationGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	vacation, err := svc.Users.Settings.GetVacation("me").Do()
+	vacation, err := svc.Users.Settings.GetVacation("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"vacation": vacation})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"vacation": vacation})
 	}
 
 	u.Out().Printf("enable_auto_reply\t%t", vacation.EnableAutoReply)
@@ -84,7 +84,7 @@ func (c *GmailVacationUpdateCmd) Run(ctx context.Context, kctx *kong.Context, fl
 	}
 
 	// Get current settings first
-	current, err := svc.Users.Settings.GetVacation("me").Do()
+	current, err := svc.Users.Settings.GetVacation("me").Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -138,13 +138,13 @@ func (c *GmailVacationUpdateCmd) Run(ctx context.Context, kctx *kong.Context, fl
 		vacation.RestrictToDomain = c.DomainOnly
 	}
 
-	updated, err := svc.Users.Settings.UpdateVacation("me", vacation).Do()
+	updated, err := svc.Users.Settings.UpdateVacation("me", vacation).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"vacation": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"vacation": updated})
 	}
 
 	u.Out().Println("Vacation responder updated successfully")
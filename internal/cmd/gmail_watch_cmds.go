@@ -68,7 +68,7 @@ func (c *GmailWatchStartCmd) Run(ctx context.Context, kctx *kong.Context, flags
 	if err != nil {
 		return err
 	}
-	labelIDs, err := resolveLabelIDsWithService(svc, c.Labels)
+	labelIDs, err := resolveLabelIDsWithService(ctx, svc, c.Labels)
 	if err != nil {
 		return err
 	}
@@ -176,7 +176,7 @@ func (c *GmailWatchStopCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	if stopErr := svc.Users.Stop("me").Do(); stopErr != nil {
+	if stopErr := svc.Users.Stop("me").Context(ctx).Do(); stopErr != nil {
 		return stopErr
 	}
 	store, err := newGmailWatchStore(account)
@@ -184,7 +184,7 @@ func (c *GmailWatchStopCmd) Run(ctx context.Context, flags *RootFlags) error {
 		_ = os.Remove(store.path)
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"stopped": true})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"stopped": true})
 	}
 	u.Out().Printf("stopped\ttrue")
 	return nil
@@ -337,7 +337,7 @@ func (c *GmailWatchServeCmd) Run(ctx context.Context, kctx *kong.Context, flags
 
 func writeWatchState(ctx context.Context, state gmailWatchState) error {
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"watch": state})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"watch": state})
 	}
 	u := ui.FromContext(ctx)
 	u.Out().Printf("account\t%s", state.Account)
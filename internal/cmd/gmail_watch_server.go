@@ -179,7 +179,7 @@ func (s *gmailWatchServer) handlePush(ctx context.Context, payload gmailPushPayl
 	historyCall := svc.Users.History.List("me").StartHistoryId(startID).MaxResults(s.cfg.HistoryMax)
 	historyCall.HistoryTypes("messageAdded")
 
-	historyResp, err := historyCall.Do()
+	historyResp, err := historyCall.Context(ctx).Do()
 	if err != nil {
 		if isStaleHistoryError(err) {
 			return s.resyncHistory(ctx, svc, payload.HistoryID, payload.MessageID)
@@ -223,7 +223,7 @@ func (s *gmailWatchServer) handlePush(ctx context.Context, payload gmailPushPayl
 }
 
 func (s *gmailWatchServer) resyncHistory(ctx context.Context, svc *gmail.Service, historyID string, messageID string) (*gmailHookPayload, error) {
-	list, err := svc.Users.Messages.List("me").MaxResults(s.cfg.ResyncMax).Do()
+	list, err := svc.Users.Messages.List("me").MaxResults(s.cfg.ResyncMax).Context(ctx).Do()
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -47,11 +48,11 @@ func formatUnixMillis(ms int64) string {
 	return time.UnixMilli(ms).Format(time.RFC3339)
 }
 
-func resolveLabelIDsWithService(svc *gmail.Service, labels []string) ([]string, error) {
+func resolveLabelIDsWithService(ctx context.Context, svc *gmail.Service, labels []string) ([]string, error) {
 	if len(labels) == 0 {
 		return nil, nil
 	}
-	nameToID, err := fetchLabelNameToID(svc)
+	nameToID, err := fetchLabelNameToID(ctx, svc)
 	if err != nil {
 		return nil, err
 	}
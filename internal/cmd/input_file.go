@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// readInputFile reads a user-provided file, or stdin when path is "-".
+func readInputFile(path string) ([]byte, error) {
+	path = strings.TrimSpace(path)
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	path, err := config.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path) //nolint:gosec // user-provided path
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	gapi "google.golang.org/api/googleapi"
+
+	"github.com/steipete/gogcli/internal/errfmt"
+	"github.com/steipete/gogcli/internal/outfmt"
+)
+
+type jsonErrorBody struct {
+	Message       string `json:"message"`
+	Code          int    `json:"code"`
+	GoogleAPICode int    `json:"googleApiCode,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// writeJSONError writes a failed command's error as {"error": {...}} for
+// --json-errors. code is the process exit code; googleApiCode and reason are
+// copied from a Google API error when there is one.
+func writeJSONError(w io.Writer, err error) error {
+	body := jsonErrorBody{
+		Message: errfmt.Format(err),
+		Code:    ExitCode(err),
+	}
+	var gerr *gapi.Error
+	if errors.As(err, &gerr) {
+		body.GoogleAPICode = gerr.Code
+		if len(gerr.Errors) > 0 {
+			body.Reason = gerr.Errors[0].Reason
+		}
+	}
+	// A bare context: --fields and --template describe the success payload.
+	return outfmt.WriteJSONContext(context.Background(), w, map[string]any{"error": body})
+}
+
+// wantsJSONError reports whether a failure should also be written to stdout
+// as a JSON envelope. stdout never carries two documents: when the command
+// already wrote its result (partial failures, --all-accounts, --fail-empty,
+// auth has-scope), the exit code alone reports the failure. --template output
+// is left alone.
+func wantsJSONError(ctx context.Context, flags *RootFlags) bool {
+	if !flags.JSONErrors || !outfmt.IsJSON(ctx) || outfmt.TemplateFromContext(ctx) != nil {
+		return false
+	}
+	return !outfmt.WroteStdout(ctx)
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+)
+
+func TestExecute_JSONErrors(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Task list not found","errors":[{"reason":"notFound","message":"Task list not found"}]}}`))
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	var execErr error
+	stdout := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"--json", "--json-errors", "--account", "a@b.com", "tasks", "list", "missing"})
+		})
+	})
+	if execErr == nil {
+		t.Fatal("expected error")
+	}
+	if got := ExitCode(execErr); got != 1 {
+		t.Fatalf("exit code = %d, want 1", got)
+	}
+
+	var parsed struct {
+		Error struct {
+			Message       string `json:"message"`
+			Code          int    `json:"code"`
+			GoogleAPICode int    `json:"googleApiCode"`
+			Reason        string `json:"reason"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &parsed); err != nil {
+		t.Fatalf("stdout is not JSON: %v (%q)", err, stdout)
+	}
+	if parsed.Error.Code != 1 || parsed.Error.GoogleAPICode != 404 || parsed.Error.Reason != "notFound" {
+		t.Fatalf("unexpected envelope: %+v", parsed.Error)
+	}
+	if !strings.Contains(parsed.Error.Message, "Task list not found") {
+		t.Fatalf("unexpected message: %q", parsed.Error.Message)
+	}
+
+	// Without the flag, stdout stays empty on failure.
+	stdout = captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			_ = Execute([]string{"--json", "--account", "a@b.com", "tasks", "list", "missing"})
+		})
+	})
+	if stdout != "" {
+		t.Fatalf("expected empty stdout without --json-errors, got %q", stdout)
+	}
+}
+
+func TestExecute_JSONErrors_Usage(t *testing.T) {
+	var execErr error
+	stdout := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"--json", "--json-errors", "--account", "a@b.com", "calendar", "create", "primary"})
+		})
+	})
+	if got := ExitCode(execErr); got != 2 {
+		t.Fatalf("exit code = %d, want 2", got)
+	}
+	if !strings.Contains(stdout, `"code": 2`) || strings.Contains(stdout, "googleApiCode") {
+		t.Fatalf("unexpected envelope: %q", stdout)
+	}
+}
+
+func TestExecute_JSONErrors_SingleDocument(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"items":[]}`))
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	// The result is already on stdout, so the failure only sets the exit code.
+	var execErr error
+	stdout := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			execErr = Execute([]string{"--json", "--json-errors", "--account", "a@b.com", "tasks", "list", "l1", "--fail-empty"})
+		})
+	})
+	if got := ExitCode(execErr); got != emptyResultsExitCode {
+		t.Fatalf("exit code = %d, want %d", got, emptyResultsExitCode)
+	}
+	dec := json.NewDecoder(strings.NewReader(stdout))
+	var first map[string]any
+	if err := dec.Decode(&first); err != nil {
+		t.Fatalf("stdout is not JSON: %v (%q)", err, stdout)
+	}
+	if _, ok := first["error"]; ok || dec.More() {
+		t.Fatalf("expected exactly one result document, got %q", stdout)
+	}
+}
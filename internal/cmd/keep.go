@@ -48,13 +48,13 @@ func (c *KeepListCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd)
 		call = call.Filter(c.Filter)
 	}
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"notes":         resp.Notes,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -80,10 +80,10 @@ func (c *KeepListCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd)
 }
 
 func noteSnippet(n *keepapi.Note) string {
-	if n.Body == nil || n.Body.Text == nil {
+	text := noteBodyText(n)
+	if text == "" {
 		return "(no content)"
 	}
-	text := n.Body.Text.Text
 	if len(text) > 50 {
 		text = text[:50] + "..."
 	}
@@ -91,17 +91,47 @@ func noteSnippet(n *keepapi.Note) string {
 	return text
 }
 
+// noteBodyText renders a note body as plain text. Checklist notes carry
+// their content in body.list rather than body.text.
+func noteBodyText(n *keepapi.Note) string {
+	if n == nil || n.Body == nil {
+		return ""
+	}
+	if n.Body.Text != nil {
+		return n.Body.Text.Text
+	}
+	if n.Body.List == nil {
+		return ""
+	}
+	var b strings.Builder
+	writeNoteListItems(&b, n.Body.List.ListItems, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func writeNoteListItems(b *strings.Builder, items []*keepapi.ListItem, depth int) {
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		mark := "[ ]"
+		if item.Checked {
+			mark = "[x]"
+		}
+		text := ""
+		if item.Text != nil {
+			text = item.Text.Text
+		}
+		fmt.Fprintf(b, "%s%s %s\n", strings.Repeat("  ", depth), mark, text)
+		writeNoteListItems(b, item.ChildListItems, depth+1)
+	}
+}
+
 func noteContains(n *keepapi.Note, query string) bool {
 	query = strings.ToLower(query)
 	if strings.Contains(strings.ToLower(n.Title), query) {
 		return true
 	}
-	if n.Body != nil && n.Body.Text != nil {
-		if strings.Contains(strings.ToLower(n.Body.Text.Text), query) {
-			return true
-		}
-	}
-	return false
+	return strings.Contains(strings.ToLower(noteBodyText(n)), query)
 }
 
 type KeepSearchCmd struct {
@@ -126,7 +156,7 @@ func (c *KeepSearchCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd
 
 	for {
 		call := svc.Notes.List().PageSize(c.Max).PageToken(pageToken)
-		resp, err := call.Do()
+		resp, err := call.Context(ctx).Do()
 		if err != nil {
 			return err
 		}
@@ -144,7 +174,7 @@ func (c *KeepSearchCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"notes": allNotes,
 			"query": c.Query,
 			"count": len(allNotes),
@@ -187,13 +217,13 @@ func (c *KeepGetCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd) e
 		name = "notes/" + name
 	}
 
-	note, err := svc.Notes.Get(name).Do()
+	note, err := svc.Notes.Get(name).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"note": note})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"note": note})
 	}
 
 	u.Out().Printf("name\t%s", note.Name)
@@ -201,9 +231,9 @@ func (c *KeepGetCmd) Run(ctx context.Context, flags *RootFlags, keep *KeepCmd) e
 	u.Out().Printf("created\t%s", note.CreateTime)
 	u.Out().Printf("updated\t%s", note.UpdateTime)
 	u.Out().Printf("trashed\t%v", note.Trashed)
-	if note.Body != nil && note.Body.Text != nil {
+	if body := noteBodyText(note); body != "" {
 		u.Out().Println("")
-		u.Out().Println(note.Body.Text.Text)
+		u.Out().Println(body)
 	}
 	if len(note.Attachments) > 0 {
 		u.Out().Println("")
@@ -264,7 +294,7 @@ func (c *KeepAttachmentCmd) Run(ctx context.Context, flags *RootFlags, keep *Kee
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"downloaded": true,
 			"path":       outPath,
 			"bytes":      written,
@@ -635,3 +635,44 @@ func TestGetKeepService_UsesLegacyPath(t *testing.T) {
 		t.Fatalf("unexpected path: %q", gotPath)
 	}
 }
+
+func TestKeepGet_ChecklistBody(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+
+	account := "a@b.com"
+	_ = writeKeepSA(t, account)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/notes/abc":
+			_, _ = io.WriteString(w, `{"name":"notes/abc","title":"Groceries","body":{"list":{"listItems":[{"text":{"text":"milk"},"checked":true},{"text":{"text":"fruit"},"childListItems":[{"text":{"text":"apples"}}]}]}}}`)
+			return
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	orig := newKeepServiceWithSA
+	t.Cleanup(func() { newKeepServiceWithSA = orig })
+	newKeepServiceWithSA = func(ctx context.Context, _, _ string) (*keepapi.Service, error) {
+		return keepapi.NewService(ctx,
+			option.WithEndpoint(srv.URL+"/"),
+			option.WithHTTPClient(srv.Client()),
+			option.WithoutAuthentication(),
+		)
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"keep", "get", "abc", "--plain", "--account", account}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	if !strings.Contains(out, "[x] milk\n[ ] fruit\n  [ ] apples") {
+		t.Fatalf("expected checklist body, got: %q", out)
+	}
+}
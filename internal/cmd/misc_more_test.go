@@ -65,7 +65,7 @@ func TestVersionCmdJSON(t *testing.T) {
 
 	out := captureStdout(t, func() {
 		ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
-		if err := (&VersionCmd{}).Run(ctx); err != nil {
+		if err := (&VersionCmd{}).Run(ctx, nil); err != nil {
 			t.Fatalf("Run: %v", err)
 		}
 	})
@@ -94,11 +94,21 @@ func TestLoadTrackingConfigForAccount(t *testing.T) {
 func TestVersionCmdText(t *testing.T) {
 	out := captureStdout(t, func() {
 		ctx := outfmt.WithMode(context.Background(), outfmt.Mode{})
-		if err := (&VersionCmd{}).Run(ctx); err != nil {
+		if err := (&VersionCmd{}).Run(ctx, &RootFlags{}); err != nil {
 			t.Fatalf("Run: %v", err)
 		}
 	})
-	if strings.TrimSpace(out) == "" {
-		t.Fatalf("expected version output")
+	if out != VersionString()+"\n" {
+		t.Fatalf("expected single version line, got %q", out)
+	}
+
+	verbose := captureStdout(t, func() {
+		ctx := outfmt.WithMode(context.Background(), outfmt.Mode{})
+		if err := (&VersionCmd{}).Run(ctx, &RootFlags{Verbose: true}); err != nil {
+			t.Fatalf("Run: %v", err)
+		}
+	})
+	if !strings.Contains(verbose, "platform") || !strings.Contains(verbose, "go\t") && !strings.Contains(verbose, "go ") {
+		t.Fatalf("expected build details with --verbose, got %q", verbose)
 	}
 }
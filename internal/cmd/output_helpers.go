@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
+	"github.com/alecthomas/kong"
+
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/ui"
 )
@@ -18,6 +22,94 @@ func tableWriter(ctx context.Context) (io.Writer, func()) {
 	return tw, func() { _ = tw.Flush() }
 }
 
+// csvTable is implemented by commands whose text output goes through
+// writeTable and therefore supports --csv.
+type csvTable interface {
+	csvTable()
+}
+
+func enforceCSVSupport(kctx *kong.Context, mode outfmt.Mode) error {
+	if !mode.CSV {
+		return nil
+	}
+	node := kctx.Selected()
+	if node != nil && node.Target.IsValid() && node.Target.CanAddr() {
+		if _, ok := node.Target.Addr().Interface().(csvTable); ok {
+			return nil
+		}
+	}
+	return usagef("--csv is not supported by %q", strings.Join(strings.Fields(kctx.Command()), " "))
+}
+
+// ndjsonStream is implemented by list commands that can stream one item per
+// line with --ndjson.
+type ndjsonStream interface {
+	ndjsonStream()
+}
+
+func enforceNDJSONSupport(kctx *kong.Context, mode outfmt.Mode) error {
+	if !mode.NDJSON {
+		return nil
+	}
+	node := kctx.Selected()
+	if node != nil && node.Target.IsValid() && node.Target.CanAddr() {
+		if _, ok := node.Target.Addr().Interface().(ndjsonStream); ok {
+			return nil
+		}
+	}
+	return usagef("--ndjson is not supported by %q", strings.Join(strings.Fields(kctx.Command()), " "))
+}
+
+// fieldsResource is implemented by commands whose JSON output lists items
+// under a resource key covered by outfmt.PresetFields (--fields-preset).
+type fieldsResource interface {
+	fieldsResource() string
+}
+
+// resolveFieldsPreset returns the preset's --fields paths. With perItem
+// (--ndjson), each output line is a single item, so the paths are relative to
+// the item rather than the resource key.
+func resolveFieldsPreset(kctx *kong.Context, preset string, perItem bool) ([]string, error) {
+	command := strings.Join(strings.Fields(kctx.Command()), " ")
+	node := kctx.Selected()
+	if node == nil || !node.Target.IsValid() || !node.Target.CanAddr() {
+		return nil, usagef("--fields-preset is not supported by %q", command)
+	}
+	r, ok := node.Target.Addr().Interface().(fieldsResource)
+	if !ok {
+		return nil, usagef("--fields-preset is not supported by %q", command)
+	}
+	fields, err := outfmt.PresetFields(r.fieldsResource(), preset)
+	if err != nil {
+		return nil, usage(err.Error())
+	}
+	if perItem {
+		items := make([]string, 0, len(fields))
+		for _, f := range fields {
+			if rest, ok := strings.CutPrefix(f, r.fieldsResource()+"."); ok {
+				items = append(items, rest)
+			}
+		}
+		return items, nil
+	}
+	return fields, nil
+}
+
+// writeTable renders a header and rows as an aligned table (or TSV with
+// --plain), or as CSV with --csv.
+func writeTable(ctx context.Context, header []string, rows [][]string) error {
+	if outfmt.IsCSV(ctx) {
+		return outfmt.WriteCSV(os.Stdout, header, rows)
+	}
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return nil
+}
+
 func printNextPageHint(u *ui.UI, nextPageToken string) {
 	if u == nil || nextPageToken == "" {
 		return
@@ -0,0 +1,92 @@
+package cmd
+
+import "context"
+
+// PageLimitFlags bound how much --all fetches. Either flag also turns on
+// paging through results, so --all can be omitted.
+type PageLimitFlags struct {
+	MaxPages int `name:"max-pages" help:"Stop after fetching this many pages (implies --all)"`
+	MaxTotal int `name:"max-total" help:"Stop after collecting this many items (implies --all)"`
+}
+
+func (f PageLimitFlags) validate() error {
+	if f.MaxPages < 0 {
+		return usage("--max-pages must be >= 0")
+	}
+	if f.MaxTotal < 0 {
+		return usage("--max-total must be >= 0")
+	}
+	return nil
+}
+
+// set reports whether any limit was given.
+func (f PageLimitFlags) set() bool {
+	return f.MaxPages > 0 || f.MaxTotal > 0
+}
+
+// collectAllPages calls fetch with successive page tokens, starting at
+// pageToken, until the API stops returning one. Items are returned in order.
+func collectAllPages[T any](ctx context.Context, pageToken string, fetch func(pageToken string) ([]T, string, error)) ([]T, error) {
+	all, _, err := collectPages(ctx, pageToken, PageLimitFlags{}, fetch)
+	return all, err
+}
+
+// collectPages is collectAllPages with optional page and item caps (zero means
+// unlimited). When a cap stops it early, the token for the next unread page is
+// returned so the caller can resume with --page; items past --max-total on
+// the final page are dropped rather than re-fetched.
+func collectPages[T any](ctx context.Context, pageToken string, limits PageLimitFlags, fetch func(pageToken string) ([]T, string, error)) ([]T, string, error) {
+	var all []T
+	next, err := streamPages(ctx, pageToken, limits, fetch, func(item T) error {
+		all = append(all, item)
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return all, next, nil
+}
+
+// streamPages is collectPages for --ndjson: each item is handed to emit as
+// soon as its page arrives instead of being accumulated.
+func streamPages[T any](ctx context.Context, pageToken string, limits PageLimitFlags, fetch func(pageToken string) ([]T, string, error), emit func(T) error) (string, error) {
+	emitted := 0
+	for pages := 1; ; pages++ {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		items, next, err := fetch(pageToken)
+		if err != nil {
+			return "", err
+		}
+		for _, item := range items {
+			if limits.MaxTotal > 0 && emitted >= limits.MaxTotal {
+				break
+			}
+			if err := emit(item); err != nil {
+				return "", err
+			}
+			emitted++
+		}
+		// Guard against APIs echoing the same token back.
+		if next == "" || next == pageToken {
+			return "", nil
+		}
+		if limits.MaxTotal > 0 && emitted >= limits.MaxTotal {
+			return next, nil
+		}
+		if limits.MaxPages > 0 && pages >= limits.MaxPages {
+			return next, nil
+		}
+		pageToken = next
+	}
+}
+
+// streamLimits returns the limits for a --ndjson run: the command's own
+// limits when paging through everything, otherwise just the first page.
+func streamLimits(all bool, limits PageLimitFlags) PageLimitFlags {
+	if all || limits.set() {
+		return limits
+	}
+	return PageLimitFlags{MaxPages: 1}
+}
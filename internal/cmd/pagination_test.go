@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCollectAllPages(t *testing.T) {
+	pages := map[string]struct {
+		items []int
+		next  string
+	}{
+		"":   {items: []int{1, 2}, next: "p2"},
+		"p2": {items: []int{3}, next: "p3"},
+		"p3": {items: []int{4, 5}, next: ""},
+	}
+
+	var tokens []string
+	got, err := collectAllPages(context.Background(), "", func(token string) ([]int, string, error) {
+		tokens = append(tokens, token)
+		p := pages[token]
+		return p.items, p.next, nil
+	})
+	if err != nil {
+		t.Fatalf("collectAllPages: %v", err)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("unexpected items: %v", got)
+	}
+	if !reflect.DeepEqual(tokens, []string{"", "p2", "p3"}) {
+		t.Fatalf("unexpected tokens: %v", tokens)
+	}
+}
+
+func TestCollectAllPages_StartTokenAndErrors(t *testing.T) {
+	calls := 0
+	got, err := collectAllPages(context.Background(), "start", func(token string) ([]string, string, error) {
+		calls++
+		if token != "start" {
+			t.Fatalf("unexpected token: %q", token)
+		}
+		// Same token echoed back must not loop forever.
+		return []string{"a"}, "start", nil
+	})
+	if err != nil || calls != 1 || len(got) != 1 {
+		t.Fatalf("unexpected result: %v %v calls=%d", got, err, calls)
+	}
+
+	boom := errors.New("boom")
+	if _, err := collectAllPages(context.Background(), "", func(string) ([]string, string, error) {
+		return nil, "", boom
+	}); !errors.Is(err, boom) {
+		t.Fatalf("expected fetch error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := collectAllPages(ctx, "", func(string) ([]string, string, error) {
+		t.Fatalf("fetch should not run on cancelled ctx")
+		return nil, "", nil
+	}); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+}
+
+func TestCollectPages_Limits(t *testing.T) {
+	fetch := func(token string) ([]int, string, error) {
+		switch token {
+		case "":
+			return []int{1, 2}, "p2", nil
+		case "p2":
+			return []int{3, 4}, "p3", nil
+		case "p3":
+			return []int{5}, "", nil
+		}
+		t.Fatalf("unexpected token: %q", token)
+		return nil, "", nil
+	}
+
+	got, next, err := collectPages(context.Background(), "", PageLimitFlags{MaxPages: 2}, fetch)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3, 4}) || next != "p3" {
+		t.Fatalf("max pages: %v %q %v", got, next, err)
+	}
+
+	got, next, err = collectPages(context.Background(), "", PageLimitFlags{MaxTotal: 3}, fetch)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) || next != "p3" {
+		t.Fatalf("max total: %v %q %v", got, next, err)
+	}
+
+	// The tighter limit wins.
+	got, next, err = collectPages(context.Background(), "", PageLimitFlags{MaxPages: 1, MaxTotal: 3}, fetch)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2}) || next != "p2" {
+		t.Fatalf("both limits: %v %q %v", got, next, err)
+	}
+
+	// Limits past the end behave like no limits.
+	got, next, err = collectPages(context.Background(), "", PageLimitFlags{MaxPages: 10, MaxTotal: 10}, fetch)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) || next != "" {
+		t.Fatalf("loose limits: %v %q %v", got, next, err)
+	}
+}
+
+func TestStreamPages(t *testing.T) {
+	var events []string
+	fetch := func(token string) ([]string, string, error) {
+		events = append(events, "fetch:"+token)
+		if token == "" {
+			return []string{"a", "b"}, "p2", nil
+		}
+		return []string{"c"}, "", nil
+	}
+	emit := func(item string) error {
+		events = append(events, item)
+		return nil
+	}
+
+	next, err := streamPages(context.Background(), "", PageLimitFlags{}, fetch, emit)
+	if err != nil || next != "" {
+		t.Fatalf("streamPages: %q %v", next, err)
+	}
+	// Items are emitted before the next page is requested.
+	if want := []string{"fetch:", "a", "b", "fetch:p2", "c"}; !reflect.DeepEqual(events, want) {
+		t.Fatalf("unexpected order: %v", events)
+	}
+
+	events = nil
+	next, err = streamPages(context.Background(), "", streamLimits(false, PageLimitFlags{}), fetch, emit)
+	if err != nil || next != "p2" || !reflect.DeepEqual(events, []string{"fetch:", "a", "b"}) {
+		t.Fatalf("single page: %v %q %v", events, next, err)
+	}
+
+	boom := errors.New("boom")
+	if _, err := streamPages(context.Background(), "", PageLimitFlags{}, fetch, func(string) error { return boom }); !errors.Is(err, boom) {
+		t.Fatalf("expected emit error, got %v", err)
+	}
+}
@@ -37,12 +37,12 @@ func (c *PeopleGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return wrapPeopleAPIError(err)
 	}
 
-	person, err := svc.People.Get(resource).PersonFields(peopleProfileReadMask).Do()
+	person, err := svc.People.Get(resource).PersonFields(peopleProfileReadMask).Context(ctx).Do()
 	if err != nil {
 		return wrapPeopleAPIError(err)
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"person": person})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"person": person})
 	}
 
 	name := primaryName(person)
@@ -119,7 +119,7 @@ func (c *PeopleSearchCmd) Run(ctx context.Context, flags *RootFlags) error {
 				Email:    primaryEmail(p),
 			})
 		}
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"people":        items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -168,7 +168,7 @@ func (c *PeopleRelationsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return wrapPeopleAPIError(err)
 	}
 
-	person, err := svc.People.Get(resource).PersonFields(peopleRelationsReadMask).Do()
+	person, err := svc.People.Get(resource).PersonFields(peopleRelationsReadMask).Context(ctx).Do()
 	if err != nil {
 		return wrapPeopleAPIError(err)
 	}
@@ -201,7 +201,7 @@ func (c *PeopleRelationsCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if relationType != "" {
 			resp["relationType"] = relationType
 		}
-		return outfmt.WriteJSON(os.Stdout, resp)
+		return outfmt.WriteJSONContext(ctx, os.Stdout, resp)
 	}
 
 	if len(relations) == 0 {
@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// applyProfile fills in defaults from the selected config profile (--profile
+// or GOG_PROFILE). Explicit flags and env vars (GOG_ACCOUNT, GOG_CLIENT,
+// GOG_KEYRING_BACKEND) win; the profile only supplies what they leave unset.
+func applyProfile(flags *RootFlags) error {
+	name := strings.TrimSpace(flags.Profile)
+	config.UseProfile(name)
+	if name == "" {
+		return nil
+	}
+
+	cfg, err := config.ReadConfig()
+	if err != nil {
+		return err
+	}
+	profile, err := config.LookupProfile(cfg, name)
+	if err != nil {
+		return usage(err.Error())
+	}
+
+	if strings.TrimSpace(flags.Account) == "" && strings.TrimSpace(os.Getenv("GOG_ACCOUNT")) == "" {
+		flags.Account = profile.Account
+	}
+	if strings.TrimSpace(flags.Client) == "" {
+		flags.Client = profile.Client
+	}
+	// The keyring backend is resolved lazily by the secrets package, which
+	// consults config.ActiveProfile.
+	return nil
+}
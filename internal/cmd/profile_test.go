@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func TestApplyProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("GOG_ACCOUNT", "")
+	t.Cleanup(func() { config.UseProfile("") })
+
+	if err := config.WriteConfig(config.File{Profiles: map[string]config.Profile{
+		"work": {Account: "me@work.com", Client: "work"},
+	}}); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	flags := &RootFlags{Profile: "work"}
+	if err := applyProfile(flags); err != nil {
+		t.Fatalf("applyProfile: %v", err)
+	}
+	if flags.Account != "me@work.com" || flags.Client != "work" {
+		t.Fatalf("profile defaults not applied: %+v", flags)
+	}
+
+	// Explicit values win over the profile.
+	flags = &RootFlags{Profile: "work", Account: "other@x.com", Client: "mine"}
+	if err := applyProfile(flags); err != nil {
+		t.Fatalf("applyProfile: %v", err)
+	}
+	if flags.Account != "other@x.com" || flags.Client != "mine" {
+		t.Fatalf("explicit flags overridden: %+v", flags)
+	}
+
+	t.Setenv("GOG_ACCOUNT", "env@x.com")
+	flags = &RootFlags{Profile: "work"}
+	if err := applyProfile(flags); err != nil {
+		t.Fatalf("applyProfile: %v", err)
+	}
+	if flags.Account != "" {
+		t.Fatalf("expected GOG_ACCOUNT to win, got %q", flags.Account)
+	}
+}
+
+func TestExecute_ConfigProfiles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("GOG_PROFILE", "work")
+	t.Cleanup(func() { config.UseProfile("") })
+
+	if err := config.WriteConfig(config.File{Profiles: map[string]config.Profile{
+		"work":     {Account: "me@work.com", KeyringBackend: "file"},
+		"personal": {Account: "me@gmail.com"},
+	}}); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	out := captureStdout(t, func() {
+		_ = captureStderr(t, func() {
+			if err := Execute([]string{"--json", "config", "profiles"}); err != nil {
+				t.Fatalf("Execute: %v", err)
+			}
+		})
+	})
+	var parsed struct {
+		Profiles []configProfileItem `json:"profiles"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if len(parsed.Profiles) != 2 || parsed.Profiles[0].Name != "personal" || parsed.Profiles[0].Active || !parsed.Profiles[1].Active {
+		t.Fatalf("unexpected profiles: %+v", parsed.Profiles)
+	}
+
+	errOut := captureStderr(t, func() {
+		err := Execute([]string{"--profile", "school", "config", "profiles"})
+		if ExitCode(err) != 2 {
+			t.Fatalf("expected usage error for unknown profile, got %v", err)
+		}
+	})
+	if !strings.Contains(errOut, "defined: personal, work") {
+		t.Fatalf("expected defined profiles in error, got %q", errOut)
+	}
+}
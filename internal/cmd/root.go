@@ -4,14 +4,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
+	"net/url"
 	"os"
+	"strings"
+	"text/template"
+	"time"
 
 	"github.com/alecthomas/kong"
 
 	"github.com/steipete/gogcli/internal/authclient"
 	"github.com/steipete/gogcli/internal/config"
 	"github.com/steipete/gogcli/internal/errfmt"
+	"github.com/steipete/gogcli/internal/googleapi"
 	"github.com/steipete/gogcli/internal/googleauth"
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/secrets"
@@ -24,15 +30,31 @@ const (
 )
 
 type RootFlags struct {
-	Color          string `help:"Color output: auto|always|never" default:"${color}"`
-	Account        string `help:"Account email for API commands (gmail/calendar/chat/classroom/drive/docs/slides/contacts/tasks/people/sheets)"`
-	Client         string `help:"OAuth client name (selects stored credentials + token bucket)" default:"${client}"`
-	EnableCommands string `help:"Comma-separated list of enabled top-level commands (restricts CLI)" default:"${enabled_commands}"`
-	JSON           bool   `help:"Output JSON to stdout (best for scripting)" default:"${json}"`
-	Plain          bool   `help:"Output stable, parseable text to stdout (TSV; no colors)" default:"${plain}"`
-	Force          bool   `help:"Skip confirmations for destructive commands"`
-	NoInput        bool   `help:"Never prompt; fail instead (useful for CI)"`
-	Verbose        bool   `help:"Enable verbose logging"`
+	Color          string        `help:"Color output: auto|always|never" default:"${color}"`
+	Account        string        `help:"Account email for API commands (gmail/calendar/chat/classroom/drive/docs/slides/contacts/tasks/people/sheets)"`
+	AllAccounts    bool          `name:"all-accounts" help:"Run a read-only list command once per stored account"`
+	Client         string        `help:"OAuth client name (selects stored credentials + token bucket)" default:"${client}"`
+	Profile        string        `help:"Config profile whose defaults (account, client, keyring backend) apply" default:"${profile}"`
+	EnableCommands string        `help:"Comma-separated list of enabled top-level commands (restricts CLI)" default:"${enabled_commands}"`
+	JSON           bool          `help:"Output JSON to stdout (best for scripting)" default:"${json}"`
+	Plain          bool          `help:"Output stable, parseable text to stdout (TSV; no colors)" default:"${plain}"`
+	CSV            bool          `name:"csv" help:"Output CSV to stdout (list commands only)"`
+	Fields         string        `help:"Comma-separated dotted JSON paths to keep in --json output (e.g. event.id,event.summary)"`
+	NDJSON         bool          `name:"ndjson" help:"Stream list results as newline-delimited JSON, one item per line (list commands only)"`
+	JSONErrors     bool          `name:"json-errors" help:"With --json, also write failures to stdout as {\"error\": {...}}"`
+	FieldsPreset   string        `name:"fields-preset" aliases:"preset" help:"Named --fields projection for list commands (e.g. minimal)"`
+	Template       string        `help:"Render the JSON result through a Go text/template instead of printing JSON"`
+	TemplateFile   string        `name:"template-file" help:"Read the --template from a file"`
+	Force          bool          `help:"Skip confirmations for destructive commands"`
+	DryRun         bool          `name:"dry-run" help:"Print the intended changes without calling the API (supported commands only)"`
+	NoInput        bool          `help:"Never prompt; fail instead (useful for CI)"`
+	Quiet          bool          `name:"quiet" help:"Suppress stderr hints and warnings (errors are still printed)"`
+	MaxRetries     *int          `name:"max-retries" help:"Maximum retries for rate-limited (429) and transient 5xx API responses (default: 3 for 429, 1 for 5xx)"`
+	RetryBaseDelay time.Duration `name:"retry-base-delay" help:"Delay before API retries (default: 1s; doubles per 429 retry with jitter, fixed for 5xx)"`
+	QPS            float64       `name:"qps" help:"Throttle outbound API requests to N per second (0 = unlimited)" default:"0"`
+	CommandTimeout time.Duration `name:"command-timeout" help:"Abort the command if it runs longer than this (e.g. 30s, 2m; 0 = no timeout)" default:"0"`
+	Proxy          string        `name:"proxy" help:"HTTP(S) or SOCKS5 proxy URL for API requests (default: HTTPS_PROXY/HTTP_PROXY)"`
+	Verbose        bool          `help:"Enable verbose logging"`
 }
 
 type CLI struct {
@@ -90,52 +112,166 @@ func Execute(args []string) (err error) {
 		return parsedErr
 	}
 
+	if err = applyProfile(&cli.RootFlags); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
 	if err = enforceEnabledCommands(kctx, cli.EnableCommands); err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
 		return err
 	}
+	if err = enforceAllAccountsSupport(kctx, &cli.RootFlags); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
 
 	logLevel := slog.LevelWarn
-	if cli.Verbose {
+	switch {
+	case cli.Verbose:
 		logLevel = slog.LevelDebug
+	case cli.Quiet:
+		logLevel = slog.LevelError
 	}
 	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
 		Level: logLevel,
 	})))
 
-	mode, err := outfmt.FromFlags(cli.JSON, cli.Plain)
+	var tmpl *template.Template
+	tmplText, err := readOutputTemplate(cli.Template, cli.TemplateFile)
+	if err == nil && tmplText != "" {
+		tmpl, err = outfmt.ParseTemplate(tmplText)
+		if err != nil {
+			err = usage(err.Error())
+		}
+	}
+	if err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
+	if (cli.MaxRetries != nil && *cli.MaxRetries < 0) || cli.RetryBaseDelay < 0 || cli.QPS < 0 {
+		err = usage("--max-retries, --retry-base-delay and --qps must be >= 0")
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
+	if cli.CommandTimeout < 0 {
+		err = usage("--command-timeout must be >= 0")
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
+	var proxyURL *url.URL
+	if strings.TrimSpace(cli.Proxy) != "" {
+		proxyURL, err = googleapi.ParseProxyURL(cli.Proxy)
+		if err != nil {
+			err = usage(err.Error())
+			_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+			return err
+		}
+	}
+	var displayLoc *time.Location
+	if strings.TrimSpace(cli.Calendar.DisplayTZ) != "" {
+		displayLoc, err = parseDisplayTimezone(cli.Calendar.DisplayTZ)
+		if err != nil {
+			_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+			return err
+		}
+	}
+	if tmpl != nil && (cli.Plain || cli.CSV) {
+		err = usage("--template cannot be combined with --plain or --csv")
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
+	if cli.NDJSON && (tmpl != nil || cli.Plain || cli.CSV) {
+		err = usage("--ndjson cannot be combined with --template, --plain, or --csv")
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
+
+	fields := outfmt.ParseFields(cli.Fields)
+	if strings.TrimSpace(cli.FieldsPreset) != "" {
+		presetFields, presetErr := resolveFieldsPreset(kctx, cli.FieldsPreset, cli.NDJSON)
+		if presetErr != nil {
+			_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(presetErr))
+			return presetErr
+		}
+		fields = append(presetFields, fields...)
+	}
+
+	// Templates render the --json payload and --ndjson streams it, so both
+	// imply JSON mode.
+	mode, err := outfmt.FromFlags(cli.JSON || cli.NDJSON || tmpl != nil, cli.Plain, cli.CSV)
 	if err != nil {
 		return newUsageError(err)
 	}
+	mode.NDJSON = cli.NDJSON
+	if err = enforceCSVSupport(kctx, mode); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
+	if err = enforceNDJSONSupport(kctx, mode); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, errfmt.Format(err))
+		return err
+	}
 
 	ctx := context.Background()
 	ctx = outfmt.WithMode(ctx, mode)
+	ctx = outfmt.WithFields(ctx, fields)
+	if tmpl != nil {
+		ctx = outfmt.WithTemplate(ctx, tmpl)
+	}
 	ctx = authclient.WithClient(ctx, cli.Client)
+	ctx = googleapi.WithRetryConfig(ctx, googleapi.RetryConfig{
+		MaxRetries: cli.MaxRetries,
+		BaseDelay:  cli.RetryBaseDelay,
+	})
+	ctx = googleapi.WithRateLimiter(ctx, googleapi.NewRateLimiter(cli.QPS))
+	ctx = googleauth.WithProxy(ctx, proxyURL)
+	ctx = withDisplayTimezone(ctx, displayLoc)
+	if cli.CommandTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cli.CommandTimeout)
+		defer cancel()
+	}
 
 	uiColor := cli.Color
-	if outfmt.IsJSON(ctx) || outfmt.IsPlain(ctx) {
+	if outfmt.IsJSON(ctx) || outfmt.IsPlain(ctx) || outfmt.IsCSV(ctx) {
 		uiColor = colorNever
 	}
 
+	// --quiet only silences the UI's stderr printer; stdout and the final
+	// error below are unaffected.
+	var uiStderr io.Writer = os.Stderr
+	if cli.Quiet {
+		uiStderr = io.Discard
+	}
 	u, err := ui.New(ui.Options{
-		Stdout: os.Stdout,
-		Stderr: os.Stderr,
-		Color:  uiColor,
+		Stdout:     os.Stdout,
+		Stderr:     uiStderr,
+		Color:      uiColor,
+		NoProgress: outfmt.IsJSON(ctx),
 	})
 	if err != nil {
 		return err
 	}
 	ctx = ui.WithUI(ctx, u)
+	ctx = outfmt.TrackStdout(ctx)
 
 	kctx.BindTo(ctx, (*context.Context)(nil))
 	kctx.Bind(&cli.RootFlags)
 
-	err = kctx.Run()
+	if cli.AllAccounts {
+		err = runAllAccounts(ctx, kctx, &cli.RootFlags)
+	} else {
+		err = kctx.Run()
+	}
 	if err == nil {
 		return nil
 	}
+	err = timeoutError(ctx, err, cli.CommandTimeout)
+	if wantsJSONError(ctx, &cli.RootFlags) {
+		_ = writeJSONError(os.Stdout, err)
+	}
 
-	if u := ui.FromContext(ctx); u != nil {
+	if u := ui.FromContext(ctx); u != nil && !cli.Quiet {
 		u.Err().Error(errfmt.Format(err))
 		return err
 	}
@@ -143,6 +279,35 @@ func Execute(args []string) (err error) {
 	return err
 }
 
+// timeoutError replaces errors caused by --command-timeout expiring with a message
+// that names the flag, so a deadline reads differently from a cancellation.
+func timeoutError(ctx context.Context, err error, timeout time.Duration) error {
+	if timeout <= 0 {
+		return err
+	}
+	if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return err
+	}
+	return errfmt.NewUserFacingError(fmt.Sprintf("timed out after %s (--command-timeout)", timeout), err)
+}
+
+// readOutputTemplate reads --template/--template-file. Inline templates
+// accept \n and \t escapes so one-liners work from any shell.
+func readOutputTemplate(inline, path string) (string, error) {
+	path = strings.TrimSpace(path)
+	if inline != "" && path != "" {
+		return "", usage("--template and --template-file are mutually exclusive")
+	}
+	if path == "" {
+		return strings.NewReplacer(`\n`, "\n", `\t`, "\t").Replace(inline), nil
+	}
+	b, err := os.ReadFile(path) //nolint:gosec // user-provided path
+	if err != nil {
+		return "", fmt.Errorf("read template file: %w", err)
+	}
+	return string(b), nil
+}
+
 func wrapParseError(err error) error {
 	if err == nil {
 		return nil
@@ -171,14 +336,16 @@ func boolString(v bool) string {
 func newParser(description string) (*kong.Kong, *CLI, error) {
 	envMode := outfmt.FromEnv()
 	vars := kong.Vars{
-		"auth_services":    googleauth.UserServiceCSV(),
-		"color":            envOr("GOG_COLOR", "auto"),
-		"calendar_weekday": envOr("GOG_CALENDAR_WEEKDAY", "false"),
-		"client":           envOr("GOG_CLIENT", ""),
-		"enabled_commands": envOr("GOG_ENABLE_COMMANDS", ""),
-		"json":             boolString(envMode.JSON),
-		"plain":            boolString(envMode.Plain),
-		"version":          VersionString(),
+		"auth_services":     googleauth.UserServiceCSV(),
+		"color":             envOr("GOG_COLOR", "auto"),
+		"calendar_weekday":  envOr("GOG_CALENDAR_WEEKDAY", "false"),
+		"client":            envOr("GOG_CLIENT", ""),
+		"enabled_commands":  envOr("GOG_ENABLE_COMMANDS", ""),
+		"json":              boolString(envMode.JSON),
+		"plain":             boolString(envMode.Plain),
+		"profile":           envOr("GOG_PROFILE", ""),
+		"upload_chunk_size": "16MB",
+		"version":           VersionString(),
 	}
 
 	cli := &CLI{}
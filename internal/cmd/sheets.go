@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
@@ -38,19 +40,65 @@ type SheetsCmd struct {
 type SheetsExportCmd struct {
 	SpreadsheetID string         `arg:"" name:"spreadsheetId" help:"Spreadsheet ID"`
 	Output        OutputPathFlag `embed:""`
-	Format        string         `name:"format" help:"Export format: pdf|xlsx|csv" default:"xlsx"`
+	Format        string         `name:"format" help:"Export format: pdf|xlsx|csv|tsv (csv/tsv export a single sheet)" default:"xlsx"`
+	GID           string         `name:"gid" help:"Sheet ID (gid) to export with csv/tsv (default: first sheet; see sheets metadata)"`
 }
 
 func (c *SheetsExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	gid := strings.TrimSpace(c.GID)
+	if gid != "" {
+		if _, err := strconv.ParseInt(gid, 10, 64); err != nil {
+			return usagef("invalid --gid %q (must be a numeric sheet ID)", gid)
+		}
+		if format := strings.ToLower(strings.TrimSpace(c.Format)); format != "csv" && format != "tsv" {
+			return usage("--gid requires --format csv or tsv")
+		}
+	}
 	return exportViaDrive(ctx, flags, exportViaDriveOptions{
 		ArgName:       "spreadsheetId",
 		ExpectedMime:  "application/vnd.google-apps.spreadsheet",
 		KindLabel:     "Google Sheet",
 		DefaultFormat: "xlsx",
-		FormatHelp:    "Export format: pdf|xlsx|csv",
+		FormatHelp:    "Export format: pdf|xlsx|csv|tsv",
+		SheetGID:      gid,
 	}, c.SpreadsheetID, c.Output.Path, c.Format)
 }
 
+// sheetsExportTabDownload fetches one sheet tab through the Sheets export URL;
+// Drive's files.export always returns the first sheet for csv/tsv.
+var sheetsExportTabDownload = func(ctx context.Context, account, spreadsheetID, format, gid string) (*http.Response, error) {
+	client, err := newDriveHTTPClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	exportURL := fmt.Sprintf("https://docs.google.com/spreadsheets/d/%s/export?%s", url.PathEscape(spreadsheetID), url.Values{
+		"format": {format},
+		"gid":    {gid},
+	}.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, exportURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+func downloadSheetTab(ctx context.Context, account, spreadsheetID, destPath, format, gid string) (string, int64, error) {
+	mimeType, err := driveExportMimeTypeForFormat(driveMimeGoogleSheet, format)
+	if err != nil {
+		return "", 0, err
+	}
+	resp, err := sheetsExportTabDownload(ctx, account, spreadsheetID, strings.ToLower(strings.TrimSpace(format)), gid)
+	if err != nil {
+		return "", 0, err
+	}
+	outPath := replaceExt(destPath, driveExportExtension(mimeType))
+	n, err := writeDownloadResponse(resp, outPath)
+	if err != nil {
+		return "", 0, err
+	}
+	return outPath, n, nil
+}
+
 type SheetsCopyCmd struct {
 	SpreadsheetID string `arg:"" name:"spreadsheetId" help:"Spreadsheet ID"`
 	Title         string `arg:"" name:"title" help:"New spreadsheet title"`
@@ -69,7 +117,26 @@ type SheetsGetCmd struct {
 	SpreadsheetID     string `arg:"" name:"spreadsheetId" help:"Spreadsheet ID"`
 	Range             string `arg:"" name:"range" help:"Range (eg. Sheet1!A1:B10)"`
 	MajorDimension    string `name:"dimension" help:"Major dimension: ROWS or COLUMNS"`
-	ValueRenderOption string `name:"render" help:"Value render option: FORMATTED_VALUE, UNFORMATTED_VALUE, or FORMULA"`
+	ValueRenderOption string `name:"render" aliases:"value-render" help:"Value render option: FORMATTED, UNFORMATTED, or FORMULA (API names like FORMATTED_VALUE also accepted)"`
+}
+
+func (*SheetsGetCmd) csvTable() {}
+
+// normalizeValueRender maps the short --render names to the API enum.
+func normalizeValueRender(raw string) (string, error) {
+	v := strings.ToUpper(strings.TrimSpace(raw))
+	switch v {
+	case "":
+		return "", nil
+	case "FORMATTED", "FORMATTED_VALUE":
+		return "FORMATTED_VALUE", nil
+	case "UNFORMATTED", "UNFORMATTED_VALUE":
+		return "UNFORMATTED_VALUE", nil
+	case "FORMULA":
+		return "FORMULA", nil
+	default:
+		return "", usagef("invalid --render %q (use FORMATTED, UNFORMATTED, or FORMULA)", raw)
+	}
 }
 
 func (c *SheetsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -87,6 +154,10 @@ func (c *SheetsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if strings.TrimSpace(rangeSpec) == "" {
 		return usage("empty range")
 	}
+	valueRender, err := normalizeValueRender(c.ValueRenderOption)
+	if err != nil {
+		return err
+	}
 
 	svc, err := newSheetsService(ctx, account)
 	if err != nil {
@@ -97,27 +168,45 @@ func (c *SheetsGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if strings.TrimSpace(c.MajorDimension) != "" {
 		call = call.MajorDimension(c.MajorDimension)
 	}
-	if strings.TrimSpace(c.ValueRenderOption) != "" {
-		call = call.ValueRenderOption(c.ValueRenderOption)
+	if valueRender != "" {
+		call = call.ValueRenderOption(valueRender)
 	}
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
+	// The API omits values for an empty range; report that as no rows.
+	values := resp.Values
+	if values == nil {
+		values = [][]interface{}{}
+	}
+
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"range":  resp.Range,
-			"values": resp.Values,
+			"values": values,
 		})
 	}
 
-	if len(resp.Values) == 0 {
+	if len(values) == 0 {
 		u.Err().Println("No data found")
 		return nil
 	}
 
+	if outfmt.IsCSV(ctx) {
+		// Sheet rows carry their own header row, so write them as-is.
+		rows := make([][]string, len(values))
+		for i, row := range values {
+			rows[i] = make([]string, len(row))
+			for j, cell := range row {
+				rows[i][j] = fmt.Sprintf("%v", cell)
+			}
+		}
+		return outfmt.WriteCSV(os.Stdout, rows[0], rows[1:])
+	}
+
 	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
 	for _, row := range resp.Values {
 		cells := make([]string, len(row))
@@ -134,8 +223,9 @@ type SheetsUpdateCmd struct {
 	SpreadsheetID      string   `arg:"" name:"spreadsheetId" help:"Spreadsheet ID"`
 	Range              string   `arg:"" name:"range" help:"Range (eg. Sheet1!A1:B2)"`
 	Values             []string `arg:"" optional:"" name:"values" help:"Values (comma-separated rows, pipe-separated cells)"`
-	ValueInput         string   `name:"input" help:"Value input option: RAW or USER_ENTERED" default:"USER_ENTERED"`
-	ValuesJSON         string   `name:"values-json" help:"Values as JSON 2D array"`
+	ValueInput         string   `name:"input" aliases:"value-input" help:"Value input option: RAW or USER_ENTERED" default:"USER_ENTERED"`
+	ValuesJSON         string   `name:"values-json" help:"Values as JSON 2D array (- reads stdin)"`
+	ValuesCSV          string   `name:"values-csv" help:"Read values from a CSV file (- reads stdin)"`
 	CopyValidationFrom string   `name:"copy-validation-from" help:"Copy data validation from an A1 range (eg. 'Sheet1!A2:D2') to the updated cells"`
 }
 
@@ -155,27 +245,13 @@ func (c *SheetsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty range")
 	}
 
-	var values [][]interface{}
-
-	switch {
-	case strings.TrimSpace(c.ValuesJSON) != "":
-		if unmarshalErr := json.Unmarshal([]byte(c.ValuesJSON), &values); unmarshalErr != nil {
-			return fmt.Errorf("invalid JSON values: %w", unmarshalErr)
-		}
-	case len(c.Values) > 0:
-		// Parse comma-separated rows, pipe-separated cells
-		rawValues := strings.Join(c.Values, " ")
-		rows := strings.Split(rawValues, ",")
-		for _, row := range rows {
-			cells := strings.Split(strings.TrimSpace(row), "|")
-			rowData := make([]interface{}, len(cells))
-			for i, cell := range cells {
-				rowData[i] = strings.TrimSpace(cell)
-			}
-			values = append(values, rowData)
-		}
-	default:
-		return fmt.Errorf("provide values as args or via --values-json")
+	valueInputOption, err := normalizeValueInput(c.ValueInput)
+	if err != nil {
+		return err
+	}
+	values, err := parseSheetsValues(c.Values, c.ValuesJSON, c.ValuesCSV)
+	if err != nil {
+		return err
 	}
 
 	svc, err := newSheetsService(ctx, account)
@@ -188,13 +264,9 @@ func (c *SheetsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	call := svc.Spreadsheets.Values.Update(spreadsheetID, rangeSpec, vr)
-	valueInputOption := strings.TrimSpace(c.ValueInput)
-	if valueInputOption == "" {
-		valueInputOption = "USER_ENTERED"
-	}
 	call = call.ValueInputOption(valueInputOption)
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -209,7 +281,7 @@ func (c *SheetsUpdateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"updatedRange":   resp.UpdatedRange,
 			"updatedRows":    resp.UpdatedRows,
 			"updatedColumns": resp.UpdatedColumns,
@@ -225,9 +297,10 @@ type SheetsAppendCmd struct {
 	SpreadsheetID      string   `arg:"" name:"spreadsheetId" help:"Spreadsheet ID"`
 	Range              string   `arg:"" name:"range" help:"Range (eg. Sheet1!A:C)"`
 	Values             []string `arg:"" optional:"" name:"values" help:"Values (comma-separated rows, pipe-separated cells)"`
-	ValueInput         string   `name:"input" help:"Value input option: RAW or USER_ENTERED" default:"USER_ENTERED"`
+	ValueInput         string   `name:"input" aliases:"value-input" help:"Value input option: RAW or USER_ENTERED" default:"USER_ENTERED"`
 	Insert             string   `name:"insert" help:"Insert data option: OVERWRITE or INSERT_ROWS"`
-	ValuesJSON         string   `name:"values-json" help:"Values as JSON 2D array"`
+	ValuesJSON         string   `name:"values-json" help:"Values as JSON 2D array (- reads stdin)"`
+	ValuesCSV          string   `name:"values-csv" help:"Read values from a CSV file (- reads stdin)"`
 	CopyValidationFrom string   `name:"copy-validation-from" help:"Copy data validation from an A1 range (eg. 'Sheet1!A2:D2') to the appended cells"`
 }
 
@@ -247,26 +320,13 @@ func (c *SheetsAppendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return usage("empty range")
 	}
 
-	var values [][]interface{}
-
-	switch {
-	case strings.TrimSpace(c.ValuesJSON) != "":
-		if unmarshalErr := json.Unmarshal([]byte(c.ValuesJSON), &values); unmarshalErr != nil {
-			return fmt.Errorf("invalid JSON values: %w", unmarshalErr)
-		}
-	case len(c.Values) > 0:
-		rawValues := strings.Join(c.Values, " ")
-		rows := strings.Split(rawValues, ",")
-		for _, row := range rows {
-			cells := strings.Split(strings.TrimSpace(row), "|")
-			rowData := make([]interface{}, len(cells))
-			for i, cell := range cells {
-				rowData[i] = strings.TrimSpace(cell)
-			}
-			values = append(values, rowData)
-		}
-	default:
-		return fmt.Errorf("provide values as args or via --values-json")
+	valueInputOption, err := normalizeValueInput(c.ValueInput)
+	if err != nil {
+		return err
+	}
+	values, err := parseSheetsValues(c.Values, c.ValuesJSON, c.ValuesCSV)
+	if err != nil {
+		return err
 	}
 
 	svc, err := newSheetsService(ctx, account)
@@ -279,16 +339,12 @@ func (c *SheetsAppendCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	call := svc.Spreadsheets.Values.Append(spreadsheetID, rangeSpec, vr)
-	valueInputOption := strings.TrimSpace(c.ValueInput)
-	if valueInputOption == "" {
-		valueInputOption = "USER_ENTERED"
-	}
 	call = call.ValueInputOption(valueInputOption)
 	if strings.TrimSpace(c.Insert) != "" {
 		call = call.InsertDataOption(c.Insert)
 	}
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
@@ -302,16 +358,20 @@ func (c *SheetsAppendCmd) Run(ctx context.Context, flags *RootFlags) error {
 		}
 	}
 
+	updates := resp.Updates
+	if updates == nil {
+		updates = &sheets.UpdateValuesResponse{}
+	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"updatedRange":   resp.Updates.UpdatedRange,
-			"updatedRows":    resp.Updates.UpdatedRows,
-			"updatedColumns": resp.Updates.UpdatedColumns,
-			"updatedCells":   resp.Updates.UpdatedCells,
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"updatedRange":   updates.UpdatedRange,
+			"updatedRows":    updates.UpdatedRows,
+			"updatedColumns": updates.UpdatedColumns,
+			"updatedCells":   updates.UpdatedCells,
 		})
 	}
 
-	u.Out().Printf("Appended %d cells to %s", resp.Updates.UpdatedCells, resp.Updates.UpdatedRange)
+	u.Out().Printf("Appended %d cells to %s", updates.UpdatedCells, updates.UpdatedRange)
 	return nil
 }
 
@@ -341,13 +401,13 @@ func (c *SheetsClearCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	resp, err := svc.Spreadsheets.Values.Clear(spreadsheetID, rangeSpec, &sheets.ClearValuesRequest{}).Do()
+	resp, err := svc.Spreadsheets.Values.Clear(spreadsheetID, rangeSpec, &sheets.ClearValuesRequest{}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"clearedRange": resp.ClearedRange,
 		})
 	}
@@ -377,13 +437,13 @@ func (c *SheetsMetadataCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	resp, err := svc.Spreadsheets.Get(spreadsheetID).Do()
+	resp, err := svc.Spreadsheets.Get(spreadsheetID).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"spreadsheetId": resp.SpreadsheetId,
 			"title":         resp.Properties.Title,
 			"locale":        resp.Properties.Locale,
@@ -455,13 +515,13 @@ func (c *SheetsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		}
 	}
 
-	resp, err := svc.Spreadsheets.Create(spreadsheet).Do()
+	resp, err := svc.Spreadsheets.Create(spreadsheet).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"spreadsheetId":  resp.SpreadsheetId,
 			"title":          resp.Properties.Title,
 			"spreadsheetUrl": resp.SpreadsheetUrl,
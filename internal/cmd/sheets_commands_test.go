@@ -255,3 +255,66 @@ func TestSheetsCommands_Text(t *testing.T) {
 		t.Fatalf("unexpected output: %q", out)
 	}
 }
+
+func TestSheetsGet_RenderCSVAndEmpty(t *testing.T) {
+	origNew := newSheetsService
+	t.Cleanup(func() { newSheetsService = origNew })
+
+	var renders []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		renders = append(renders, r.URL.Query().Get("valueRenderOption"))
+		if strings.Contains(r.URL.Path, "Empty") {
+			_ = json.NewEncoder(w).Encode(map[string]any{"range": "Empty!A1:B2"})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"range":  "Sheet1!A1:B2",
+			"values": [][]any{{"name", "note"}, {"a", "x, y"}},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := sheets.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSheetsService = func(context.Context, string) (*sheets.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	flags := &RootFlags{Account: "a@b.com"}
+
+	csvCtx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{CSV: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SheetsGetCmd{}, []string{"s1", "Sheet1!A1:B2", "--value-render", "unformatted"}, csvCtx, flags); err != nil {
+			t.Fatalf("get csv: %v", err)
+		}
+	})
+	if out != "name,note\r\na,\"x, y\"\r\n" {
+		t.Fatalf("unexpected csv: %q", out)
+	}
+	if len(renders) != 1 || renders[0] != "UNFORMATTED_VALUE" {
+		t.Fatalf("unexpected render option: %v", renders)
+	}
+
+	jsonCtx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	out = captureStdout(t, func() {
+		if err := runKong(t, &SheetsGetCmd{}, []string{"s1", "Empty!A1:B2"}, jsonCtx, flags); err != nil {
+			t.Fatalf("get empty: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"values": []`) {
+		t.Fatalf("expected empty values array, got %q", out)
+	}
+
+	if err := runKong(t, &SheetsGetCmd{}, []string{"s1", "Sheet1!A1", "--render", "pretty"}, jsonCtx, flags); err == nil {
+		t.Fatal("expected error for invalid --render")
+	}
+}
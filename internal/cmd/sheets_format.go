@@ -89,12 +89,12 @@ func (c *SheetsFormatCmd) Run(ctx context.Context, flags *RootFlags) error {
 		},
 	}
 
-	if _, err := svc.Spreadsheets.BatchUpdate(spreadsheetID, req).Do(); err != nil {
+	if _, err := svc.Spreadsheets.BatchUpdate(spreadsheetID, req).Context(ctx).Do(); err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"range":  rangeSpec,
 			"fields": formatFields,
 		})
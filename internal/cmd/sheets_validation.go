@@ -44,7 +44,7 @@ func copyDataValidation(ctx context.Context, svc *sheets.Service, spreadsheetID,
 		},
 	}
 
-	_, err = svc.Spreadsheets.BatchUpdate(spreadsheetID, req).Do()
+	_, err = svc.Spreadsheets.BatchUpdate(spreadsheetID, req).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("apply data validation: %w", err)
 	}
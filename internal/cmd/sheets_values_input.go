@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// parseSheetsValues builds the 2D value grid for sheets update/append from
+// exactly one source: positional args, --values-json, or --values-csv.
+// JSON and CSV sources read stdin when given "-".
+func parseSheetsValues(args []string, valuesJSON, valuesCSV string) ([][]interface{}, error) {
+	valuesJSON = strings.TrimSpace(valuesJSON)
+	valuesCSV = strings.TrimSpace(valuesCSV)
+
+	sources := 0
+	for _, set := range []bool{len(args) > 0, valuesJSON != "", valuesCSV != ""} {
+		if set {
+			sources++
+		}
+	}
+	if sources == 0 {
+		return nil, usage("provide values as args, --values-json, or --values-csv")
+	}
+	if sources > 1 {
+		return nil, usage("use only one of values args, --values-json, or --values-csv")
+	}
+
+	var values [][]interface{}
+	switch {
+	case valuesJSON != "":
+		data := []byte(valuesJSON)
+		if valuesJSON == "-" {
+			b, err := readInputFile("-")
+			if err != nil {
+				return nil, fmt.Errorf("read values from stdin: %w", err)
+			}
+			data = b
+		}
+		// UseNumber keeps large integers and IDs exact instead of rounding
+		// them through float64.
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			return nil, usagef("invalid --values-json: expected a 2D array like [[\"a\",\"b\"]]: %v", err)
+		}
+		if dec.More() {
+			return nil, usage("invalid --values-json: unexpected data after the array")
+		}
+	case valuesCSV != "":
+		data, err := readInputFile(valuesCSV)
+		if err != nil {
+			return nil, fmt.Errorf("read --values-csv: %w", err)
+		}
+		r := csv.NewReader(bytes.NewReader(data))
+		r.FieldsPerRecord = -1
+		records, err := r.ReadAll()
+		if err != nil {
+			return nil, usagef("invalid --values-csv: %v", err)
+		}
+		for _, record := range records {
+			row := make([]interface{}, len(record))
+			for i, cell := range record {
+				row[i] = cell
+			}
+			values = append(values, row)
+		}
+	default:
+		// Parse comma-separated rows, pipe-separated cells
+		rawValues := strings.Join(args, " ")
+		rows := strings.Split(rawValues, ",")
+		for _, row := range rows {
+			cells := strings.Split(strings.TrimSpace(row), "|")
+			rowData := make([]interface{}, len(cells))
+			for i, cell := range cells {
+				rowData[i] = strings.TrimSpace(cell)
+			}
+			values = append(values, rowData)
+		}
+	}
+
+	if err := validateSheetsValues(values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// validateSheetsValues requires a non-empty grid of scalar cells; nested
+// arrays or objects would be rejected by the API with a less helpful error.
+func validateSheetsValues(values [][]interface{}) error {
+	if len(values) == 0 {
+		return usage("values must contain at least one row")
+	}
+	for i, row := range values {
+		for j, cell := range row {
+			switch cell.(type) {
+			case nil, string, json.Number, float64, bool:
+			default:
+				return usagef("values[%d][%d] must be a string, number, boolean, or null", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+func normalizeValueInput(raw string) (string, error) {
+	v := strings.ToUpper(strings.TrimSpace(raw))
+	switch v {
+	case "":
+		return "USER_ENTERED", nil
+	case "RAW", "USER_ENTERED":
+		return v, nil
+	default:
+		return "", usagef("invalid --input %q (use RAW or USER_ENTERED)", raw)
+	}
+}
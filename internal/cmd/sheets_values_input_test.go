@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/sheets/v4"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestParseSheetsValues(t *testing.T) {
+	csvPath := filepath.Join(t.TempDir(), "rows.csv")
+	if err := os.WriteFile(csvPath, []byte("date,count\n2025-01-01,3\n\"a, b\",\n"), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	got, err := parseSheetsValues(nil, "", csvPath)
+	if err != nil {
+		t.Fatalf("csv: %v", err)
+	}
+	want := [][]interface{}{{"date", "count"}, {"2025-01-01", "3"}, {"a, b", ""}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("csv values = %#v", got)
+	}
+
+	got, err = parseSheetsValues(nil, `[["a", 1, true, null, 12345678901234567890]]`, "")
+	if err != nil {
+		t.Fatalf("json: %v", err)
+	}
+	if !reflect.DeepEqual(got, [][]interface{}{{"a", json.Number("1"), true, nil, json.Number("12345678901234567890")}}) {
+		t.Fatalf("json values = %#v", got)
+	}
+
+	got, err = parseSheetsValues([]string{"a|b,c|d"}, "", "")
+	if err != nil || !reflect.DeepEqual(got, [][]interface{}{{"a", "b"}, {"c", "d"}}) {
+		t.Fatalf("args values = %#v (err=%v)", got, err)
+	}
+
+	for name, tc := range map[string]struct {
+		args        []string
+		json, csv   string
+		errContains string
+	}{
+		"none":       {errContains: "provide values"},
+		"two":        {args: []string{"a"}, json: `[["a"]]`, errContains: "only one"},
+		"flat array": {json: `["a","b"]`, errContains: "2D array"},
+		"empty":      {json: `[]`, errContains: "at least one row"},
+		"nested":     {json: `[["a",["b"]]]`, errContains: "values[0][1]"},
+		"object":     {json: `[[{"a":1}]]`, errContains: "values[0][0]"},
+		"trailing":   {json: `[["a"]] [["b"]]`, errContains: "after the array"},
+	} {
+		if _, err := parseSheetsValues(tc.args, tc.json, tc.csv); err == nil || !strings.Contains(err.Error(), tc.errContains) || ExitCode(err) != 2 {
+			t.Fatalf("%s: expected usage error containing %q, got %v", name, tc.errContains, err)
+		}
+	}
+}
+
+func TestSheetsAppend_ValuesCSVAndValueInput(t *testing.T) {
+	origNew := newSheetsService
+	t.Cleanup(func() { newSheetsService = origNew })
+
+	var (
+		gotValues [][]interface{}
+		gotInput  string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.Contains(r.URL.Path, ":append") {
+			http.NotFound(w, r)
+			return
+		}
+		gotInput = r.URL.Query().Get("valueInputOption")
+		var body sheets.ValueRange
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		gotValues = body.Values
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"updates": map[string]any{"updatedRange": "Log!A5:B5", "updatedRows": 1, "updatedColumns": 2, "updatedCells": 2},
+		})
+	}))
+	defer srv.Close()
+
+	svc, err := sheets.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newSheetsService = func(context.Context, string) (*sheets.Service, error) { return svc, nil }
+
+	csvPath := filepath.Join(t.TempDir(), "row.csv")
+	if err := os.WriteFile(csvPath, []byte("2025-01-01,=1+1\n"), 0o600); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SheetsAppendCmd{}, []string{"s1", "Log!A:B", "--values-csv", csvPath, "--value-input", "raw"}, ctx, flags); err != nil {
+			t.Fatalf("append: %v", err)
+		}
+	})
+	if gotInput != "RAW" {
+		t.Fatalf("valueInputOption = %q", gotInput)
+	}
+	if !reflect.DeepEqual(gotValues, [][]interface{}{{"2025-01-01", "=1+1"}}) {
+		t.Fatalf("values = %#v", gotValues)
+	}
+	var parsed struct {
+		UpdatedRange string `json:"updatedRange"`
+		UpdatedCells int64  `json:"updatedCells"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.UpdatedRange != "Log!A5:B5" || parsed.UpdatedCells != 2 {
+		t.Fatalf("unexpected output: %+v", parsed)
+	}
+
+	if err := runKong(t, &SheetsAppendCmd{}, []string{"s1", "Log!A:B", "a|b", "--input", "FORMULA"}, ctx, flags); err == nil || ExitCode(err) != 2 {
+		t.Fatalf("expected usage error for invalid --input, got %v", err)
+	}
+}
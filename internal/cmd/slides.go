@@ -8,15 +8,25 @@ import (
 
 	"google.golang.org/api/drive/v3"
 
+	"github.com/steipete/gogcli/internal/googleapi"
 	"github.com/steipete/gogcli/internal/outfmt"
 	"github.com/steipete/gogcli/internal/ui"
 )
 
+var newSlidesService = googleapi.NewSlides
+
 type SlidesCmd struct {
-	Export SlidesExportCmd `cmd:"" name:"export" help:"Export a Google Slides deck (pdf|pptx)"`
-	Info   SlidesInfoCmd   `cmd:"" name:"info" help:"Get Google Slides presentation metadata"`
-	Create SlidesCreateCmd `cmd:"" name:"create" help:"Create a Google Slides presentation"`
-	Copy   SlidesCopyCmd   `cmd:"" name:"copy" help:"Copy a Google Slides presentation"`
+	Export       SlidesExportCmd             `cmd:"" name:"export" help:"Export a Google Slides deck (pdf|pptx)"`
+	Info         SlidesInfoCmd               `cmd:"" name:"info" help:"Get Google Slides presentation metadata"`
+	List         SlidesListCmd               `cmd:"" name:"list" aliases:"ls" help:"List slides with object IDs, layouts and titles"`
+	Create       SlidesCreateCmd             `cmd:"" name:"create" help:"Create a Google Slides presentation"`
+	Copy         SlidesCopyCmd               `cmd:"" name:"copy" help:"Copy a Google Slides presentation"`
+	FromTemplate SlidesCreateFromTemplateCmd `cmd:"" name:"from-template" help:"Copy a template deck, fill {{key}} placeholders and swap tagged images"`
+	AddSlide     SlidesAddSlideCmd           `cmd:"" name:"add-slide" help:"Add a slide, optionally with a predefined layout"`
+	Duplicate    SlidesDuplicateCmd          `cmd:"" name:"duplicate" aliases:"dup" help:"Duplicate a slide (speaker notes included)"`
+	SetText      SlidesSetTextCmd            `cmd:"" name:"set-text" help:"Replace the text of a placeholder or shape on a slide"`
+	AddTextBox   SlidesAddTextBoxCmd         `cmd:"" name:"add-text-box" help:"Add a text box to a slide"`
+	Notes        SlidesNotesCmd              `cmd:"" name:"notes" help:"Print every slide's speaker notes (or write a Markdown script)"`
 }
 
 type SlidesExportCmd struct {
@@ -90,7 +100,7 @@ func (c *SlidesCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{strFile: created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{strFile: created})
 	}
 
 	u.Out().Printf("id\t%s", created.Id)
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// slidesPredefinedLayouts are the Slides API PredefinedLayout values.
+var slidesPredefinedLayouts = []string{
+	"BLANK",
+	"CAPTION_ONLY",
+	"TITLE",
+	"TITLE_AND_BODY",
+	"TITLE_AND_TWO_COLUMNS",
+	"TITLE_ONLY",
+	"SECTION_HEADER",
+	"SECTION_TITLE_AND_DESCRIPTION",
+	"ONE_COLUMN_TEXT",
+	"MAIN_POINT",
+	"BIG_NUMBER",
+}
+
+type SlidesAddSlideCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	Layout         string `name:"layout" help:"Predefined layout from the deck's theme: BLANK, TITLE, TITLE_AND_BODY, TITLE_ONLY, SECTION_HEADER, ... (default: API default)"`
+	Index          int64  `name:"index" help:"Insert at this 0-based index (default: end of deck)" default:"-1"`
+}
+
+func (c *SlidesAddSlideCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	presentationID := strings.TrimSpace(c.PresentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+	if c.Index < -1 {
+		return usage("--index must be >= 0")
+	}
+	layout, err := normalizeSlidesLayout(c.Layout)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := getPresentation(ctx, svc, presentationID)
+	if err != nil {
+		return err
+	}
+	count := len(pres.Slides) + 1
+	if c.Index > int64(len(pres.Slides)) {
+		return usagef("--index %d out of range (deck will have %d slides)", c.Index, count)
+	}
+
+	req := &slides.CreateSlideRequest{}
+	if layout != "" {
+		req.SlideLayoutReference = &slides.LayoutReference{PredefinedLayout: layout}
+	}
+	position := int64(len(pres.Slides))
+	if c.Index >= 0 {
+		req.InsertionIndex = c.Index
+		req.ForceSendFields = []string{"InsertionIndex"}
+		position = c.Index
+	}
+
+	resp, err := svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: []*slides.Request{{CreateSlide: req}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if len(resp.Replies) == 0 || resp.Replies[0].CreateSlide == nil {
+		return errors.New("add slide: empty response")
+	}
+	newID := resp.Replies[0].CreateSlide.ObjectId
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"presentationId": presentationID,
+			"objectId":       newID,
+			"layout":         layout,
+			"number":         position + 1,
+			"slideCount":     count,
+		})
+	}
+	u.Out().Printf("id\t%s", presentationID)
+	u.Out().Printf("objectId\t%s", newID)
+	if layout != "" {
+		u.Out().Printf("layout\t%s", layout)
+	}
+	u.Out().Printf("number\t%d", position+1)
+	u.Out().Printf("slides\t%d", count)
+	return nil
+}
+
+// normalizeSlidesLayout accepts layouts in any case, with - or _ separators.
+func normalizeSlidesLayout(raw string) (string, error) {
+	layout := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(raw), "-", "_"))
+	if layout == "" {
+		return "", nil
+	}
+	for _, known := range slidesPredefinedLayouts {
+		if layout == known {
+			return layout, nil
+		}
+	}
+	return "", usagef("invalid --layout %q (expected one of: %s)", raw, strings.Join(slidesPredefinedLayouts, ", "))
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestSlidesAddSlideCmd_Layout(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/p1":
+			_ = json.NewEncoder(w).Encode(testDeck())
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/p1:batchUpdate":
+			raw, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(raw))
+			_ = json.NewEncoder(w).Encode(map[string]any{"replies": []any{
+				map[string]any{"createSlide": map[string]any{"objectId": "s_new"}},
+			}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("slides.NewService: %v", err)
+	}
+	origNew := newSlidesService
+	t.Cleanup(func() { newSlidesService = origNew })
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SlidesAddSlideCmd{}, []string{"p1", "--layout", "title-and-body", "--index", "0"}, ctx, flags); err != nil {
+			t.Fatalf("add-slide: %v", err)
+		}
+	})
+	var req slides.BatchUpdatePresentationRequest
+	if err := json.Unmarshal([]byte(bodies[0]), &req); err != nil {
+		t.Fatalf("decode request: %v", err)
+	}
+	create := req.Requests[0].CreateSlide
+	if create == nil || create.SlideLayoutReference == nil || create.SlideLayoutReference.PredefinedLayout != "TITLE_AND_BODY" || !strings.Contains(bodies[0], `"insertionIndex":0`) {
+		t.Fatalf("unexpected request: %s", bodies[0])
+	}
+	var parsed struct {
+		ObjectID   string `json:"objectId"`
+		Layout     string `json:"layout"`
+		Number     int    `json:"number"`
+		SlideCount int    `json:"slideCount"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	if parsed.ObjectID != "s_new" || parsed.Layout != "TITLE_AND_BODY" || parsed.Number != 1 || parsed.SlideCount != 3 {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+
+	// Without flags the request keeps the API defaults and appends the slide.
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &SlidesAddSlideCmd{}, []string{"p1"}, ctx, flags); err != nil {
+			t.Fatalf("add-slide: %v", err)
+		}
+	})
+	if strings.Contains(bodies[1], "slideLayoutReference") || strings.Contains(bodies[1], "insertionIndex") {
+		t.Fatalf("unexpected default request: %s", bodies[1])
+	}
+
+	if err := runKong(t, &SlidesAddSlideCmd{}, []string{"p1", "--layout", "FANCY"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "TITLE_AND_BODY") {
+		t.Fatalf("expected invalid layout error, got %v", err)
+	}
+	if len(bodies) != 2 {
+		t.Fatalf("expected no request for invalid layout, got %d", len(bodies))
+	}
+}
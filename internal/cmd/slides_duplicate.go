@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesDuplicateCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	Slide          string `arg:"" name:"slide" help:"Slide object ID or 1-based slide number"`
+	ToIndex        int64  `name:"to-index" help:"Move the copy to this 0-based index (default: right after the original)" default:"-1"`
+}
+
+func (c *SlidesDuplicateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	presentationID := strings.TrimSpace(c.PresentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+	if c.ToIndex < -1 {
+		return usage("--to-index must be >= 0")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := getPresentation(ctx, svc, presentationID)
+	if err != nil {
+		return err
+	}
+	page, number, err := findSlide(pres, c.Slide)
+	if err != nil {
+		return err
+	}
+	count := len(pres.Slides) + 1
+	if c.ToIndex > int64(len(pres.Slides)) {
+		return usagef("--to-index %d out of range (deck will have %d slides)", c.ToIndex, count)
+	}
+
+	resp, err := svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: []*slides.Request{{DuplicateObject: &slides.DuplicateObjectRequest{ObjectId: page.ObjectId}}},
+	}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if len(resp.Replies) == 0 || resp.Replies[0].DuplicateObject == nil {
+		return errors.New("duplicate slide: empty response")
+	}
+	newID := resp.Replies[0].DuplicateObject.ObjectId
+
+	// The copy lands directly after the original (0-based index == number).
+	position := int64(number)
+	if c.ToIndex >= 0 && c.ToIndex != position {
+		// InsertionIndex refers to the arrangement before the move, which
+		// still contains the copy, so later targets are one further along.
+		insertion := c.ToIndex
+		if c.ToIndex > position {
+			insertion++
+		}
+		if _, err := svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+			Requests: []*slides.Request{{UpdateSlidesPosition: &slides.UpdateSlidesPositionRequest{
+				SlideObjectIds:  []string{newID},
+				InsertionIndex:  insertion,
+				ForceSendFields: []string{"InsertionIndex"},
+			}}},
+		}).Context(ctx).Do(); err != nil {
+			return err
+		}
+		position = c.ToIndex
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"presentationId": presentationID,
+			"sourceId":       page.ObjectId,
+			"objectId":       newID,
+			"number":         position + 1,
+			"slideCount":     count,
+		})
+	}
+	u.Out().Printf("id\t%s", presentationID)
+	u.Out().Printf("source\t%s", page.ObjectId)
+	u.Out().Printf("objectId\t%s", newID)
+	u.Out().Printf("number\t%d", position+1)
+	u.Out().Printf("slides\t%d", count)
+	return nil
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type slidesDuplicateResult struct {
+	ObjectID   string `json:"objectId"`
+	Number     int    `json:"number"`
+	SlideCount int    `json:"slideCount"`
+}
+
+func runSlidesDuplicate(t *testing.T, deck map[string]any, args []string) ([]slides.BatchUpdatePresentationRequest, slidesDuplicateResult) {
+	t.Helper()
+	var batches []slides.BatchUpdatePresentationRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/p1":
+			_ = json.NewEncoder(w).Encode(deck)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/p1:batchUpdate":
+			var req slides.BatchUpdatePresentationRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			batches = append(batches, req)
+			if dup := req.Requests[0].DuplicateObject; dup != nil {
+				_ = json.NewEncoder(w).Encode(map[string]any{"replies": []any{
+					map[string]any{"duplicateObject": map[string]any{"objectId": dup.ObjectId + "_copy"}},
+				}})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"replies": []any{map[string]any{}}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("slides.NewService: %v", err)
+	}
+	origNew := newSlidesService
+	t.Cleanup(func() { newSlidesService = origNew })
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SlidesDuplicateCmd{}, args, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("duplicate: %v", err)
+		}
+	})
+	var parsed slidesDuplicateResult
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	return batches, parsed
+}
+
+func threeSlideDeck() map[string]any {
+	return map[string]any{
+		"presentationId": "p1",
+		"slides": []map[string]any{
+			{"objectId": "s1"}, {"objectId": "s2"}, {"objectId": "s3"},
+		},
+	}
+}
+
+func TestSlidesDuplicateCmd_ToIndex(t *testing.T) {
+	batches, parsed := runSlidesDuplicate(t, testDeck(), []string{"p1", "2", "--to-index", "0"})
+
+	if len(batches) != 2 || batches[0].Requests[0].DuplicateObject.ObjectId != "s2" {
+		t.Fatalf("unexpected batches: %#v", batches)
+	}
+	move := batches[1].Requests[0].UpdateSlidesPosition
+	if move == nil || move.SlideObjectIds[0] != "s2_copy" || move.InsertionIndex != 0 {
+		t.Fatalf("unexpected move: %#v", batches[1].Requests[0])
+	}
+	if parsed.ObjectID != "s2_copy" || parsed.Number != 1 || parsed.SlideCount != 3 {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+}
+
+func TestSlidesDuplicateCmd_ToIndexLater(t *testing.T) {
+	// Copy of s1 starts at index 1 of [s1 copy s2 s3]; index 2 means after s2.
+	for _, tc := range []struct {
+		toIndex   string
+		insertion int64
+		number    int
+	}{
+		{toIndex: "2", insertion: 3, number: 3},
+		{toIndex: "3", insertion: 4, number: 4}, // last slot
+	} {
+		batches, parsed := runSlidesDuplicate(t, threeSlideDeck(), []string{"p1", "s1", "--to-index", tc.toIndex})
+		if len(batches) != 2 {
+			t.Fatalf("--to-index %s: expected duplicate+move, got %d batches", tc.toIndex, len(batches))
+		}
+		move := batches[1].Requests[0].UpdateSlidesPosition
+		if move == nil || move.SlideObjectIds[0] != "s1_copy" || move.InsertionIndex != tc.insertion {
+			t.Fatalf("--to-index %s: unexpected move: %#v", tc.toIndex, batches[1].Requests[0])
+		}
+		if parsed.Number != tc.number || parsed.SlideCount != 4 {
+			t.Fatalf("--to-index %s: unexpected output: %#v", tc.toIndex, parsed)
+		}
+	}
+}
+
+func TestSlidesDuplicateCmd_ToIndexCurrentPosition(t *testing.T) {
+	batches, parsed := runSlidesDuplicate(t, threeSlideDeck(), []string{"p1", "s1", "--to-index", "1"})
+	if len(batches) != 1 || parsed.Number != 2 {
+		t.Fatalf("expected no move, got %d batches, %#v", len(batches), parsed)
+	}
+}
+
+func TestSlidesDuplicateCmd_ToIndexOutOfRange(t *testing.T) {
+	batches := stubSlidesService(t, testDeck())
+
+	err := (&SlidesDuplicateCmd{PresentationID: "p1", Slide: "s1", ToIndex: 5}).Run(context.Background(), &RootFlags{Account: "a@b.com"})
+	if err == nil {
+		t.Fatal("expected out of range --to-index error")
+	}
+	if len(*batches) != 0 {
+		t.Fatalf("expected no batch updates, got %d", len(*batches))
+	}
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesListCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+}
+
+func (*SlidesListCmd) csvTable() {}
+
+type slideSummary struct {
+	Number   int    `json:"number"`
+	ObjectID string `json:"objectId"`
+	Title    string `json:"title"`
+	Layout   string `json:"layout,omitempty"`
+	Elements int    `json:"elements"`
+}
+
+func (c *SlidesListCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	presentationID := strings.TrimSpace(c.PresentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := getPresentation(ctx, svc, presentationID)
+	if err != nil {
+		return err
+	}
+	items := summarizeSlides(pres)
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"presentationId": presentationID,
+			"slides":         items,
+		})
+	}
+	if len(items) == 0 {
+		u.Err().Println("No slides")
+		return nil
+	}
+
+	rows := make([][]string, 0, len(items))
+	for _, it := range items {
+		rows = append(rows, []string{strconv.Itoa(it.Number), it.ObjectID, it.Layout, strconv.Itoa(it.Elements), it.Title})
+	}
+	return writeTable(ctx, []string{"#", "ID", "LAYOUT", "ELEMENTS", "TITLE"}, rows)
+}
+
+func summarizeSlides(pres *slides.Presentation) []slideSummary {
+	layoutNames := make(map[string]string, len(pres.Layouts))
+	for _, layout := range pres.Layouts {
+		if layout == nil || layout.LayoutProperties == nil {
+			continue
+		}
+		name := layout.LayoutProperties.DisplayName
+		if name == "" {
+			name = layout.LayoutProperties.Name
+		}
+		layoutNames[layout.ObjectId] = name
+	}
+
+	items := make([]slideSummary, 0, len(pres.Slides))
+	for i, page := range pres.Slides {
+		if page == nil {
+			continue
+		}
+		item := slideSummary{
+			Number:   i + 1,
+			ObjectID: page.ObjectId,
+			Title:    strings.TrimSpace(shapeText(findPlaceholderShape(page, placeholderTypes["title"]...))),
+			Elements: len(page.PageElements),
+		}
+		if page.SlideProperties != nil {
+			item.Layout = layoutNames[page.SlideProperties.LayoutObjectId]
+			if item.Layout == "" {
+				item.Layout = page.SlideProperties.LayoutObjectId
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestSlidesListCmd(t *testing.T) {
+	deck := testDeck()
+	deck["layouts"] = []map[string]any{
+		{"objectId": "l1", "layoutProperties": map[string]any{"name": "TITLE", "displayName": "Title slide"}},
+	}
+	deck["slides"].([]map[string]any)[0]["slideProperties"] = map[string]any{"layoutObjectId": "l1"}
+	stubSlidesService(t, deck)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	flags := &RootFlags{Account: "a@b.com"}
+
+	jsonCtx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SlidesListCmd{}, []string{"p1"}, jsonCtx, flags); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	var parsed struct {
+		Slides []slideSummary `json:"slides"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json: %v\n%s", err, out)
+	}
+	want := []slideSummary{
+		{Number: 1, ObjectID: "s1", Title: "Old title", Layout: "Title slide", Elements: 1},
+		{Number: 2, ObjectID: "s2", Elements: 1},
+	}
+	if len(parsed.Slides) != len(want) || parsed.Slides[0] != want[0] || parsed.Slides[1] != want[1] {
+		t.Fatalf("unexpected slides: %#v", parsed.Slides)
+	}
+
+	textOut := captureStdout(t, func() {
+		u2, uiErr := ui.New(ui.Options{Stdout: os.Stdout, Stderr: io.Discard, Color: "never"})
+		if uiErr != nil {
+			t.Fatalf("ui.New: %v", uiErr)
+		}
+		if err := runKong(t, &SlidesListCmd{}, []string{"p1"}, ui.WithUI(context.Background(), u2), flags); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	if !strings.Contains(textOut, "ELEMENTS") || !strings.Contains(textOut, "Title slide") {
+		t.Fatalf("unexpected text output: %s", textOut)
+	}
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/config"
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesNotesCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	Output         string `name:"out" aliases:"output" help:"Write the notes as a Markdown script (## Slide N sections) to this file"`
+	Overwrite      bool   `name:"overwrite" help:"Overwrite output file if it exists"`
+}
+
+type slideNotes struct {
+	SlideNumber int    `json:"slideNumber"`
+	ObjectID    string `json:"objectId"`
+	Notes       string `json:"notes"`
+}
+
+func (c *SlidesNotesCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	presentationID := strings.TrimSpace(c.PresentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+	outPath := strings.TrimSpace(c.Output)
+	if outPath != "" {
+		if outPath, err = config.ExpandPath(outPath); err != nil {
+			return err
+		}
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := getPresentation(ctx, svc, presentationID)
+	if err != nil {
+		return err
+	}
+	notes := collectSlideNotes(pres)
+
+	if outPath != "" {
+		if err := writeSlideNotesFile(outPath, slideNotesMarkdown(pres.Title, notes), c.Overwrite); err != nil {
+			return err
+		}
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"path": outPath, "slides": len(notes)})
+		}
+		u.Out().Printf("path\t%s", outPath)
+		u.Out().Printf("slides\t%d", len(notes))
+		return nil
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, notes)
+	}
+	if len(notes) == 0 {
+		u.Err().Println("No slides")
+		return nil
+	}
+	for i, n := range notes {
+		if i > 0 {
+			u.Out().Println("")
+		}
+		u.Out().Printf("Slide %d (%s)", n.SlideNumber, n.ObjectID)
+		if n.Notes != "" {
+			u.Out().Println(n.Notes)
+		}
+	}
+	return nil
+}
+
+// collectSlideNotes returns the speaker notes of every slide in order; slides
+// without notes get an empty string.
+func collectSlideNotes(pres *slides.Presentation) []slideNotes {
+	out := make([]slideNotes, 0, len(pres.Slides))
+	for i, page := range pres.Slides {
+		if page == nil {
+			continue
+		}
+		out = append(out, slideNotes{
+			SlideNumber: i + 1,
+			ObjectID:    page.ObjectId,
+			Notes:       strings.TrimRight(speakerNotesText(page), "\n"),
+		})
+	}
+	return out
+}
+
+// speakerNotesText reads the shape named by the notes page's
+// speakerNotesObjectId, falling back to its BODY placeholder.
+func speakerNotesText(page *slides.Page) string {
+	if page.SlideProperties == nil || page.SlideProperties.NotesPage == nil {
+		return ""
+	}
+	notesPage := page.SlideProperties.NotesPage
+	if props := notesPage.NotesProperties; props != nil && props.SpeakerNotesObjectId != "" {
+		if el := findPageElement(notesPage, props.SpeakerNotesObjectId); el != nil {
+			return shapeText(el)
+		}
+	}
+	return shapeText(findPlaceholderShape(notesPage, "BODY"))
+}
+
+// writeSlideNotesFile writes the script, refusing to replace an existing file
+// unless overwrite is set.
+func writeSlideNotesFile(outPath, content string, overwrite bool) error {
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if !overwrite {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_EXCL
+	}
+	f, err := os.OpenFile(outPath, flags, 0o600) //nolint:gosec // user-provided path
+	if err != nil {
+		return err
+	}
+	_, writeErr := f.WriteString(content)
+	return errors.Join(writeErr, f.Close())
+}
+
+func slideNotesMarkdown(title string, notes []slideNotes) string {
+	var b strings.Builder
+	if title = strings.TrimSpace(title); title != "" {
+		fmt.Fprintf(&b, "# %s\n\n", title)
+	}
+	for _, n := range notes {
+		fmt.Fprintf(&b, "## Slide %d\n\n", n.SlideNumber)
+		if n.Notes != "" {
+			b.WriteString(n.Notes)
+			b.WriteString("\n\n")
+		}
+	}
+	return b.String()
+}
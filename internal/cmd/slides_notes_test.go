@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func notesDeck() map[string]any {
+	return map[string]any{
+		"presentationId": "p1",
+		"title":          "Q3 Review",
+		"slides": []map[string]any{
+			{"objectId": "s1", "slideProperties": map[string]any{"notesPage": map[string]any{
+				"notesProperties": map[string]any{"speakerNotesObjectId": "n1"},
+				"pageElements": []map[string]any{
+					{"objectId": "n1", "shape": map[string]any{"text": map[string]any{"textElements": []map[string]any{
+						{"textRun": map[string]any{"content": "Welcome everyone\n"}},
+					}}}},
+				},
+			}}},
+			{"objectId": "s2"},
+			{"objectId": "s3", "slideProperties": map[string]any{"notesPage": map[string]any{
+				"pageElements": []map[string]any{
+					{"objectId": "b3", "shape": map[string]any{
+						"placeholder": map[string]any{"type": "BODY"},
+						"text": map[string]any{"textElements": []map[string]any{
+							{"textRun": map[string]any{"content": "Questions?\n"}},
+						}},
+					}},
+				},
+			}}},
+		},
+	}
+}
+
+func TestSlidesNotesCmd_JSON(t *testing.T) {
+	_ = stubSlidesService(t, notesDeck())
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SlidesNotesCmd{}, []string{"p1"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("notes: %v", err)
+		}
+	})
+	var got []slideNotes
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("unmarshal: %v (%s)", err, out)
+	}
+	want := []slideNotes{
+		{SlideNumber: 1, ObjectID: "s1", Notes: "Welcome everyone"},
+		{SlideNumber: 2, ObjectID: "s2", Notes: ""},
+		{SlideNumber: 3, ObjectID: "s3", Notes: "Questions?"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %#v", got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("slide %d: got %#v, want %#v", i+1, got[i], want[i])
+		}
+	}
+}
+
+func TestSlidesNotesCmd_Markdown(t *testing.T) {
+	_ = stubSlidesService(t, notesDeck())
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{})
+
+	path := filepath.Join(t.TempDir(), "script.md")
+	if err := runKong(t, &SlidesNotesCmd{}, []string{"p1", "--output", path}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+		t.Fatalf("notes: %v", err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	want := "# Q3 Review\n\n## Slide 1\n\nWelcome everyone\n\n## Slide 2\n\n## Slide 3\n\nQuestions?\n\n"
+	if string(b) != want {
+		t.Fatalf("markdown:\n%q\nwant:\n%q", b, want)
+	}
+	if err := os.WriteFile(path, []byte("keep"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := runKong(t, &SlidesNotesCmd{}, []string{"p1", "--output", path}, ctx, &RootFlags{Account: "a@b.com"}); err == nil {
+		t.Fatal("expected error for existing file without --overwrite")
+	}
+	if b, _ = os.ReadFile(path); string(b) != "keep" {
+		t.Fatalf("existing file was modified: %q", b)
+	}
+	if err := runKong(t, &SlidesNotesCmd{}, []string{"p1", "--output", path, "--overwrite"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+		t.Fatalf("notes --overwrite: %v", err)
+	}
+	if b, _ = os.ReadFile(path); string(b) != want {
+		t.Fatalf("expected overwritten script, got %q", b)
+	}
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+)
+
+// getPresentation fetches a deck, turning a 404 into a readable error.
+func getPresentation(ctx context.Context, svc *slides.Service, presentationID string) (*slides.Presentation, error) {
+	pres, err := svc.Presentations.Get(presentationID).Context(ctx).Do()
+	if err != nil {
+		if isDocsNotFound(err) {
+			return nil, fmt.Errorf("presentation not found (id=%s)", presentationID)
+		}
+		return nil, err
+	}
+	return pres, nil
+}
+
+// findSlide resolves a slide by object ID or 1-based position.
+func findSlide(pres *slides.Presentation, ref string) (*slides.Page, int, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, 0, usage("empty --slide")
+	}
+	for i, page := range pres.Slides {
+		if page != nil && page.ObjectId == ref {
+			return page, i + 1, nil
+		}
+	}
+	if n, err := strconv.Atoi(ref); err == nil {
+		if n < 1 || n > len(pres.Slides) {
+			return nil, 0, usagef("slide %d out of range (deck has %d slides)", n, len(pres.Slides))
+		}
+		return pres.Slides[n-1], n, nil
+	}
+	return nil, 0, usagef("slide not found: %s", ref)
+}
+
+// placeholderTypes maps the --placeholder names to Slides placeholder types.
+var placeholderTypes = map[string][]string{
+	"title":    {"TITLE", "CENTERED_TITLE"},
+	"subtitle": {"SUBTITLE"},
+	"body":     {"BODY"},
+}
+
+// findPlaceholderShape returns the first shape on the page whose placeholder
+// type is one of types.
+func findPlaceholderShape(page *slides.Page, types ...string) *slides.PageElement {
+	for _, el := range page.PageElements {
+		if el == nil || el.Shape == nil || el.Shape.Placeholder == nil {
+			continue
+		}
+		for _, t := range types {
+			if el.Shape.Placeholder.Type == t {
+				return el
+			}
+		}
+	}
+	return nil
+}
+
+func findPageElement(page *slides.Page, objectID string) *slides.PageElement {
+	for _, el := range page.PageElements {
+		if el != nil && el.ObjectId == objectID {
+			return el
+		}
+	}
+	return nil
+}
+
+// shapeText concatenates the text runs of a shape.
+func shapeText(el *slides.PageElement) string {
+	if el == nil || el.Shape == nil || el.Shape.Text == nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, te := range el.Shape.Text.TextElements {
+		if te != nil && te.TextRun != nil {
+			b.WriteString(te.TextRun.Content)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesSetTextCmd struct {
+	PresentationID string `arg:"" name:"presentationId" help:"Presentation ID"`
+	Slide          string `name:"slide" help:"Slide object ID or 1-based slide number" required:""`
+	Placeholder    string `name:"placeholder" help:"Placeholder to fill: title|subtitle|body"`
+	ObjectID       string `name:"object-id" help:"Explicit shape object ID (instead of --placeholder)"`
+	Text           string `name:"text" help:"Text to set"`
+	File           string `name:"file" help:"Read text from file ('-' for stdin)"`
+}
+
+func (c *SlidesSetTextCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	presentationID := strings.TrimSpace(c.PresentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+	placeholder := strings.ToLower(strings.TrimSpace(c.Placeholder))
+	objectID := strings.TrimSpace(c.ObjectID)
+	if (placeholder == "") == (objectID == "") {
+		return usage("provide exactly one of --placeholder or --object-id")
+	}
+	if _, ok := placeholderTypes[placeholder]; placeholder != "" && !ok {
+		return usagef("invalid --placeholder: %q (expected title|subtitle|body)", c.Placeholder)
+	}
+	text, err := c.resolveText()
+	if err != nil {
+		return err
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := getPresentation(ctx, svc, presentationID)
+	if err != nil {
+		return err
+	}
+	page, _, err := findSlide(pres, c.Slide)
+	if err != nil {
+		return err
+	}
+
+	var target *slides.PageElement
+	if objectID != "" {
+		target = findPageElement(page, objectID)
+		if target == nil {
+			return usagef("could not find shape %s on slide %s", objectID, page.ObjectId)
+		}
+		if target.Shape == nil {
+			return usagef("object %s on slide %s is not a shape", objectID, page.ObjectId)
+		}
+	} else {
+		target = findPlaceholderShape(page, placeholderTypes[placeholder]...)
+		if target == nil {
+			return usagef("could not find %s placeholder on slide %s", placeholder, page.ObjectId)
+		}
+	}
+
+	var reqs []*slides.Request
+	if shapeText(target) != "" {
+		reqs = append(reqs, &slides.Request{DeleteText: &slides.DeleteTextRequest{
+			ObjectId:  target.ObjectId,
+			TextRange: &slides.Range{Type: "ALL"},
+		}})
+	}
+	if text != "" {
+		reqs = append(reqs, &slides.Request{InsertText: &slides.InsertTextRequest{
+			ObjectId:       target.ObjectId,
+			InsertionIndex: 0,
+			Text:           text,
+		}})
+	}
+	if len(reqs) > 0 {
+		if _, err := svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+			Requests: reqs,
+		}).Context(ctx).Do(); err != nil {
+			return err
+		}
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"presentationId": presentationID,
+			"slideId":        page.ObjectId,
+			"objectId":       target.ObjectId,
+		})
+	}
+	u.Out().Printf("id\t%s", presentationID)
+	u.Out().Printf("slide\t%s", page.ObjectId)
+	u.Out().Printf("objectId\t%s", target.ObjectId)
+	return nil
+}
+
+func (c *SlidesSetTextCmd) resolveText() (string, error) {
+	file := strings.TrimSpace(c.File)
+	if file == "" {
+		if c.Text == "" {
+			return "", usage("provide --text or --file")
+		}
+		return c.Text, nil
+	}
+	if c.Text != "" {
+		return "", usage("use only one of --text or --file")
+	}
+	b, err := readInputFile(file)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\n"), nil
+}
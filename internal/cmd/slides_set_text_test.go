@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// stubSlidesService serves presentation GETs from pres and records batch
+// updates into the returned slice.
+func stubSlidesService(t *testing.T, pres map[string]any) *[]slides.BatchUpdatePresentationRequest {
+	t.Helper()
+	var batches []slides.BatchUpdatePresentationRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/p1":
+			_ = json.NewEncoder(w).Encode(pres)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/p1:batchUpdate":
+			var req slides.BatchUpdatePresentationRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			batches = append(batches, req)
+			_ = json.NewEncoder(w).Encode(map[string]any{"presentationId": "p1"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := slides.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("slides.NewService: %v", err)
+	}
+	origNew := newSlidesService
+	t.Cleanup(func() { newSlidesService = origNew })
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return svc, nil }
+	return &batches
+}
+
+func testDeck() map[string]any {
+	return map[string]any{
+		"presentationId": "p1",
+		"slides": []map[string]any{
+			{"objectId": "s1", "pageElements": []map[string]any{
+				{"objectId": "t1", "shape": map[string]any{
+					"placeholder": map[string]any{"type": "CENTERED_TITLE"},
+					"text": map[string]any{"textElements": []map[string]any{
+						{"textRun": map[string]any{"content": "Old title\n"}},
+					}},
+				}},
+			}},
+			{"objectId": "s2", "pageElements": []map[string]any{
+				{"objectId": "b2", "shape": map[string]any{"placeholder": map[string]any{"type": "BODY"}}},
+			}},
+		},
+	}
+}
+
+func TestSlidesSetTextCmd_Placeholder(t *testing.T) {
+	batches := stubSlidesService(t, testDeck())
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		args := []string{"p1", "--slide", "1", "--placeholder", "title", "--text", "New title"}
+		if err := runKong(t, &SlidesSetTextCmd{}, args, ctx, flags); err != nil {
+			t.Fatalf("set-text: %v", err)
+		}
+	})
+	if !strings.Contains(out, `"objectId": "t1"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+	reqs := (*batches)[0].Requests
+	if len(reqs) != 2 || reqs[0].DeleteText == nil || reqs[0].DeleteText.TextRange.Type != "ALL" ||
+		reqs[1].InsertText == nil || reqs[1].InsertText.ObjectId != "t1" || reqs[1].InsertText.Text != "New title" {
+		t.Fatalf("unexpected requests: %#v", reqs)
+	}
+
+	// Empty shapes only get an insert.
+	_ = captureStdout(t, func() {
+		args := []string{"p1", "--slide", "s2", "--object-id", "b2", "--text", "Body"}
+		if err := runKong(t, &SlidesSetTextCmd{}, args, ctx, flags); err != nil {
+			t.Fatalf("set-text object-id: %v", err)
+		}
+	})
+	if reqs := (*batches)[1].Requests; len(reqs) != 1 || reqs[0].InsertText == nil {
+		t.Fatalf("unexpected requests: %#v", reqs)
+	}
+}
+
+func TestSlidesSetTextCmd_MissingPlaceholder(t *testing.T) {
+	batches := stubSlidesService(t, testDeck())
+	flags := &RootFlags{Account: "a@b.com"}
+
+	err := (&SlidesSetTextCmd{PresentationID: "p1", Slide: "2", Placeholder: "subtitle", Text: "x"}).Run(context.Background(), flags)
+	if err == nil || !strings.Contains(err.Error(), "could not find subtitle placeholder on slide s2") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := (&SlidesSetTextCmd{PresentationID: "p1", Slide: "9", Placeholder: "title", Text: "x"}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected out of range error")
+	}
+	if err := (&SlidesSetTextCmd{PresentationID: "p1", Slide: "1", Text: "x"}).Run(context.Background(), flags); err == nil {
+		t.Fatal("expected error without --placeholder/--object-id")
+	}
+	if len(*batches) != 0 {
+		t.Fatalf("expected no batch updates, got %d", len(*batches))
+	}
+}
@@ -0,0 +1,244 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type SlidesCreateFromTemplateCmd struct {
+	TemplateID string             `arg:"" name:"templateId" help:"Presentation ID of the template"`
+	Title      string             `name:"title" help:"Title of the new presentation" required:""`
+	Parent     string             `name:"parent" help:"Destination folder ID"`
+	Values     TemplateValueFlags `embed:""`
+	SetImage   []string           `name:"set-image" help:"Replace images whose alt text (title or description) or object ID is key, as key=path or key=URL; can be repeated"`
+	ChunkSize  string             `name:"chunk-size" help:"Resumable upload chunk size for local --set-image files (e.g. 8MB)" default:"${upload_chunk_size}"`
+}
+
+func (c *SlidesCreateFromTemplateCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	templateID := strings.TrimSpace(c.TemplateID)
+	if templateID == "" {
+		return usage("empty templateId")
+	}
+	title := strings.TrimSpace(c.Title)
+	if title == "" {
+		return usage("empty title")
+	}
+	values, err := c.Values.values()
+	if err != nil {
+		return err
+	}
+	keys := sortedTemplateKeys(values)
+	images, err := parseSlidesTemplateImages(c.SetImage)
+	if err != nil {
+		return err
+	}
+	imageKeys := sortedTemplateKeys(images)
+
+	if stop, dryErr := dryRunExit(ctx, flags, "slides.create-from-template", map[string]any{
+		"templateId": templateID,
+		"title":      title,
+		"parent":     strings.TrimSpace(c.Parent),
+		"keys":       keys,
+		"images":     imageKeys,
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	driveSvc, err := newDriveService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	meta, err := driveSvc.Files.Get(templateID).
+		SupportsAllDrives(true).
+		Fields("id, mimeType").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if meta.MimeType != driveMimeGoogleSlides {
+		return fmt.Errorf("file is not a Google Slides presentation (mimeType=%q)", meta.MimeType)
+	}
+
+	req := &drive.File{Name: title}
+	if parent := strings.TrimSpace(c.Parent); parent != "" {
+		req.Parents = []string{parent}
+	}
+	created, err := driveSvc.Files.Copy(templateID, req).
+		SupportsAllDrives(true).
+		Fields("id, name, webViewLink").
+		Context(ctx).
+		Do()
+	if err != nil {
+		return err
+	}
+	if created == nil {
+		return errors.New("copy failed")
+	}
+
+	replacements := make(map[string]int64, len(keys))
+	imageReplacements := make(map[string]int, len(imageKeys))
+	requests := make([]*slides.Request, 0, len(keys))
+	for _, k := range keys {
+		replacements[k] = 0
+		requests = append(requests, &slides.Request{ReplaceAllText: &slides.ReplaceAllTextRequest{
+			ContainsText: &slides.SubstringMatchCriteria{Text: templatePlaceholder(k), MatchCase: true},
+			ReplaceText:  values[k],
+		}})
+	}
+
+	if len(keys) > 0 || len(imageKeys) > 0 {
+		slidesSvc, svcErr := newSlidesService(ctx, account)
+		if svcErr != nil {
+			return svcErr
+		}
+
+		if len(imageKeys) > 0 {
+			pres, getErr := slidesSvc.Presentations.Get(created.Id).Context(ctx).Do()
+			if getErr != nil {
+				return fmt.Errorf("presentation %s created, but reading it failed: %w", created.Id, getErr)
+			}
+			targets := slidesTemplateImageTargets(pres, imageKeys)
+
+			var uploaded []*uploadedImage
+			defer func() { cleanupUploadedImages(ctx, driveSvc, uploaded) }()
+			for _, k := range imageKeys {
+				imageReplacements[k] = len(targets[k])
+				if len(targets[k]) == 0 {
+					continue
+				}
+				imageURL := images[k]
+				if !isHTTPURL(imageURL) {
+					img, uploadErr := uploadLocalImage(ctx, driveSvc, imageURL, c.ChunkSize)
+					if uploadErr != nil {
+						return fmt.Errorf("presentation %s created, but uploading image for %q failed: %w", created.Id, k, uploadErr)
+					}
+					uploaded = append(uploaded, img)
+					imageURL = img.URL
+				}
+				for _, objectID := range targets[k] {
+					requests = append(requests, &slides.Request{ReplaceImage: &slides.ReplaceImageRequest{
+						ImageObjectId:      objectID,
+						Url:                imageURL,
+						ImageReplaceMethod: "CENTER_INSIDE",
+					}})
+				}
+			}
+		}
+
+		if len(requests) > 0 {
+			resp, batchErr := slidesSvc.Presentations.BatchUpdate(created.Id, &slides.BatchUpdatePresentationRequest{Requests: requests}).
+				Context(ctx).
+				Do()
+			if batchErr != nil {
+				return fmt.Errorf("presentation %s created, but filling placeholders failed: %w", created.Id, batchErr)
+			}
+			for i, k := range keys {
+				if i < len(resp.Replies) && resp.Replies[i] != nil && resp.Replies[i].ReplaceAllText != nil {
+					replacements[k] = resp.Replies[i].ReplaceAllText.OccurrencesChanged
+				}
+			}
+		}
+	}
+
+	link := created.WebViewLink
+	if link == "" {
+		link = "https://docs.google.com/presentation/d/" + created.Id + "/edit"
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"presentationId":    created.Id,
+			"title":             created.Name,
+			"link":              link,
+			"replacements":      replacements,
+			"imageReplacements": imageReplacements,
+		})
+	}
+
+	u.Out().Printf("id\t%s", created.Id)
+	u.Out().Printf("title\t%s", created.Name)
+	u.Out().Printf("link\t%s", link)
+	for _, k := range keys {
+		u.Out().Printf("replaced\t%s\t%d", templatePlaceholder(k), replacements[k])
+		if replacements[k] == 0 {
+			u.Err().Printf("warning: %s not found in template", templatePlaceholder(k))
+		}
+	}
+	for _, k := range imageKeys {
+		u.Out().Printf("image\t%s\t%d", k, imageReplacements[k])
+		if imageReplacements[k] == 0 {
+			u.Err().Printf("warning: no image with alt text or object ID %q in template", k)
+		}
+	}
+	return nil
+}
+
+func parseSlidesTemplateImages(raw []string) (map[string]string, error) {
+	out := make(map[string]string, len(raw))
+	for _, r := range raw {
+		k, v, ok := strings.Cut(r, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" || v == "" {
+			return nil, usagef("invalid --set-image %q (want key=path or key=URL)", r)
+		}
+		out[k] = v
+	}
+	return out, nil
+}
+
+// slidesTemplateImageTargets maps each key to the image object IDs it selects:
+// images whose object ID, alt-text title or description equals the key (or
+// {{key}}). Images inside groups are included.
+func slidesTemplateImageTargets(pres *slides.Presentation, keys []string) map[string][]string {
+	out := make(map[string][]string, len(keys))
+	var visit func(elements []*slides.PageElement)
+	visit = func(elements []*slides.PageElement) {
+		for _, el := range elements {
+			if el == nil {
+				continue
+			}
+			if el.ElementGroup != nil {
+				visit(el.ElementGroup.Children)
+			}
+			if el.Image == nil {
+				continue
+			}
+			for _, k := range keys {
+				for _, candidate := range []string{el.ObjectId, strings.TrimSpace(el.Title), strings.TrimSpace(el.Description)} {
+					if candidate != "" && (candidate == k || candidate == templatePlaceholder(k)) {
+						out[k] = append(out[k], el.ObjectId)
+						break
+					}
+				}
+			}
+		}
+	}
+	for _, page := range pres.Slides {
+		if page != nil {
+			visit(page.PageElements)
+		}
+	}
+	return out
+}
+
+func isHTTPURL(s string) bool {
+	lower := strings.ToLower(strings.TrimSpace(s))
+	return strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "http://")
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/drive/v3"
+	"google.golang.org/api/option"
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestSlidesCreateFromTemplateCmd(t *testing.T) {
+	origSlides, origDrive := newSlidesService, newDriveService
+	t.Cleanup(func() {
+		newSlidesService = origSlides
+		newDriveService = origDrive
+	})
+
+	var (
+		copied  drive.File
+		batch   slides.BatchUpdatePresentationRequest
+		deleted []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/files/tpl"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "tpl", "mimeType": driveMimeGoogleSlides})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/files/tpl/copy"):
+			_ = json.NewDecoder(r.Body).Decode(&copied)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "p2", "name": copied.Name})
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/upload/drive/v3/files"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "tmp-img"})
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/files/tmp-img/permissions"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "perm"})
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/files/tmp-img/permissions/perm"):
+			deleted = append(deleted, "perm")
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && strings.HasSuffix(r.URL.Path, "/files/tmp-img"):
+			deleted = append(deleted, "tmp-img")
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/presentations/p2":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"presentationId": "p2",
+				"slides": []map[string]any{
+					{"objectId": "s1", "pageElements": []map[string]any{
+						{"objectId": "logo1", "title": "logo", "image": map[string]any{}},
+						{"objectId": "g1", "elementGroup": map[string]any{"children": []map[string]any{
+							{"objectId": "logo2", "description": "{{logo}}", "image": map[string]any{}},
+						}}},
+						{"objectId": "hero", "image": map[string]any{}},
+						{"objectId": "shape1", "title": "logo", "shape": map[string]any{}},
+					}},
+				},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/presentations/p2:batchUpdate":
+			_ = json.NewDecoder(r.Body).Decode(&batch)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"presentationId": "p2",
+				"replies": []any{
+					map[string]any{"replaceAllText": map[string]any{"occurrencesChanged": 4}},
+					map[string]any{},
+					map[string]any{},
+					map[string]any{},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	opts := []option.ClientOption{
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL + "/"),
+	}
+	slidesSvc, err := slides.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("slides.NewService: %v", err)
+	}
+	driveSvc, err := drive.NewService(context.Background(), opts...)
+	if err != nil {
+		t.Fatalf("drive.NewService: %v", err)
+	}
+	newSlidesService = func(context.Context, string) (*slides.Service, error) { return slidesSvc, nil }
+	newDriveService = func(context.Context, string) (*drive.Service, error) { return driveSvc, nil }
+
+	logo := filepath.Join(t.TempDir(), "logo.png")
+	if err := os.WriteFile(logo, []byte("\x89PNG\r\n\x1a\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &SlidesCreateFromTemplateCmd{}, []string{
+			"tpl", "--title", "Acme pitch", "--set", "client=Acme",
+			"--set-image", "logo=" + logo, "--set-image", "hero=https://example.com/hero.png", "--set-image", "missing=https://example.com/x.png",
+		}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("from-template: %v", err)
+		}
+	})
+
+	if copied.Name != "Acme pitch" {
+		t.Fatalf("unexpected copy request: %#v", copied)
+	}
+	reqs := batch.Requests
+	if len(reqs) != 4 || reqs[0].ReplaceAllText == nil || reqs[0].ReplaceAllText.ContainsText.Text != "{{client}}" {
+		t.Fatalf("unexpected requests: %#v", reqs)
+	}
+	var replaced []string
+	for _, r := range reqs[1:] {
+		if r.ReplaceImage == nil {
+			t.Fatalf("expected image replacement, got %#v", r)
+		}
+		replaced = append(replaced, r.ReplaceImage.ImageObjectId+"="+r.ReplaceImage.Url)
+	}
+	want := "hero=https://example.com/hero.png," +
+		"logo1=https://drive.google.com/uc?export=download&id=tmp-img," +
+		"logo2=https://drive.google.com/uc?export=download&id=tmp-img"
+	if strings.Join(replaced, ",") != want {
+		t.Fatalf("unexpected image requests: %v", replaced)
+	}
+	if !reflect.DeepEqual(deleted, []string{"perm", "tmp-img"}) {
+		t.Fatalf("expected temporary upload to be unshared and deleted, got %v", deleted)
+	}
+
+	var parsed struct {
+		PresentationID    string           `json:"presentationId"`
+		Replacements      map[string]int64 `json:"replacements"`
+		ImageReplacements map[string]int   `json:"imageReplacements"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.PresentationID != "p2" || parsed.Replacements["client"] != 4 ||
+		parsed.ImageReplacements["logo"] != 2 || parsed.ImageReplacements["hero"] != 1 || parsed.ImageReplacements["missing"] != 0 {
+		t.Fatalf("unexpected output: %#v", parsed)
+	}
+
+	if err := runKong(t, &SlidesCreateFromTemplateCmd{}, []string{"tpl", "--title", "x", "--set-image", "logo"}, ctx, &RootFlags{Account: "a@b.com"}); err == nil {
+		t.Fatalf("expected invalid --set-image error")
+	}
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"google.golang.org/api/slides/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// emuPerPoint converts points to the EMUs the Slides API uses for geometry.
+const emuPerPoint = 12700
+
+type SlidesAddTextBoxCmd struct {
+	PresentationID string  `arg:"" name:"presentationId" help:"Presentation ID"`
+	Slide          string  `name:"slide" help:"Slide object ID or 1-based slide number" required:""`
+	Text           string  `name:"text" help:"Text to put in the box" required:""`
+	X              float64 `name:"x" help:"Left edge in points (default: 10% of the slide width)" default:"-1"`
+	Y              float64 `name:"y" help:"Top edge in points (default: 10% of the slide height)" default:"-1"`
+	Width          float64 `name:"width" help:"Width in points (default: 80% of the slide width)"`
+	Height         float64 `name:"height" help:"Height in points (default: 20% of the slide height)"`
+}
+
+func (c *SlidesAddTextBoxCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	presentationID := strings.TrimSpace(c.PresentationID)
+	if presentationID == "" {
+		return usage("empty presentationId")
+	}
+	if c.Text == "" {
+		return usage("empty --text")
+	}
+	if c.Width < 0 || c.Height < 0 {
+		return usage("--width and --height must be positive")
+	}
+
+	svc, err := newSlidesService(ctx, account)
+	if err != nil {
+		return err
+	}
+	pres, err := getPresentation(ctx, svc, presentationID)
+	if err != nil {
+		return err
+	}
+	page, _, err := findSlide(pres, c.Slide)
+	if err != nil {
+		return err
+	}
+
+	objectID, err := newSlidesObjectID("gog_textbox_")
+	if err != nil {
+		return err
+	}
+	x, y, width, height := c.geometry(pres.PageSize)
+
+	if _, err := svc.Presentations.BatchUpdate(presentationID, &slides.BatchUpdatePresentationRequest{
+		Requests: []*slides.Request{
+			{CreateShape: &slides.CreateShapeRequest{
+				ObjectId:  objectID,
+				ShapeType: "TEXT_BOX",
+				ElementProperties: &slides.PageElementProperties{
+					PageObjectId: page.ObjectId,
+					Size: &slides.Size{
+						Width:  &slides.Dimension{Magnitude: width, Unit: "EMU"},
+						Height: &slides.Dimension{Magnitude: height, Unit: "EMU"},
+					},
+					Transform: &slides.AffineTransform{
+						ScaleX: 1, ScaleY: 1,
+						TranslateX: x, TranslateY: y,
+						Unit: "EMU",
+					},
+				},
+			}},
+			{InsertText: &slides.InsertTextRequest{ObjectId: objectID, Text: c.Text}},
+		},
+	}).Context(ctx).Do(); err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"presentationId": presentationID,
+			"slideId":        page.ObjectId,
+			"objectId":       objectID,
+		})
+	}
+	u.Out().Printf("id\t%s", presentationID)
+	u.Out().Printf("slide\t%s", page.ObjectId)
+	u.Out().Printf("objectId\t%s", objectID)
+	return nil
+}
+
+// geometry returns the box position and size in EMU. Omitted values default
+// to a wide box near the top-left of the slide (a 720x405pt slide when the
+// deck doesn't report its size).
+func (c *SlidesAddTextBoxCmd) geometry(pageSize *slides.Size) (x, y, width, height float64) {
+	pageW, pageH := 720.0*emuPerPoint, 405.0*emuPerPoint
+	if pageSize != nil && pageSize.Width != nil && pageSize.Height != nil {
+		pageW = slidesDimensionEMU(pageSize.Width)
+		pageH = slidesDimensionEMU(pageSize.Height)
+	}
+	x, y = pageW*0.1, pageH*0.1
+	width, height = pageW*0.8, pageH*0.2
+	if c.X >= 0 {
+		x = c.X * emuPerPoint
+	}
+	if c.Y >= 0 {
+		y = c.Y * emuPerPoint
+	}
+	if c.Width > 0 {
+		width = c.Width * emuPerPoint
+	}
+	if c.Height > 0 {
+		height = c.Height * emuPerPoint
+	}
+	return x, y, width, height
+}
+
+func slidesDimensionEMU(d *slides.Dimension) float64 {
+	if d.Unit == "PT" {
+		return d.Magnitude * emuPerPoint
+	}
+	return d.Magnitude
+}
+
+// newSlidesObjectID returns a random object ID; Slides needs it up front to
+// address a new element within the same batch update.
+func newSlidesObjectID(prefix string) (string, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return prefix + hex.EncodeToString(b[:]), nil
+}
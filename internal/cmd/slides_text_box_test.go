@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestSlidesAddTextBoxCmd(t *testing.T) {
+	deck := testDeck()
+	deck["pageSize"] = map[string]any{
+		"width":  map[string]any{"magnitude": 9144000, "unit": "EMU"},
+		"height": map[string]any{"magnitude": 5143500, "unit": "EMU"},
+	}
+	batches := stubSlidesService(t, deck)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		args := []string{"p1", "--slide", "2", "--text", "Hello", "--x", "36", "--y", "0", "--width", "200"}
+		if err := runKong(t, &SlidesAddTextBoxCmd{}, args, ctx, flags); err != nil {
+			t.Fatalf("add-text-box: %v", err)
+		}
+	})
+
+	reqs := (*batches)[0].Requests
+	if len(reqs) != 2 || reqs[0].CreateShape == nil || reqs[1].InsertText == nil {
+		t.Fatalf("unexpected requests: %#v", reqs)
+	}
+	shape := reqs[0].CreateShape
+	props := shape.ElementProperties
+	if shape.ShapeType != "TEXT_BOX" || props.PageObjectId != "s2" || reqs[1].InsertText.ObjectId != shape.ObjectId || reqs[1].InsertText.Text != "Hello" {
+		t.Fatalf("unexpected create/insert: %#v %#v", shape, reqs[1].InsertText)
+	}
+	if props.Transform.TranslateX != 36*emuPerPoint || props.Transform.TranslateY != 0 || props.Size.Width.Magnitude != 200*emuPerPoint {
+		t.Fatalf("unexpected geometry: %#v %#v", props.Transform, props.Size.Width)
+	}
+	// Height falls back to 20% of the slide.
+	if props.Size.Height.Magnitude != 5143500*0.2 {
+		t.Fatalf("unexpected default height: %v", props.Size.Height.Magnitude)
+	}
+	if !strings.Contains(out, `"objectId": "`+shape.ObjectId+`"`) || !strings.HasPrefix(shape.ObjectId, "gog_textbox_") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestSlidesAddTextBoxCmd_Defaults(t *testing.T) {
+	c := &SlidesAddTextBoxCmd{X: -1, Y: -1}
+	x, y, w, h := c.geometry(nil)
+	if x != 72*emuPerPoint || y != 40.5*emuPerPoint || w != 576*emuPerPoint || h != 81*emuPerPoint {
+		t.Fatalf("unexpected defaults: %v %v %v %v", x, y, w, h)
+	}
+}
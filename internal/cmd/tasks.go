@@ -7,13 +7,18 @@ import (
 var newTasksService = googleapi.NewTasks
 
 type TasksCmd struct {
-	Lists  TasksListsCmd  `cmd:"" name:"lists" help:"List task lists"`
-	List   TasksListCmd   `cmd:"" name:"list" help:"List tasks"`
-	Get    TasksGetCmd    `cmd:"" name:"get" help:"Get a task"`
-	Add    TasksAddCmd    `cmd:"" name:"add" help:"Add a task" aliases:"create"`
-	Update TasksUpdateCmd `cmd:"" name:"update" help:"Update a task"`
-	Done   TasksDoneCmd   `cmd:"" name:"done" help:"Mark task completed" aliases:"complete"`
-	Undo   TasksUndoCmd   `cmd:"" name:"undo" help:"Mark task needs action" aliases:"uncomplete,undone"`
-	Delete TasksDeleteCmd `cmd:"" name:"delete" help:"Delete a task" aliases:"rm,del"`
-	Clear  TasksClearCmd  `cmd:"" name:"clear" help:"Clear completed tasks"`
+	Lists        TasksListsCmd            `cmd:"" name:"lists" help:"List task lists"`
+	List         TasksListCmd             `cmd:"" name:"list" help:"List tasks"`
+	Get          TasksGetCmd              `cmd:"" name:"get" help:"Get a task"`
+	Add          TasksAddCmd              `cmd:"" name:"add" help:"Add a task" aliases:"create"`
+	Update       TasksUpdateCmd           `cmd:"" name:"update" help:"Update a task"`
+	Move         TasksMoveCmd             `cmd:"" name:"move" help:"Move a task (reorder, reparent, or change list)" aliases:"mv"`
+	Reorder      TasksReorderCmd          `cmd:"" name:"reorder" help:"Change a task's position among its siblings"`
+	Done         TasksDoneCmd             `cmd:"" name:"done" help:"Mark task completed" aliases:"complete"`
+	DoneMatching TasksCompleteMatchingCmd `cmd:"" name:"done-matching" help:"Mark all open tasks matching a filter completed" aliases:"complete-matching"`
+	Undo         TasksUndoCmd             `cmd:"" name:"undo" help:"Mark task needs action" aliases:"uncomplete,undone"`
+	Delete       TasksDeleteCmd           `cmd:"" name:"delete" help:"Delete a task" aliases:"rm,del"`
+	Clear        TasksClearCmd            `cmd:"" name:"clear" help:"Clear completed tasks"`
+	Export       TasksExportCmd           `cmd:"" name:"export" help:"Export a task list (hierarchy and order) as JSON"`
+	Import       TasksImportCmd           `cmd:"" name:"import" help:"Recreate tasks from an export file in a task list"`
 }
@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type TasksCompleteMatchingCmd struct {
+	TasklistID    string `arg:"" name:"tasklistId" help:"Task list ID or title"`
+	TitleContains string `name:"title-contains" help:"Complete open tasks whose title contains this text (case-insensitive)"`
+	All           bool   `name:"all" help:"Complete every open task in the list"`
+}
+
+func (c *TasksCompleteMatchingCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	needle := strings.TrimSpace(c.TitleContains)
+	if needle == "" && !c.All {
+		return usage("required: --title-contains or --all")
+	}
+	if needle != "" && c.All {
+		return usage("--title-contains and --all are mutually exclusive")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+
+	matched, err := listOpenTasksMatching(ctx, svc, tasklistID, needle)
+	if err != nil {
+		return err
+	}
+
+	preview := make([]map[string]any, 0, len(matched))
+	for _, t := range matched {
+		preview = append(preview, map[string]any{"id": t.Id, "title": t.Title})
+	}
+	if stop, dryErr := dryRunExit(ctx, flags, "tasks.complete-matching", map[string]any{
+		"tasklistId": tasklistID,
+		"tasks":      preview,
+		"count":      len(matched),
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	if len(matched) == 0 {
+		if outfmt.IsJSON(ctx) {
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"completed": []string{}, "count": 0})
+		}
+		u.Err().Println("No matching tasks")
+		return nil
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("complete %d tasks in list %s", len(matched), tasklistID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	completed := make([]string, 0, len(matched))
+	for _, t := range matched {
+		if _, patchErr := svc.Tasks.Patch(tasklistID, t.Id, &tasks.Task{Status: taskStatusCompleted}).Context(ctx).Do(); patchErr != nil {
+			return fmt.Errorf("complete task %s (after %d completed): %w", t.Id, len(completed), patchErr)
+		}
+		completed = append(completed, t.Id)
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"completed": completed,
+			"count":     len(completed),
+		})
+	}
+	u.Out().Printf("completed\t%d", len(completed))
+	for _, t := range matched {
+		u.Out().Printf("%s\t%s", t.Id, t.Title)
+	}
+	return nil
+}
+
+// listOpenTasksMatching returns all needsAction tasks in the list whose title
+// contains needle (case-insensitive). An empty needle matches every open task.
+func listOpenTasksMatching(ctx context.Context, svc *tasks.Service, tasklistID, needle string) ([]*tasks.Task, error) {
+	needle = strings.ToLower(needle)
+	var out []*tasks.Task
+	pageToken := ""
+	for {
+		resp, err := svc.Tasks.List(tasklistID).
+			MaxResults(100).
+			PageToken(pageToken).
+			ShowCompleted(false).
+			Context(ctx).
+			Do()
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range resp.Items {
+			if t == nil || strings.TrimSpace(t.Status) == taskStatusCompleted {
+				continue
+			}
+			if needle != "" && !strings.Contains(strings.ToLower(t.Title), needle) {
+				continue
+			}
+			out = append(out, t)
+		}
+		if resp.NextPageToken == "" {
+			return out, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+)
+
+func TestTasksCompleteMatchingCmd(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var (
+		mu      sync.Mutex
+		patched []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/tasks/v1/users/@me/lists" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{"id": "l1", "title": "Sprint"}}})
+		case strings.HasSuffix(r.URL.Path, "/tasks/v1/lists/l1/tasks") && r.Method == http.MethodGet:
+			if r.URL.Query().Get("pageToken") == "" {
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"items": []map[string]any{
+						{"id": "t1", "title": "Review PR", "status": "needsAction"},
+						{"id": "t2", "title": "Write docs", "status": "needsAction"},
+					},
+					"nextPageToken": "p2",
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{{"id": "t3", "title": "review release notes", "status": "needsAction"}},
+			})
+		case strings.Contains(r.URL.Path, "/tasks/v1/lists/l1/tasks/") && r.Method == http.MethodPatch:
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			mu.Lock()
+			patched = append(patched, id)
+			mu.Unlock()
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": id, "status": "completed"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+
+	dry := captureStdout(t, func() {
+		if err := runKong(t, &TasksCompleteMatchingCmd{}, []string{"Sprint", "--title-contains", "review"}, ctx, &RootFlags{Account: "a@b.com", DryRun: true}); err != nil {
+			t.Fatalf("dry-run: %v", err)
+		}
+	})
+	if len(patched) != 0 {
+		t.Fatalf("dry-run patched tasks: %v", patched)
+	}
+	if !strings.Contains(dry, `"count": 2`) {
+		t.Fatalf("unexpected dry-run output: %s", dry)
+	}
+
+	if err := runKong(t, &TasksCompleteMatchingCmd{}, []string{"l1", "--all"}, ctx, &RootFlags{Account: "a@b.com", NoInput: true}); err == nil {
+		t.Fatalf("expected confirmation error without --force")
+	}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksCompleteMatchingCmd{}, []string{"Sprint", "--title-contains", "review"}, ctx, &RootFlags{Account: "a@b.com", Force: true}); err != nil {
+			t.Fatalf("complete: %v", err)
+		}
+	})
+	var parsed struct {
+		Completed []string `json:"completed"`
+		Count     int      `json:"count"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Count != 2 || strings.Join(parsed.Completed, ",") != "t1,t3" || strings.Join(patched, ",") != "t1,t3" {
+		t.Fatalf("unexpected result: %#v patched=%v", parsed, patched)
+	}
+}
+
+func TestTasksCompleteMatchingCmd_Validation(t *testing.T) {
+	flags := &RootFlags{Account: "a@b.com"}
+	if err := runKong(t, &TasksCompleteMatchingCmd{}, []string{"l1"}, context.Background(), flags); err == nil {
+		t.Fatalf("expected error without filter")
+	}
+	if err := runKong(t, &TasksCompleteMatchingCmd{}, []string{"l1", "--all", "--title-contains", "x"}, context.Background(), flags); err == nil {
+		t.Fatalf("expected error when combining --all and --title-contains")
+	}
+}
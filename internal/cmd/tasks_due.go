@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/steipete/gogcli/internal/ui"
 )
@@ -19,6 +21,46 @@ func warnTasksDueTime(u *ui.UI, due string) {
 	}
 }
 
+// taskNotesTimePrefix marks the line --notes-time appends to task notes.
+const taskNotesTimePrefix = "⏰ "
+
+var taskNotesTimeLine = regexp.MustCompile(`^` + taskNotesTimePrefix + `\d{2}:\d{2}$`)
+
+// taskDueClock returns the HH:MM part of a --due value that has a time of
+// day, as written (in its own offset, or local time when it has none).
+func taskDueClock(value string) (string, bool) {
+	if strings.TrimSpace(value) == "" {
+		return "", false
+	}
+	parsed, hasTime, err := parseTaskDate(value)
+	if err != nil || !hasTime {
+		return "", false
+	}
+	return parsed.Format("15:04"), true
+}
+
+// withTaskNotesTime replaces any "⏰ HH:MM" line in notes with one for clock
+// (or just removes it when clock is empty), so repeated updates never stack
+// markers.
+func withTaskNotesTime(notes, clock string) string {
+	lines := strings.Split(notes, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if taskNotesTimeLine.MatchString(strings.TrimSpace(line)) {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	notes = strings.TrimRight(strings.Join(kept, "\n"), " \t\n")
+	if clock == "" {
+		return notes
+	}
+	if notes == "" {
+		return taskNotesTimePrefix + clock
+	}
+	return notes + "\n" + taskNotesTimePrefix + clock
+}
+
 func normalizeTaskDue(value string) (string, error) {
 	value = strings.TrimSpace(value)
 	if value == "" {
@@ -30,3 +72,19 @@ func normalizeTaskDue(value string) (string, error) {
 	}
 	return formatTaskDue(parsed, hasTime), nil
 }
+
+// taskDueBounds maps --today/--overdue/--this-week onto DueMin/DueMax. Google
+// Tasks stores due dates as midnight UTC, so the local calendar date is
+// projected onto UTC day boundaries instead of converting the instant.
+func taskDueBounds(now time.Time, today, overdue, thisWeek bool) (dueMin, dueMax string) {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch {
+	case today:
+		return day.Format(time.RFC3339), endOfDay(day).Format(time.RFC3339)
+	case overdue:
+		return "", endOfDay(day.AddDate(0, 0, -1)).Format(time.RFC3339)
+	case thisWeek:
+		return startOfWeek(day, time.Monday).Format(time.RFC3339), endOfWeek(day, time.Monday).Format(time.RFC3339)
+	}
+	return "", ""
+}
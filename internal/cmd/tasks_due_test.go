@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTasksListCmd_DueFilter(t *testing.T) {
+	// Late evening local time: the local date must win over the UTC date.
+	loc := time.FixedZone("PDT", -7*3600)
+	now := time.Date(2026, 10, 15, 22, 30, 0, 0, loc) // Thursday
+
+	tests := []struct {
+		name          string
+		cmd           TasksListCmd
+		min, max      string
+		showCompleted bool
+	}{
+		{"today", TasksListCmd{Today: true, ShowCompleted: true}, "2026-10-15T00:00:00Z", "2026-10-15T23:59:59Z", true},
+		{"overdue", TasksListCmd{Overdue: true, ShowCompleted: true}, "", "2026-10-14T23:59:59Z", false},
+		{"this-week", TasksListCmd{ThisWeek: true, ShowCompleted: true}, "2026-10-12T00:00:00Z", "2026-10-18T23:59:59Z", true},
+		{"explicit", TasksListCmd{DueMin: " 2026-01-01T00:00:00Z ", ShowCompleted: true}, "2026-01-01T00:00:00Z", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin, gotMax, showCompleted, err := tt.cmd.dueFilter(now)
+			if err != nil {
+				t.Fatalf("dueFilter: %v", err)
+			}
+			if gotMin != tt.min || gotMax != tt.max || showCompleted != tt.showCompleted {
+				t.Fatalf("got (%q, %q, %v), want (%q, %q, %v)", gotMin, gotMax, showCompleted, tt.min, tt.max, tt.showCompleted)
+			}
+		})
+	}
+}
+
+func TestTasksListCmd_DueFilterConflicts(t *testing.T) {
+	now := time.Now()
+	if _, _, _, err := (&TasksListCmd{Today: true, Overdue: true}).dueFilter(now); err == nil {
+		t.Fatal("expected error for multiple shortcuts")
+	}
+	if _, _, _, err := (&TasksListCmd{ThisWeek: true, DueMax: "2026-01-01T00:00:00Z"}).dueFilter(now); err == nil {
+		t.Fatal("expected error when combined with --due-max")
+	}
+}
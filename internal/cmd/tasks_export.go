@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+// taskListExport is the file format shared by `tasks export` and `tasks import`.
+// Tasks are ordered parents-first, siblings by position.
+type taskListExport struct {
+	Title string           `json:"title,omitempty"`
+	Tasks []taskExportItem `json:"tasks"`
+}
+
+type taskExportItem struct {
+	ID        string  `json:"id,omitempty"`
+	Title     string  `json:"title"`
+	Notes     string  `json:"notes,omitempty"`
+	Due       string  `json:"due,omitempty"`
+	Status    string  `json:"status,omitempty"`
+	Completed *string `json:"completed,omitempty"`
+	Parent    string  `json:"parent,omitempty"`
+	Position  string  `json:"position,omitempty"`
+}
+
+type TasksExportCmd struct {
+	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID or title"`
+}
+
+func (c *TasksExportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+	list, err := svc.Tasklists.Get(tasklistID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	items, err := collectAllPages(ctx, "", func(pageToken string) ([]*tasks.Task, string, error) {
+		resp, listErr := svc.Tasks.List(tasklistID).
+			MaxResults(100).
+			PageToken(pageToken).
+			ShowCompleted(true).
+			ShowHidden(true).
+			Context(ctx).
+			Do()
+		if listErr != nil {
+			return nil, "", listErr
+		}
+		return resp.Items, resp.NextPageToken, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	doc := taskListExport{Title: list.Title, Tasks: []taskExportItem{}}
+	walkTaskTree(buildTaskTree(items), func(n *taskTreeNode, _ *taskTreeNode) {
+		t := n.Task
+		doc.Tasks = append(doc.Tasks, taskExportItem{
+			ID:        t.Id,
+			Title:     t.Title,
+			Notes:     t.Notes,
+			Due:       t.Due,
+			Status:    t.Status,
+			Completed: t.Completed,
+			Parent:    t.Parent,
+			Position:  t.Position,
+		})
+	})
+
+	// The export is JSON regardless of --json so it can be piped into import.
+	return outfmt.WriteJSONContext(ctx, os.Stdout, doc)
+}
+
+type TasksImportCmd struct {
+	TasklistID string `arg:"" name:"tasklistId" help:"Destination task list ID or title"`
+	File       string `arg:"" name:"file" help:"Export file to import ('-' for stdin)"`
+}
+
+type taskImportResult struct {
+	SourceID string `json:"sourceId,omitempty"`
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+}
+
+func (c *TasksImportCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	b, err := readInputFile(c.File)
+	if err != nil {
+		return err
+	}
+	var doc taskListExport
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return fmt.Errorf("parse tasks file: %w", err)
+	}
+	roots := buildTaskTree(doc.toTasks())
+	if len(roots) == 0 {
+		return usage("tasks file contains no tasks")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+
+	if stop, dryErr := dryRunExit(ctx, flags, "tasks.import", map[string]any{
+		"tasklistId": tasklistID,
+		"tasks":      doc.Tasks,
+		"count":      len(doc.Tasks),
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	created := make([]taskImportResult, 0, len(doc.Tasks))
+	progress := u.Progress("import", countTaskTree(roots))
+	defer progress.Done()
+	newIDs := map[string]string{}
+	var insertErr error
+	// Parents are created before their children, and each task is placed after
+	// the previously created sibling so the original order is kept.
+	lastChild := map[*taskTreeNode]string{}
+	walkTaskTree(roots, func(n, parent *taskTreeNode) {
+		if insertErr != nil {
+			return
+		}
+		src := n.Task
+		call := svc.Tasks.Insert(tasklistID, &tasks.Task{
+			Title:     src.Title,
+			Notes:     src.Notes,
+			Due:       src.Due,
+			Status:    src.Status,
+			Completed: src.Completed,
+		}).Context(ctx)
+		if parent != nil {
+			call = call.Parent(newIDs[parent.Task.Id])
+		}
+		if prev := lastChild[parent]; prev != "" {
+			call = call.Previous(prev)
+		}
+		t, err := call.Context(ctx).Do()
+		if err != nil {
+			insertErr = fmt.Errorf("import task %q (after %d created): %w", src.Title, len(created), err)
+			return
+		}
+		if src.Id != "" {
+			newIDs[src.Id] = t.Id
+		}
+		lastChild[parent] = t.Id
+		created = append(created, taskImportResult{SourceID: src.Id, ID: t.Id, Title: t.Title})
+		progress.Add(1)
+	})
+	if insertErr != nil {
+		return insertErr
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"tasklistId": tasklistID,
+			"created":    created,
+			"count":      len(created),
+		})
+	}
+	u.Out().Printf("created\t%d", len(created))
+	for _, r := range created {
+		u.Out().Printf("%s\t%s", r.ID, r.Title)
+	}
+	return nil
+}
+
+func (d taskListExport) toTasks() []*tasks.Task {
+	out := make([]*tasks.Task, 0, len(d.Tasks))
+	for i, it := range d.Tasks {
+		if strings.TrimSpace(it.Title) == "" {
+			continue
+		}
+		position := it.Position
+		if position == "" {
+			// Hand-written files may omit positions; keep file order.
+			position = fmt.Sprintf("%020d", i)
+		}
+		out = append(out, &tasks.Task{
+			Id:        it.ID,
+			Title:     it.Title,
+			Notes:     it.Notes,
+			Due:       it.Due,
+			Status:    it.Status,
+			Completed: it.Completed,
+			Parent:    it.Parent,
+			Position:  position,
+		})
+	}
+	return out
+}
+
+func countTaskTree(nodes []*taskTreeNode) int {
+	n := 0
+	walkTaskTree(nodes, func(*taskTreeNode, *taskTreeNode) { n++ })
+	return n
+}
+
+// walkTaskTree visits nodes depth-first, parents before children.
+func walkTaskTree(nodes []*taskTreeNode, visit func(n, parent *taskTreeNode)) {
+	var walk func(nodes []*taskTreeNode, parent *taskTreeNode)
+	walk = func(nodes []*taskTreeNode, parent *taskTreeNode) {
+		for _, n := range nodes {
+			visit(n, parent)
+			walk(n.Children, n)
+		}
+	}
+	walk(nodes, nil)
+}
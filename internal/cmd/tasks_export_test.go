@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type taskInsert struct {
+	Title, Parent, Previous, Status string
+}
+
+func newTasksExportTestService(t *testing.T, inserts *[]taskInsert) {
+	t.Helper()
+
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/tasks/v1/users/@me/lists" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "l1", "title": "Launch"},
+				{"id": "l2", "title": "Copy"},
+			}})
+		case r.URL.Path == "/tasks/v1/users/@me/lists/l1" && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "l1", "title": "Launch"})
+		case r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodGet:
+			if r.URL.Query().Get("pageToken") == "" {
+				_ = json.NewEncoder(w).Encode(map[string]any{
+					"items": []map[string]any{
+						{"id": "c1", "title": "Child", "parent": "p1", "position": "0001"},
+						{"id": "p2", "title": "Second", "position": "0002", "status": "completed", "completed": "2026-01-02T00:00:00.000Z"},
+					},
+					"nextPageToken": "next",
+				})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{
+				{"id": "p1", "title": "First", "notes": "n", "due": "2026-01-05T00:00:00.000Z", "position": "0001"},
+			}})
+		case r.URL.Path == "/tasks/v1/lists/l2/tasks" && r.Method == http.MethodPost:
+			var task tasks.Task
+			_ = json.NewDecoder(r.Body).Decode(&task)
+			*inserts = append(*inserts, taskInsert{
+				Title:    task.Title,
+				Parent:   r.URL.Query().Get("parent"),
+				Previous: r.URL.Query().Get("previous"),
+				Status:   task.Status,
+			})
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": fmt.Sprintf("new%d", len(*inserts)), "title": task.Title})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+}
+
+func TestTasksExportImport_RoundTrip(t *testing.T) {
+	var inserts []taskInsert
+	newTasksExportTestService(t, &inserts)
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	flags := &RootFlags{Account: "a@b.com"}
+
+	exported := captureStdout(t, func() {
+		if err := runKong(t, &TasksExportCmd{}, []string{"Launch"}, ctx, flags); err != nil {
+			t.Fatalf("export: %v", err)
+		}
+	})
+	var doc taskListExport
+	if err := json.Unmarshal([]byte(exported), &doc); err != nil {
+		t.Fatalf("json: %v\n%s", err, exported)
+	}
+	var order []string
+	for _, it := range doc.Tasks {
+		order = append(order, it.ID)
+	}
+	if doc.Title != "Launch" || strings.Join(order, ",") != "p1,c1,p2" {
+		t.Fatalf("unexpected export: %#v", doc)
+	}
+	if doc.Tasks[0].Notes != "n" || doc.Tasks[2].Completed == nil || doc.Tasks[1].Parent != "p1" {
+		t.Fatalf("export dropped fields: %#v", doc.Tasks)
+	}
+
+	path := filepath.Join(t.TempDir(), "tasks.json")
+	if err := os.WriteFile(path, []byte(exported), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	jsonCtx := outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksImportCmd{}, []string{"Copy", path}, jsonCtx, flags); err != nil {
+			t.Fatalf("import: %v", err)
+		}
+	})
+	want := []taskInsert{
+		{Title: "First"},
+		{Title: "Child", Parent: "new1"},
+		{Title: "Second", Previous: "new1", Status: "completed"},
+	}
+	if len(inserts) != len(want) {
+		t.Fatalf("unexpected inserts: %#v", inserts)
+	}
+	for i := range want {
+		if inserts[i] != want[i] {
+			t.Fatalf("insert %d = %#v, want %#v", i, inserts[i], want[i])
+		}
+	}
+	if !strings.Contains(out, `"count": 3`) || !strings.Contains(out, `"sourceId": "c1"`) {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
+
+func TestTasksImport_DryRun(t *testing.T) {
+	var inserts []taskInsert
+	newTasksExportTestService(t, &inserts)
+
+	path := filepath.Join(t.TempDir(), "tasks.json")
+	if err := os.WriteFile(path, []byte(`{"tasks":[{"title":"A"},{"title":"B"}]}`), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksImportCmd{}, []string{"l2", path}, ctx, &RootFlags{Account: "a@b.com", DryRun: true}); err != nil {
+			t.Fatalf("import: %v", err)
+		}
+	})
+	if len(inserts) != 0 {
+		t.Fatalf("dry-run created tasks: %#v", inserts)
+	}
+	if !strings.Contains(out, `"tasks.import"`) || !strings.Contains(out, `"count": 2`) {
+		t.Fatalf("unexpected dry-run output: %s", out)
+	}
+}
@@ -32,8 +32,17 @@ type TasksListCmd struct {
 	CompletedMin  string `name:"completed-min" help:"Lower bound for completion date filter (RFC3339)"`
 	CompletedMax  string `name:"completed-max" help:"Upper bound for completion date filter (RFC3339)"`
 	UpdatedMin    string `name:"updated-min" help:"Lower bound for updated time filter (RFC3339)"`
+	Today         bool   `name:"today" help:"Only tasks due today (local date)"`
+	Overdue       bool   `name:"overdue" help:"Only open tasks due before today (local date)"`
+	ThisWeek      bool   `name:"this-week" help:"Only tasks due this week (Mon-Sun, local date)"`
+	Tree          bool   `name:"tree" help:"Show subtasks nested under their parent tasks"`
+	FailEmpty     bool   `name:"fail-empty" help:"Exit with code 3 when no tasks are found"`
 }
 
+func (*TasksListCmd) csvTable()              {}
+func (*TasksListCmd) allAccounts()           {}
+func (*TasksListCmd) fieldsResource() string { return "tasks" }
+
 func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -44,6 +53,13 @@ func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if tasklistID == "" {
 		return usage("empty tasklistId")
 	}
+	if c.Tree && outfmt.IsCSV(ctx) {
+		return usage("--tree cannot be combined with --csv")
+	}
+	dueMin, dueMax, showCompleted, err := c.dueFilter(time.Now())
+	if err != nil {
+		return err
+	}
 
 	svc, err := newTasksService(ctx, account)
 	if err != nil {
@@ -53,15 +69,15 @@ func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	call := svc.Tasks.List(tasklistID).
 		MaxResults(c.Max).
 		PageToken(c.Page).
-		ShowCompleted(c.ShowCompleted).
+		ShowCompleted(showCompleted).
 		ShowDeleted(c.ShowDeleted).
 		ShowHidden(c.ShowHidden).
 		ShowAssigned(c.ShowAssigned)
-	if strings.TrimSpace(c.DueMin) != "" {
-		call = call.DueMin(strings.TrimSpace(c.DueMin))
+	if dueMin != "" {
+		call = call.DueMin(dueMin)
 	}
-	if strings.TrimSpace(c.DueMax) != "" {
-		call = call.DueMax(strings.TrimSpace(c.DueMax))
+	if dueMax != "" {
+		call = call.DueMax(dueMax)
 	}
 	if strings.TrimSpace(c.CompletedMin) != "" {
 		call = call.CompletedMin(strings.TrimSpace(c.CompletedMin))
@@ -73,37 +89,77 @@ func (c *TasksListCmd) Run(ctx context.Context, flags *RootFlags) error {
 		call = call.UpdatedMin(strings.TrimSpace(c.UpdatedMin))
 	}
 
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"tasks":         resp.Items,
+		var items any = resp.Items
+		if c.Tree {
+			items = buildTaskTree(resp.Items)
+		}
+		if err := outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"tasks":         items,
 			"nextPageToken": resp.NextPageToken,
-		})
+		}); err != nil {
+			return err
+		}
+		return failEmptyExit(c.FailEmpty && len(resp.Items) == 0)
 	}
 
 	if len(resp.Items) == 0 {
 		u.Err().Println("No tasks")
-		return nil
+		return failEmptyExit(c.FailEmpty)
 	}
 
-	w, flush := tableWriter(ctx)
-	defer flush()
-	fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tDUE\tUPDATED")
+	if c.Tree {
+		w, flush := tableWriter(ctx)
+		defer flush()
+		fmt.Fprintln(w, "TASK\tDUE\tID")
+		writeTaskTree(w, buildTaskTree(resp.Items), 0)
+		printNextPageHint(u, resp.NextPageToken)
+		return nil
+	}
+	rows := make([][]string, 0, len(resp.Items))
 	for _, t := range resp.Items {
 		status := strings.TrimSpace(t.Status)
 		if status == "" {
 			status = taskStatusNeedsAction
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", t.Id, t.Title, status, strings.TrimSpace(t.Due), strings.TrimSpace(t.Updated))
+		rows = append(rows, []string{t.Id, t.Title, status, strings.TrimSpace(t.Due), strings.TrimSpace(t.Updated), strings.TrimSpace(t.Position)})
+	}
+	if err := writeTable(ctx, []string{"ID", "TITLE", "STATUS", "DUE", "UPDATED", "POSITION"}, rows); err != nil {
+		return err
 	}
 	printNextPageHint(u, resp.NextPageToken)
 	return nil
 }
 
+// dueFilter resolves the due-date bounds from either the explicit
+// --due-min/--due-max flags or one of the --today/--overdue/--this-week
+// shortcuts. --overdue also hides completed tasks.
+func (c *TasksListCmd) dueFilter(now time.Time) (dueMin, dueMax string, showCompleted bool, err error) {
+	shortcuts := 0
+	for _, set := range []bool{c.Today, c.Overdue, c.ThisWeek} {
+		if set {
+			shortcuts++
+		}
+	}
+	dueMin, dueMax = strings.TrimSpace(c.DueMin), strings.TrimSpace(c.DueMax)
+	if shortcuts == 0 {
+		return dueMin, dueMax, c.ShowCompleted, nil
+	}
+	if shortcuts > 1 {
+		return "", "", false, usage("use only one of --today, --overdue or --this-week")
+	}
+	if dueMin != "" || dueMax != "" {
+		return "", "", false, usage("--today/--overdue/--this-week cannot be combined with --due-min/--due-max")
+	}
+	dueMin, dueMax = taskDueBounds(now, c.Today, c.Overdue, c.ThisWeek)
+	return dueMin, dueMax, c.ShowCompleted && !c.Overdue, nil
+}
+
 type TasksGetCmd struct {
 	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID"`
 	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
@@ -129,12 +185,12 @@ func (c *TasksGetCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	task, err := svc.Tasks.Get(tasklistID, taskID).Do()
+	task, err := svc.Tasks.Get(tasklistID, taskID).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"task": task})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": task})
 	}
 	u.Out().Printf("id\t%s", task.Id)
 	u.Out().Printf("title\t%s", task.Title)
@@ -158,8 +214,10 @@ type TasksAddCmd struct {
 	Parent      string `name:"parent" help:"Parent task ID (create as subtask)"`
 	Previous    string `name:"previous" help:"Previous sibling task ID (controls ordering)"`
 	Repeat      string `name:"repeat" help:"Repeat task: daily, weekly, monthly, yearly"`
-	RepeatCount int    `name:"repeat-count" help:"Number of occurrences to create (requires --repeat)"`
-	RepeatUntil string `name:"repeat-until" help:"Repeat until date/time (RFC3339 or YYYY-MM-DD; requires --repeat)"`
+	RRule       string `name:"rrule" help:"Repeat by RRULE (e.g. FREQ=WEEKLY;BYDAY=MO,WE); one task is created per occurrence"`
+	RepeatCount int    `name:"repeat-count" help:"Number of occurrences to create (requires --repeat or --rrule)"`
+	RepeatUntil string `name:"repeat-until" help:"Repeat until date/time (RFC3339 or YYYY-MM-DD; requires --repeat or --rrule)"`
+	NotesTime   bool   `name:"notes-time" help:"Keep the time of day from --due by appending it to the notes (e.g. \"⏰ 14:30\")"`
 }
 
 func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -180,25 +238,52 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if err != nil {
 		return err
 	}
-	if repeatUnit == repeatNone && (strings.TrimSpace(c.RepeatUntil) != "" || c.RepeatCount != 0) {
-		return usage("--repeat is required when using --repeat-count or --repeat-until")
+	var rrule *taskRRule
+	if strings.TrimSpace(c.RRule) != "" {
+		if repeatUnit != repeatNone {
+			return usage("--repeat cannot be combined with --rrule")
+		}
+		if rrule, err = parseTaskRRule(c.RRule); err != nil {
+			return usage(err.Error())
+		}
+	}
+	if repeatUnit == repeatNone && rrule == nil && (strings.TrimSpace(c.RepeatUntil) != "" || c.RepeatCount != 0) {
+		return usage("--repeat or --rrule is required when using --repeat-count or --repeat-until")
+	}
+	if c.NotesTime && strings.TrimSpace(c.Due) == "" {
+		return usage("--notes-time requires --due")
 	}
 
-	if repeatUnit == repeatNone {
-		svc, svcErr := newTasksService(ctx, account)
-		if svcErr != nil {
-			return svcErr
-		}
+	notes := strings.TrimSpace(c.Notes)
+	if c.NotesTime {
+		clock, _ := taskDueClock(c.Due)
+		notes = withTaskNotesTime(notes, clock)
+	} else {
 		warnTasksDueTime(u, c.Due)
+	}
+
+	if repeatUnit == repeatNone && rrule == nil {
 		dueValue, dueErr := normalizeTaskDue(c.Due)
 		if dueErr != nil {
 			return dueErr
 		}
 		task := &tasks.Task{
 			Title: strings.TrimSpace(c.Title),
-			Notes: strings.TrimSpace(c.Notes),
+			Notes: notes,
 			Due:   dueValue,
 		}
+		if stop, dryErr := dryRunExit(ctx, flags, "tasks.add", map[string]any{
+			"tasklistId": tasklistID,
+			"task":       task,
+			"parent":     strings.TrimSpace(c.Parent),
+			"previous":   strings.TrimSpace(c.Previous),
+		}); stop || dryErr != nil {
+			return dryErr
+		}
+		svc, svcErr := newTasksService(ctx, account)
+		if svcErr != nil {
+			return svcErr
+		}
 		call := svc.Tasks.Insert(tasklistID, task)
 		if strings.TrimSpace(c.Parent) != "" {
 			call = call.Parent(strings.TrimSpace(c.Parent))
@@ -207,12 +292,12 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 			call = call.Previous(strings.TrimSpace(c.Previous))
 		}
 
-		created, createErr := call.Do()
+		created, createErr := call.Context(ctx).Do()
 		if createErr != nil {
 			return createErr
 		}
 		if outfmt.IsJSON(ctx) {
-			return outfmt.WriteJSON(os.Stdout, map[string]any{"task": created})
+			return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": created})
 		}
 		u.Out().Printf("id\t%s", created.Id)
 		u.Out().Printf("title\t%s", created.Title)
@@ -228,18 +313,28 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return nil
 	}
 
+	repeatFlag := "--repeat"
+	if rrule != nil {
+		repeatFlag = "--rrule"
+	}
 	if strings.TrimSpace(c.Due) == "" {
-		return usage("--due is required when using --repeat")
+		return usagef("--due is required when using %s", repeatFlag)
 	}
 	if c.RepeatCount < 0 {
 		return usage("--repeat-count must be >= 0")
 	}
-	if strings.TrimSpace(c.RepeatUntil) == "" && c.RepeatCount == 0 {
+	count := c.RepeatCount
+	if rrule != nil && rrule.Count > 0 && (count == 0 || rrule.Count < count) {
+		count = rrule.Count
+	}
+	rruleHasUntil := rrule != nil && !rrule.Until.IsZero()
+	if strings.TrimSpace(c.RepeatUntil) == "" && !rruleHasUntil && count == 0 {
+		if rrule != nil {
+			return usage("--rrule requires COUNT or UNTIL in the rule, or --repeat-count/--repeat-until")
+		}
 		return usage("--repeat requires --repeat-count or --repeat-until")
 	}
 
-	warnTasksDueTime(u, c.Due)
-
 	dueTime, dueHasTime, err := parseTaskDate(c.Due)
 	if err != nil {
 		return err
@@ -251,29 +346,36 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if parseErr != nil {
 			return parseErr
 		}
-		switch {
-		case dueHasTime && !untilHasTime:
-			untilValue = time.Date(
-				untilValue.Year(),
-				untilValue.Month(),
-				untilValue.Day(),
-				dueTime.Hour(),
-				dueTime.Minute(),
-				dueTime.Second(),
-				dueTime.Nanosecond(),
-				dueTime.Location(),
-			)
-		case !dueHasTime && untilHasTime:
-			untilValue = time.Date(untilValue.Year(), untilValue.Month(), untilValue.Day(), 0, 0, 0, 0, time.UTC)
-		}
+		untilValue = alignRepeatUntil(untilValue, untilHasTime, dueTime, dueHasTime)
 		until = &untilValue
 	}
+	if rruleHasUntil {
+		ruleUntil := alignRepeatUntil(rrule.Until, rrule.UntilHasTime, dueTime, dueHasTime)
+		if until == nil || ruleUntil.Before(*until) {
+			until = &ruleUntil
+		}
+	}
 
-	schedule := expandRepeatSchedule(dueTime, repeatUnit, c.RepeatCount, until)
+	var schedule []time.Time
+	if rrule != nil {
+		schedule = expandRRuleSchedule(dueTime, rrule, count, until)
+	} else {
+		schedule = expandRepeatSchedule(dueTime, repeatUnit, count, until)
+	}
 	if len(schedule) == 0 {
 		return usage("repeat produced no occurrences")
 	}
 
+	if stop, dryErr := dryRunExit(ctx, flags, "tasks.add", map[string]any{
+		"tasklistId":  tasklistID,
+		"title":       strings.TrimSpace(c.Title),
+		"parent":      strings.TrimSpace(c.Parent),
+		"previous":    strings.TrimSpace(c.Previous),
+		"occurrences": len(schedule),
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
 	svc, svcErr := newTasksService(ctx, account)
 	if svcErr != nil {
 		return svcErr
@@ -291,7 +393,7 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 		}
 		task := &tasks.Task{
 			Title: title,
-			Notes: strings.TrimSpace(c.Notes),
+			Notes: notes,
 			Due:   formatTaskDue(due, dueHasTime),
 		}
 		call := svc.Tasks.Insert(tasklistID, task)
@@ -301,7 +403,7 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 		if previous != "" {
 			call = call.Previous(previous)
 		}
-		created, createErr := call.Do()
+		created, createErr := call.Context(ctx).Do()
 		if createErr != nil {
 			return createErr
 		}
@@ -312,7 +414,7 @@ func (c *TasksAddCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"tasks": createdTasks,
 			"count": len(createdTasks),
 		})
@@ -349,6 +451,7 @@ type TasksUpdateCmd struct {
 	Notes      string `name:"notes" help:"New notes (set empty to clear)"`
 	Due        string `name:"due" help:"New due date (RFC3339 or YYYY-MM-DD; time may be ignored; set empty to clear)"`
 	Status     string `name:"status" help:"New status: needsAction|completed (set empty to clear)"`
+	NotesTime  bool   `name:"notes-time" help:"Keep the time of day from --due in the notes (replaces an earlier \"⏰ HH:MM\" line; a date-only --due removes it)"`
 }
 
 func (c *TasksUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *RootFlags) error {
@@ -377,14 +480,18 @@ func (c *TasksUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *Roo
 		changed = true
 	}
 	if flagProvided(kctx, "due") {
-		warnTasksDueTime(u, c.Due)
+		if !c.NotesTime {
+			warnTasksDueTime(u, c.Due)
+		}
 		dueValue, dueErr := normalizeTaskDue(c.Due)
 		if dueErr != nil {
 			return dueErr
 		}
 		patch.Due = dueValue
 		changed = true
-		warnTasksDueTime(u, c.Due)
+	}
+	if c.NotesTime && !flagProvided(kctx, "due") {
+		return usage("--notes-time requires --due")
 	}
 	if flagProvided(kctx, "status") {
 		patch.Status = strings.TrimSpace(c.Status)
@@ -403,13 +510,27 @@ func (c *TasksUpdateCmd) Run(ctx context.Context, kctx *kong.Context, flags *Roo
 		return err
 	}
 
-	updated, err := svc.Tasks.Patch(tasklistID, taskID, patch).Do()
+	if c.NotesTime {
+		notes := patch.Notes
+		if !flagProvided(kctx, "notes") {
+			current, getErr := svc.Tasks.Get(tasklistID, taskID).Context(ctx).Do()
+			if getErr != nil {
+				return getErr
+			}
+			notes = current.Notes
+		}
+		clock, _ := taskDueClock(c.Due)
+		patch.Notes = withTaskNotesTime(notes, clock)
+		patch.ForceSendFields = append(patch.ForceSendFields, "Notes")
+	}
+
+	updated, err := svc.Tasks.Patch(tasklistID, taskID, patch).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"task": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": updated})
 	}
 	u.Out().Printf("id\t%s", updated.Id)
 	u.Out().Printf("title\t%s", updated.Title)
@@ -450,12 +571,12 @@ func (c *TasksDoneCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	updated, err := svc.Tasks.Patch(tasklistID, taskID, &tasks.Task{Status: taskStatusCompleted}).Do()
+	updated, err := svc.Tasks.Patch(tasklistID, taskID, &tasks.Task{Status: taskStatusCompleted}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"task": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": updated})
 	}
 	u.Out().Printf("id\t%s", updated.Id)
 	u.Out().Printf("status\t%s", strings.TrimSpace(updated.Status))
@@ -487,12 +608,12 @@ func (c *TasksUndoCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	updated, err := svc.Tasks.Patch(tasklistID, taskID, &tasks.Task{Status: "needsAction"}).Do()
+	updated, err := svc.Tasks.Patch(tasklistID, taskID, &tasks.Task{Status: "needsAction"}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"task": updated})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": updated})
 	}
 	u.Out().Printf("id\t%s", updated.Id)
 	u.Out().Printf("status\t%s", strings.TrimSpace(updated.Status))
@@ -528,11 +649,11 @@ func (c *TasksDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	if err := svc.Tasks.Delete(tasklistID, taskID).Do(); err != nil {
+	if err := svc.Tasks.Delete(tasklistID, taskID).Context(ctx).Do(); err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"deleted": true,
 			"id":      taskID,
 		})
@@ -566,11 +687,11 @@ func (c *TasksClearCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	if err := svc.Tasks.Clear(tasklistID).Do(); err != nil {
+	if err := svc.Tasks.Clear(tasklistID).Context(ctx).Do(); err != nil {
 		return err
 	}
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"cleared":    true,
 			"tasklistId": tasklistID,
 		})
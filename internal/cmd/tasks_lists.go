@@ -15,6 +15,8 @@ import (
 type TasksListsCmd struct {
 	List   TasksListsListCmd   `cmd:"" default:"withargs" help:"List task lists"`
 	Create TasksListsCreateCmd `cmd:"" name:"create" help:"Create a task list" aliases:"add,new"`
+	Rename TasksListsRenameCmd `cmd:"" name:"rename" help:"Rename a task list"`
+	Delete TasksListsDeleteCmd `cmd:"" name:"delete" help:"Delete a task list" aliases:"rm,del"`
 }
 
 type TasksListsListCmd struct {
@@ -22,6 +24,8 @@ type TasksListsListCmd struct {
 	Page string `name:"page" help:"Page token"`
 }
 
+func (*TasksListsListCmd) allAccounts() {}
+
 func (c *TasksListsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	u := ui.FromContext(ctx)
 	account, err := requireAccount(flags)
@@ -35,13 +39,13 @@ func (c *TasksListsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 	}
 
 	call := svc.Tasklists.List().MaxResults(c.Max).PageToken(c.Page)
-	resp, err := call.Do()
+	resp, err := call.Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"tasklists":     resp.Items,
 			"nextPageToken": resp.NextPageToken,
 		})
@@ -63,7 +67,8 @@ func (c *TasksListsListCmd) Run(ctx context.Context, flags *RootFlags) error {
 }
 
 type TasksListsCreateCmd struct {
-	Title []string `arg:"" name:"title" help:"Task list title"`
+	Title     []string `arg:"" name:"title" optional:"" help:"Task list title"`
+	TitleFlag string   `name:"title" help:"Task list title (alternative to the positional argument)"`
 }
 
 func (c *TasksListsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
@@ -73,6 +78,12 @@ func (c *TasksListsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 	title := strings.TrimSpace(strings.Join(c.Title, " "))
+	if flagTitle := strings.TrimSpace(c.TitleFlag); flagTitle != "" {
+		if title != "" {
+			return usage("use either a positional title or --title, not both")
+		}
+		title = flagTitle
+	}
 	if title == "" {
 		return usage("empty title")
 	}
@@ -82,15 +93,140 @@ func (c *TasksListsCreateCmd) Run(ctx context.Context, flags *RootFlags) error {
 		return err
 	}
 
-	created, err := svc.Tasklists.Insert(&tasks.TaskList{Title: title}).Do()
+	created, err := svc.Tasklists.Insert(&tasks.TaskList{Title: title}).Context(ctx).Do()
 	if err != nil {
 		return err
 	}
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{"tasklist": created})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"tasklist": created})
 	}
 	u.Out().Printf("id\t%s", created.Id)
 	u.Out().Printf("title\t%s", created.Title)
 	return nil
 }
+
+type TasksListsRenameCmd struct {
+	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID or title"`
+	Title      string `name:"title" help:"New task list title (required)"`
+}
+
+func (c *TasksListsRenameCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	title := strings.TrimSpace(c.Title)
+	if title == "" {
+		return usage("required: --title")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+
+	updated, err := svc.Tasklists.Patch(tasklistID, &tasks.TaskList{Title: title}).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"tasklist": updated})
+	}
+	u.Out().Printf("id\t%s", updated.Id)
+	u.Out().Printf("title\t%s", updated.Title)
+	return nil
+}
+
+type TasksListsDeleteCmd struct {
+	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID or title"`
+}
+
+func (c *TasksListsDeleteCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+
+	if confirmErr := confirmDestructive(ctx, flags, fmt.Sprintf("delete task list %s and all its tasks", tasklistID)); confirmErr != nil {
+		return confirmErr
+	}
+
+	if err := svc.Tasklists.Delete(tasklistID).Context(ctx).Do(); err != nil {
+		return err
+	}
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
+			"deleted": true,
+			"id":      tasklistID,
+		})
+	}
+	u.Out().Printf("deleted\ttrue")
+	u.Out().Printf("id\t%s", tasklistID)
+	return nil
+}
+
+// resolveTasklistID accepts a task list ID or title and returns the list ID.
+// Unknown values are passed through unchanged so raw IDs keep working.
+func resolveTasklistID(ctx context.Context, svc *tasks.Service, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", usage("empty tasklistId")
+	}
+	if value == "@default" {
+		return value, nil
+	}
+
+	var matches []*tasks.TaskList
+	pageToken := ""
+	for {
+		resp, err := svc.Tasklists.List().MaxResults(1000).PageToken(pageToken).Context(ctx).Do()
+		if err != nil {
+			return "", err
+		}
+		for _, tl := range resp.Items {
+			if tl == nil {
+				continue
+			}
+			if tl.Id == value {
+				return tl.Id, nil
+			}
+			if strings.EqualFold(strings.TrimSpace(tl.Title), value) {
+				matches = append(matches, tl)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	switch len(matches) {
+	case 0:
+		return value, nil
+	case 1:
+		return matches[0].Id, nil
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, tl := range matches {
+			ids = append(ids, tl.Id)
+		}
+		return "", usagef("ambiguous task list title %q (matches: %s)", value, strings.Join(ids, ", "))
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type TasksMoveCmd struct {
+	TasklistID  string `arg:"" name:"tasklistId" help:"Task list ID or title"`
+	TaskID      string `arg:"" name:"taskId" help:"Task ID"`
+	Parent      string `name:"parent" help:"New parent task ID (omit to move to top level)"`
+	Previous    string `name:"previous" help:"Previous sibling task ID (omit to move to first position)"`
+	Destination string `name:"destination-tasklist" aliases:"to-list" help:"Destination task list ID or title (moves the task across lists)"`
+}
+
+func (c *TasksMoveCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	taskID := strings.TrimSpace(c.TaskID)
+	parent := strings.TrimSpace(c.Parent)
+	previous := strings.TrimSpace(c.Previous)
+	if strings.TrimSpace(c.TasklistID) == "" {
+		return usage("empty tasklistId")
+	}
+	if taskID == "" {
+		return usage("empty taskId")
+	}
+	if parent != "" && parent == taskID {
+		return usage("--parent cannot be the task itself")
+	}
+	if previous != "" && previous == taskID {
+		return usage("--previous cannot be the task itself")
+	}
+	if previous != "" && previous == parent {
+		return usage("--previous must be a sibling under --parent, not the parent itself")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+	destinationID := ""
+	if strings.TrimSpace(c.Destination) != "" {
+		destinationID, err = resolveTasklistID(ctx, svc, c.Destination)
+		if err != nil {
+			return err
+		}
+		if destinationID == tasklistID {
+			destinationID = ""
+		}
+	}
+
+	if stop, dryErr := dryRunExit(ctx, flags, "tasks.move", map[string]any{
+		"tasklistId":          tasklistID,
+		"taskId":              taskID,
+		"parent":              parent,
+		"previous":            previous,
+		"destinationTasklist": destinationID,
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	call := svc.Tasks.Move(tasklistID, taskID)
+	if parent != "" {
+		call = call.Parent(parent)
+	}
+	if previous != "" {
+		call = call.Previous(previous)
+	}
+	if destinationID != "" {
+		call = call.DestinationTasklist(destinationID)
+	}
+
+	moved, err := call.Context(ctx).Do()
+	if err != nil {
+		if destinationID != "" {
+			return fmt.Errorf("move task %s to list %s (recurring tasks cannot move between lists; --parent/--previous must exist in the destination list): %w", taskID, destinationID, err)
+		}
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": moved})
+	}
+	u.Out().Printf("id\t%s", moved.Id)
+	u.Out().Printf("title\t%s", moved.Title)
+	if destinationID != "" {
+		u.Out().Printf("tasklist\t%s", destinationID)
+	}
+	if strings.TrimSpace(moved.Parent) != "" {
+		u.Out().Printf("parent\t%s", moved.Parent)
+	}
+	if strings.TrimSpace(moved.Position) != "" {
+		u.Out().Printf("position\t%s", moved.Position)
+	}
+	return nil
+}
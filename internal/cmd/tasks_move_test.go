@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func newTasksMoveTestService(t *testing.T, moves *[]string) {
+	t.Helper()
+
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/tasks/v1/users/@me/lists" && r.Method == http.MethodGet:
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{
+					{"id": "l1", "title": "Inbox"},
+					{"id": "l2", "title": "Someday"},
+				},
+			})
+		case strings.HasSuffix(r.URL.Path, "/tasks/v1/lists/l1/tasks/t1/move") && r.Method == http.MethodPost:
+			*moves = append(*moves, r.URL.RawQuery)
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1", "title": "Task", "parent": r.URL.Query().Get("parent"), "position": "00000000000000000001"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+}
+
+func TestTasksMoveCmd_JSON(t *testing.T) {
+	var moves []string
+	newTasksMoveTestService(t, &moves)
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+	ctx = outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksMoveCmd{}, []string{"Inbox", "t1", "--parent", "p1", "--to-list", "someday"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+			t.Fatalf("move: %v", err)
+		}
+	})
+
+	if len(moves) != 1 {
+		t.Fatalf("expected one move call, got %d", len(moves))
+	}
+	if !strings.Contains(moves[0], "parent=p1") || !strings.Contains(moves[0], "destinationTasklist=l2") {
+		t.Fatalf("unexpected move query: %q", moves[0])
+	}
+
+	var parsed struct {
+		Task struct {
+			ID     string `json:"id"`
+			Parent string `json:"parent"`
+		} `json:"task"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Task.ID != "t1" || parsed.Task.Parent != "p1" {
+		t.Fatalf("unexpected task: %#v", parsed.Task)
+	}
+}
+
+func TestTasksMoveCmd_SameListDropsDestination(t *testing.T) {
+	var moves []string
+	newTasksMoveTestService(t, &moves)
+
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	if err := runKong(t, &TasksMoveCmd{}, []string{"l1", "t1", "--previous", "t0", "--destination-tasklist", "Inbox"}, ctx, &RootFlags{Account: "a@b.com"}); err != nil {
+		t.Fatalf("move: %v", err)
+	}
+	if len(moves) != 1 || strings.Contains(moves[0], "destinationTasklist") {
+		t.Fatalf("unexpected move calls: %#v", moves)
+	}
+}
+
+func TestTasksMoveCmd_DryRun(t *testing.T) {
+	var moves []string
+	newTasksMoveTestService(t, &moves)
+
+	ctx := outfmt.WithMode(context.Background(), outfmt.Mode{JSON: true})
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksMoveCmd{}, []string{"l1", "t1", "--to-list", "l2"}, ctx, &RootFlags{Account: "a@b.com", DryRun: true}); err != nil {
+			t.Fatalf("move: %v", err)
+		}
+	})
+	if len(moves) != 0 {
+		t.Fatalf("dry-run should not call move, got %#v", moves)
+	}
+	var parsed struct {
+		DryRun    bool           `json:"dryRun"`
+		Operation string         `json:"operation"`
+		Params    map[string]any `json:"params"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if !parsed.DryRun || parsed.Operation != "tasks.move" || parsed.Params["destinationTasklist"] != "l2" {
+		t.Fatalf("unexpected dry-run payload: %#v", parsed)
+	}
+}
+
+func TestTasksMoveCmd_Validation(t *testing.T) {
+	flags := &RootFlags{Account: "a@b.com"}
+	cases := [][]string{
+		{"l1", "t1", "--parent", "t1"},
+		{"l1", "t1", "--previous", "t1"},
+		{"l1", "t1", "--parent", "p1", "--previous", "p1"},
+	}
+	for _, args := range cases {
+		if err := runKong(t, &TasksMoveCmd{}, args, context.Background(), flags); err == nil {
+			t.Fatalf("expected error for %v", args)
+		}
+	}
+}
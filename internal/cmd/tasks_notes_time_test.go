@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestWithTaskNotesTime(t *testing.T) {
+	tests := []struct {
+		notes, clock, want string
+	}{
+		{"", "14:30", "⏰ 14:30"},
+		{"Bring slides", "14:30", "Bring slides\n⏰ 14:30"},
+		{"Bring slides\n⏰ 09:00", "14:30", "Bring slides\n⏰ 14:30"},
+		{"Bring slides\n⏰ 09:00\n", "", "Bring slides"},
+		{"⏰ alarm emoji stays", "08:00", "⏰ alarm emoji stays\n⏰ 08:00"},
+	}
+	for _, tt := range tests {
+		if got := withTaskNotesTime(tt.notes, tt.clock); got != tt.want {
+			t.Fatalf("withTaskNotesTime(%q, %q) = %q, want %q", tt.notes, tt.clock, got, tt.want)
+		}
+	}
+	// Applying the same time twice is stable.
+	once := withTaskNotesTime("x", "10:15")
+	if twice := withTaskNotesTime(once, "10:15"); twice != once {
+		t.Fatalf("not idempotent: %q vs %q", once, twice)
+	}
+}
+
+func TestTaskDueClock(t *testing.T) {
+	if got, ok := taskDueClock("2026-03-01T14:30:00+01:00"); !ok || got != "14:30" {
+		t.Fatalf("RFC3339: got %q %v", got, ok)
+	}
+	if got, ok := taskDueClock("2026-03-01 09:05"); !ok || got != "09:05" {
+		t.Fatalf("local: got %q %v", got, ok)
+	}
+	if _, ok := taskDueClock("2026-03-01"); ok {
+		t.Fatal("date-only due should have no clock")
+	}
+}
+
+func TestTasksNotesTime_AddAndUpdate(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var inserted, patched map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/lists/l1/tasks"):
+			_ = json.NewDecoder(r.Body).Decode(&inserted)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1", "title": "Call"})
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/lists/l1/tasks/t1"):
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1", "title": "Call", "notes": "Agenda\n⏰ 14:30"})
+		case r.Method == http.MethodPatch && strings.HasSuffix(r.URL.Path, "/lists/l1/tasks/t1"):
+			_ = json.NewDecoder(r.Body).Decode(&patched)
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1", "title": "Call"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &TasksAddCmd{}, []string{"l1", "--title", "Call", "--notes", "Agenda", "--due", "2026-03-01T14:30:00Z", "--notes-time"}, ctx, flags); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+	})
+	if inserted["notes"] != "Agenda\n⏰ 14:30" {
+		t.Fatalf("unexpected inserted notes: %#v", inserted["notes"])
+	}
+
+	// Moving the time replaces the marker in the existing notes.
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &TasksUpdateCmd{}, []string{"l1", "t1", "--due", "2026-03-02T16:00:00Z", "--notes-time"}, ctx, flags); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+	})
+	if patched["notes"] != "Agenda\n⏰ 16:00" {
+		t.Fatalf("unexpected patched notes: %#v", patched["notes"])
+	}
+
+	// A date-only due drops the marker.
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &TasksUpdateCmd{}, []string{"l1", "t1", "--due", "2026-03-03", "--notes-time"}, ctx, flags); err != nil {
+			t.Fatalf("update: %v", err)
+		}
+	})
+	if patched["notes"] != "Agenda" {
+		t.Fatalf("unexpected patched notes: %#v", patched["notes"])
+	}
+
+	if err := runKong(t, &TasksUpdateCmd{}, []string{"l1", "t1", "--title", "x", "--notes-time"}, ctx, flags); err == nil {
+		t.Fatal("expected usage error without --due")
+	}
+}
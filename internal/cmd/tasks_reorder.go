@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+type TasksReorderCmd struct {
+	TasklistID string `arg:"" name:"tasklistId" help:"Task list ID or title"`
+	TaskID     string `arg:"" name:"taskId" help:"Task ID"`
+	After      string `name:"after" help:"Place the task directly after this sibling task ID"`
+	First      bool   `name:"first" help:"Place the task first among its siblings"`
+}
+
+func (c *TasksReorderCmd) Run(ctx context.Context, flags *RootFlags) error {
+	u := ui.FromContext(ctx)
+	account, err := requireAccount(flags)
+	if err != nil {
+		return err
+	}
+	taskID := strings.TrimSpace(c.TaskID)
+	after := strings.TrimSpace(c.After)
+	if strings.TrimSpace(c.TasklistID) == "" {
+		return usage("empty tasklistId")
+	}
+	if taskID == "" {
+		return usage("empty taskId")
+	}
+	if (after == "") == !c.First {
+		return usage("provide exactly one of --after or --first")
+	}
+	if after == taskID {
+		return usage("--after cannot be the task itself")
+	}
+
+	svc, err := newTasksService(ctx, account)
+	if err != nil {
+		return err
+	}
+	tasklistID, err := resolveTasklistID(ctx, svc, c.TasklistID)
+	if err != nil {
+		return err
+	}
+
+	task, err := svc.Tasks.Get(tasklistID, taskID).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	if after != "" {
+		prev, getErr := svc.Tasks.Get(tasklistID, after).Context(ctx).Do()
+		if getErr != nil {
+			return getErr
+		}
+		if prev.Parent != task.Parent {
+			return usagef("task %s is not a sibling of %s (use tasks move --parent to reparent)", after, taskID)
+		}
+	}
+
+	if stop, dryErr := dryRunExit(ctx, flags, "tasks.reorder", map[string]any{
+		"tasklistId": tasklistID,
+		"taskId":     taskID,
+		"parent":     task.Parent,
+		"previous":   after,
+	}); stop || dryErr != nil {
+		return dryErr
+	}
+
+	// Move without a parent sends the task to the top level, so keep its own.
+	call := svc.Tasks.Move(tasklistID, taskID)
+	if task.Parent != "" {
+		call = call.Parent(task.Parent)
+	}
+	if after != "" {
+		call = call.Previous(after)
+	}
+	moved, err := call.Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+
+	if outfmt.IsJSON(ctx) {
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{"task": moved})
+	}
+	u.Out().Printf("id\t%s", moved.Id)
+	u.Out().Printf("title\t%s", moved.Title)
+	if strings.TrimSpace(moved.Parent) != "" {
+		u.Out().Printf("parent\t%s", moved.Parent)
+	}
+	u.Out().Printf("position\t%s", moved.Position)
+	return nil
+}
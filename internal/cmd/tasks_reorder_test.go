@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestTasksReorderCmd(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	stored := map[string]map[string]any{
+		"sub1": {"id": "sub1", "title": "Sub 1", "parent": "p1"},
+		"sub2": {"id": "sub2", "title": "Sub 2", "parent": "p1"},
+		"top":  {"id": "top", "title": "Top"},
+	}
+	var moves []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		rest := strings.TrimPrefix(r.URL.Path, "/tasks/v1/lists/l1/tasks/")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tasks/v1/users/@me/lists":
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{{"id": "l1", "title": "Inbox"}}})
+		case r.Method == http.MethodPost && strings.HasSuffix(rest, "/move"):
+			moves = append(moves, r.URL.RawQuery)
+			task := stored[strings.TrimSuffix(rest, "/move")]
+			out := map[string]any{"position": "00000000000000000002"}
+			for k, v := range task {
+				out[k] = v
+			}
+			_ = json.NewEncoder(w).Encode(out)
+		case r.Method == http.MethodGet && stored[rest] != nil:
+			_ = json.NewEncoder(w).Encode(stored[rest])
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksReorderCmd{}, []string{"l1", "sub1", "--after", "sub2"}, ctx, flags); err != nil {
+			t.Fatalf("reorder: %v", err)
+		}
+	})
+	// The subtask stays under its parent instead of jumping to the top level.
+	if len(moves) != 1 || moves[0] != "alt=json&parent=p1&prettyPrint=false&previous=sub2" {
+		t.Fatalf("unexpected move: %v", moves)
+	}
+	var parsed struct {
+		Task struct {
+			ID       string `json:"id"`
+			Position string `json:"position"`
+		} `json:"task"`
+	}
+	if err := json.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, out)
+	}
+	if parsed.Task.ID != "sub1" || parsed.Task.Position != "00000000000000000002" {
+		t.Fatalf("unexpected output: %+v", parsed)
+	}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &TasksReorderCmd{}, []string{"l1", "top", "--first"}, ctx, flags); err != nil {
+			t.Fatalf("reorder --first: %v", err)
+		}
+	})
+	if len(moves) != 2 || strings.Contains(moves[1], "parent=") || strings.Contains(moves[1], "previous=") {
+		t.Fatalf("unexpected --first move: %v", moves)
+	}
+
+	if err := runKong(t, &TasksReorderCmd{}, []string{"l1", "sub1", "--after", "top"}, ctx, flags); err == nil || !strings.Contains(err.Error(), "not a sibling") {
+		t.Fatalf("expected sibling error, got %v", err)
+	}
+	for _, args := range [][]string{{"l1", "sub1"}, {"l1", "sub1", "--after", "sub2", "--first"}, {"l1", "sub1", "--after", "sub1"}} {
+		if err := runKong(t, &TasksReorderCmd{}, args, ctx, flags); err == nil {
+			t.Fatalf("expected usage error for %v", args)
+		}
+	}
+	if len(moves) != 2 {
+		t.Fatalf("invalid invocations should not move, got %v", moves)
+	}
+}
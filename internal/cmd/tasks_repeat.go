@@ -58,6 +58,20 @@ func parseTaskDate(value string) (time.Time, bool, error) {
 	return time.Time{}, false, fmt.Errorf("invalid date/time %q (expected RFC3339 or YYYY-MM-DD)", value)
 }
 
+// alignRepeatUntil makes a --repeat-until bound comparable with the due
+// date: a date-only bound takes the due time of day, and a timed bound on a
+// date-only due is truncated to midnight UTC.
+func alignRepeatUntil(until time.Time, untilHasTime bool, due time.Time, dueHasTime bool) time.Time {
+	switch {
+	case dueHasTime && !untilHasTime:
+		return time.Date(until.Year(), until.Month(), until.Day(), due.Hour(), due.Minute(), due.Second(), due.Nanosecond(), due.Location())
+	case !dueHasTime && untilHasTime:
+		return time.Date(until.Year(), until.Month(), until.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return until
+	}
+}
+
 func expandRepeatSchedule(start time.Time, unit repeatUnit, count int, until *time.Time) []time.Time {
 	if unit == repeatNone {
 		return []time.Time{start}
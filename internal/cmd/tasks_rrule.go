@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRRuleScanPeriods bounds expansion for rules that rarely or never match
+// (e.g. FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30).
+const maxRRuleScanPeriods = 5000
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// taskRRule is the subset of RFC 5545 RRULE that tasks add can materialize:
+// FREQ, INTERVAL, BYDAY (without ordinals), BYMONTHDAY, BYMONTH, COUNT and
+// UNTIL.
+type taskRRule struct {
+	Freq         repeatUnit
+	Interval     int
+	ByDay        []time.Weekday
+	ByMonthDay   []int
+	ByMonth      []time.Month
+	Count        int
+	Until        time.Time
+	UntilHasTime bool
+}
+
+func parseTaskRRule(raw string) (*taskRRule, error) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) >= 6 && strings.EqualFold(raw[:6], "RRULE:") {
+		raw = raw[6:]
+	}
+	if raw == "" {
+		return nil, fmt.Errorf("empty rrule")
+	}
+
+	rule := &taskRRule{Interval: 1}
+	for _, part := range strings.Split(raw, ";") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rrule part %q (want KEY=VALUE)", part)
+		}
+		switch key {
+		case "FREQ":
+			unit, err := parseRepeatUnit(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid rrule FREQ %q (must be DAILY, WEEKLY, MONTHLY, or YEARLY)", value)
+			}
+			rule.Freq = unit
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid rrule INTERVAL %q", value)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid rrule COUNT %q", value)
+			}
+			rule.Count = n
+		case "UNTIL":
+			t, hasTime, err := parseRRuleUntil(value)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until, rule.UntilHasTime = t, hasTime
+		case "BYDAY":
+			for _, d := range strings.Split(value, ",") {
+				wd, ok := rruleWeekdays[strings.TrimSpace(d)]
+				if !ok {
+					return nil, fmt.Errorf("invalid rrule BYDAY %q (use MO..SU; ordinals like 1MO are not supported)", d)
+				}
+				rule.ByDay = append(rule.ByDay, wd)
+			}
+		case "BYMONTHDAY":
+			for _, d := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(d))
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid rrule BYMONTHDAY %q", d)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, m := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(m))
+				if err != nil || n < 1 || n > 12 {
+					return nil, fmt.Errorf("invalid rrule BYMONTH %q", m)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(n))
+			}
+		case "WKST":
+			// Weeks always start on Monday here; accepted for compatibility.
+		default:
+			return nil, fmt.Errorf("unsupported rrule part %q", key)
+		}
+	}
+	if rule.Freq == repeatNone {
+		return nil, fmt.Errorf("rrule requires FREQ")
+	}
+	if rule.Count > 0 && !rule.Until.IsZero() {
+		return nil, fmt.Errorf("rrule cannot set both COUNT and UNTIL")
+	}
+	return rule, nil
+}
+
+func parseRRuleUntil(value string) (time.Time, bool, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, time.Local); err == nil {
+		return t, true, nil
+	}
+	if t, err := time.Parse("20060102", value); err == nil {
+		return t, false, nil
+	}
+	return time.Time{}, false, fmt.Errorf("invalid rrule UNTIL %q (want YYYYMMDD or YYYYMMDDTHHMMSSZ)", value)
+}
+
+// expandRRuleSchedule mirrors expandRepeatSchedule for an RRULE: occurrences
+// start at start (inclusive) and stop at count or until, whichever comes
+// first. Every occurrence keeps start's time of day.
+func expandRRuleSchedule(start time.Time, rule *taskRRule, count int, until *time.Time) []time.Time {
+	if count < 0 {
+		count = 0
+	}
+	// Defensive guard: if neither count nor until is set, return single occurrence
+	// to prevent generating an unbounded schedule (caller should validate).
+	if count == 0 && until == nil {
+		return []time.Time{start}
+	}
+	out := []time.Time{}
+	for i := 0; i < maxRRuleScanPeriods; i++ {
+		for _, t := range rule.periodCandidates(start, i) {
+			if t.Before(start) {
+				continue
+			}
+			if until != nil && t.After(*until) {
+				return out
+			}
+			out = append(out, t)
+			if count > 0 && len(out) >= count {
+				return out
+			}
+		}
+	}
+	return out
+}
+
+// periodCandidates lists the sorted occurrences in the i-th FREQ*INTERVAL
+// period after start.
+func (r *taskRRule) periodCandidates(start time.Time, i int) []time.Time {
+	n := i * r.Interval
+	var out []time.Time
+	switch r.Freq {
+	case repeatDaily:
+		day := start.AddDate(0, 0, n)
+		if r.matchesWeekday(day) && r.matchesMonth(day) && r.matchesMonthDay(day) {
+			out = append(out, day)
+		}
+	case repeatWeekly:
+		offset := (int(start.Weekday()) + 6) % 7 // days since Monday
+		monday := start.AddDate(0, 0, 7*n-offset)
+		for d := 0; d < 7; d++ {
+			day := monday.AddDate(0, 0, d)
+			if len(r.ByDay) == 0 && day.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchesWeekday(day) && r.matchesMonth(day) {
+				out = append(out, day)
+			}
+		}
+	case repeatMonthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(n), 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+		if r.matchesMonth(first) {
+			out = r.monthCandidates(start, first)
+		}
+	case repeatYearly:
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByDay) > 0 && len(r.ByMonthDay) == 0 {
+				months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			} else {
+				months = []time.Month{start.Month()}
+			}
+		}
+		for _, m := range months {
+			first := time.Date(start.Year()+n, m, 1, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+			out = append(out, r.monthCandidates(start, first)...)
+		}
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].Before(out[b]) })
+	return out
+}
+
+// monthCandidates expands BYMONTHDAY/BYDAY within the month starting at
+// first. Without either, start's day of month is used; months too short for
+// it are skipped, as RFC 5545 requires.
+func (r *taskRRule) monthCandidates(start, first time.Time) []time.Time {
+	days := daysIn(first)
+	var out []time.Time
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, d := range r.ByMonthDay {
+			if d < 0 {
+				d = days + 1 + d
+			}
+			if d < 1 || d > days {
+				continue
+			}
+			day := first.AddDate(0, 0, d-1)
+			if r.matchesWeekday(day) {
+				out = append(out, day)
+			}
+		}
+	case len(r.ByDay) > 0:
+		for d := 0; d < days; d++ {
+			day := first.AddDate(0, 0, d)
+			if r.matchesWeekday(day) {
+				out = append(out, day)
+			}
+		}
+	default:
+		if start.Day() <= days {
+			out = append(out, first.AddDate(0, 0, start.Day()-1))
+		}
+	}
+	return out
+}
+
+func (r *taskRRule) matchesWeekday(t time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, wd := range r.ByDay {
+		if t.Weekday() == wd {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *taskRRule) matchesMonth(t time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if t.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *taskRRule) matchesMonthDay(t time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+	days := daysIn(t)
+	for _, d := range r.ByMonthDay {
+		if d < 0 {
+			d = days + 1 + d
+		}
+		if t.Day() == d {
+			return true
+		}
+	}
+	return false
+}
+
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestParseTaskRRule(t *testing.T) {
+	rule, err := parseTaskRRule("RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=4")
+	if err != nil {
+		t.Fatalf("parseTaskRRule: %v", err)
+	}
+	if rule.Freq != repeatWeekly || rule.Interval != 2 || rule.Count != 4 {
+		t.Fatalf("unexpected rule: %#v", rule)
+	}
+	if len(rule.ByDay) != 2 || rule.ByDay[0] != time.Monday || rule.ByDay[1] != time.Wednesday {
+		t.Fatalf("unexpected BYDAY: %#v", rule.ByDay)
+	}
+
+	rule, err = parseTaskRRule("FREQ=DAILY;UNTIL=20250105")
+	if err != nil {
+		t.Fatalf("parseTaskRRule until: %v", err)
+	}
+	if rule.UntilHasTime || rule.Until.Format("2006-01-02") != "2025-01-05" {
+		t.Fatalf("unexpected UNTIL: %v (hasTime=%v)", rule.Until, rule.UntilHasTime)
+	}
+
+	for _, bad := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;COUNT=0",
+	} {
+		if _, err := parseTaskRRule(bad); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestExpandRRuleSchedule(t *testing.T) {
+	start := time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC) // Monday
+
+	rule, err := parseTaskRRule("FREQ=WEEKLY;BYDAY=MO,WE")
+	if err != nil {
+		t.Fatalf("parseTaskRRule: %v", err)
+	}
+	got := formatSchedule(expandRRuleSchedule(start, rule, 4, nil))
+	want := "2025-01-06,2025-01-08,2025-01-13,2025-01-15"
+	if got != want {
+		t.Fatalf("weekly schedule = %s, want %s", got, want)
+	}
+
+	rule, err = parseTaskRRule("FREQ=MONTHLY;BYMONTHDAY=-1")
+	if err != nil {
+		t.Fatalf("parseTaskRRule: %v", err)
+	}
+	until := time.Date(2025, 4, 30, 0, 0, 0, 0, time.UTC)
+	got = formatSchedule(expandRRuleSchedule(start, rule, 0, &until))
+	want = "2025-01-31,2025-02-28,2025-03-31,2025-04-30"
+	if got != want {
+		t.Fatalf("month-end schedule = %s, want %s", got, want)
+	}
+}
+
+func formatSchedule(schedule []time.Time) string {
+	parts := make([]string, 0, len(schedule))
+	for _, t := range schedule {
+		parts = append(parts, t.Format("2006-01-02"))
+	}
+	return strings.Join(parts, ",")
+}
+
+func TestTasksAddCmd_RRule(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	var gotDue []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !(r.URL.Path == "/tasks/v1/lists/l1/tasks" && r.Method == http.MethodPost) {
+			http.NotFound(w, r)
+			return
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if due, ok := body["due"].(string); ok {
+			gotDue = append(gotDue, due)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": "t1", "due": body["due"]})
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	u, err := ui.New(ui.Options{Stdout: os.Stdout, Stderr: os.Stderr, Color: "never"})
+	if err != nil {
+		t.Fatalf("ui.New: %v", err)
+	}
+	ctx := outfmt.WithMode(ui.WithUI(context.Background(), u), outfmt.Mode{JSON: true})
+	flags := &RootFlags{Account: "a@b.com"}
+
+	_ = captureStdout(t, func() {
+		if err := runKong(t, &TasksAddCmd{}, []string{
+			"l1",
+			"--title", "Task",
+			"--due", "2025-01-06",
+			"--rrule", "FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10",
+			"--repeat-count", "3",
+		}, ctx, flags); err != nil {
+			t.Fatalf("runKong: %v", err)
+		}
+	})
+	want := []string{"2025-01-06T00:00:00Z", "2025-01-08T00:00:00Z", "2025-01-13T00:00:00Z"}
+	if strings.Join(gotDue, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected due schedule: %#v", gotDue)
+	}
+
+	for _, args := range [][]string{
+		{"l1", "--title", "Task", "--due", "2025-01-06", "--rrule", "FREQ=WEEKLY"},
+		{"l1", "--title", "Task", "--due", "2025-01-06", "--rrule", "FREQ=WEEKLY;COUNT=2", "--repeat", "daily"},
+		{"l1", "--title", "Task", "--rrule", "FREQ=WEEKLY;COUNT=2"},
+		{"l1", "--title", "Task", "--due", "2025-01-06", "--rrule", "FREQ=SECONDLY;COUNT=2"},
+	} {
+		if err := runKong(t, &TasksAddCmd{}, args, ctx, flags); err == nil || ExitCode(err) != 2 {
+			t.Fatalf("expected usage error for %v, got %v", args, err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/tasks/v1"
+)
+
+type taskTreeNode struct {
+	Task     *tasks.Task
+	Children []*taskTreeNode
+}
+
+// MarshalJSON renders the task fields with nested children under "children".
+func (n *taskTreeNode) MarshalJSON() ([]byte, error) {
+	raw, err := json.Marshal(n.Task)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	children := n.Children
+	if children == nil {
+		children = []*taskTreeNode{}
+	}
+	fields["children"] = children
+	return json.Marshal(fields)
+}
+
+// buildTaskTree groups tasks by their Parent field. Tasks whose parent is not
+// part of the result set (e.g. on another page) are treated as roots, and a
+// parent cycle is broken at its first task so every task is emitted once.
+func buildTaskTree(items []*tasks.Task) []*taskTreeNode {
+	nodes := make(map[string]*taskTreeNode, len(items))
+	ordered := make([]*taskTreeNode, 0, len(items))
+	for _, t := range items {
+		if t == nil {
+			continue
+		}
+		node := &taskTreeNode{Task: t}
+		nodes[t.Id] = node
+		ordered = append(ordered, node)
+	}
+
+	roots := make([]*taskTreeNode, 0, len(ordered))
+	for _, node := range ordered {
+		parentID := strings.TrimSpace(node.Task.Parent)
+		parent, ok := nodes[parentID]
+		if parentID == "" || !ok || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	// Tasks in a parent cycle (A -> B -> A) are unreachable from the roots.
+	reached := make(map[*taskTreeNode]bool, len(ordered))
+	var mark func(*taskTreeNode)
+	mark = func(n *taskTreeNode) {
+		if reached[n] {
+			return
+		}
+		reached[n] = true
+		for _, c := range n.Children {
+			mark(c)
+		}
+	}
+	for _, r := range roots {
+		mark(r)
+	}
+	for _, node := range ordered {
+		if reached[node] {
+			continue
+		}
+		// Walk up until a task repeats; that task is on the cycle.
+		onCycle := node
+		seen := map[*taskTreeNode]bool{}
+		for !seen[onCycle] {
+			seen[onCycle] = true
+			onCycle = nodes[strings.TrimSpace(onCycle.Task.Parent)]
+		}
+		parent := nodes[strings.TrimSpace(onCycle.Task.Parent)]
+		parent.Children = slices.DeleteFunc(parent.Children, func(c *taskTreeNode) bool { return c == onCycle })
+		roots = append(roots, onCycle)
+		mark(onCycle)
+	}
+
+	sortTaskTreeNodes(roots)
+	return roots
+}
+
+func sortTaskTreeNodes(nodes []*taskTreeNode) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Task.Position < nodes[j].Task.Position
+	})
+	for _, n := range nodes {
+		sortTaskTreeNodes(n.Children)
+	}
+}
+
+func writeTaskTree(w io.Writer, nodes []*taskTreeNode, depth int) {
+	for _, n := range nodes {
+		marker := "[ ]"
+		if strings.TrimSpace(n.Task.Status) == taskStatusCompleted {
+			marker = "[x]"
+		}
+		fmt.Fprintf(w, "%s%s %s\t%s\t%s\n", strings.Repeat("  ", depth), marker, n.Task.Title, strings.TrimSpace(n.Task.Due), n.Task.Id)
+		writeTaskTree(w, n.Children, depth+1)
+	}
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/api/option"
+	"google.golang.org/api/tasks/v1"
+
+	"github.com/steipete/gogcli/internal/outfmt"
+	"github.com/steipete/gogcli/internal/ui"
+)
+
+func TestBuildTaskTree(t *testing.T) {
+	items := []*tasks.Task{
+		{Id: "c2", Title: "Child 2", Parent: "p1", Position: "00000000000000000002"},
+		{Id: "p2", Title: "Parent 2", Position: "00000000000000000002"},
+		{Id: "c1", Title: "Child 1", Parent: "p1", Position: "00000000000000000001"},
+		{Id: "p1", Title: "Parent 1", Position: "00000000000000000001"},
+		{Id: "o1", Title: "Orphan", Parent: "missing", Position: "00000000000000000003"},
+		{Id: "g1", Title: "Grandchild", Parent: "c1", Position: "00000000000000000001"},
+	}
+
+	roots := buildTaskTree(items)
+	if len(roots) != 3 {
+		t.Fatalf("expected 3 roots, got %d", len(roots))
+	}
+	if roots[0].Task.Id != "p1" || roots[1].Task.Id != "p2" || roots[2].Task.Id != "o1" {
+		t.Fatalf("unexpected root order: %s %s %s", roots[0].Task.Id, roots[1].Task.Id, roots[2].Task.Id)
+	}
+	if len(roots[0].Children) != 2 || roots[0].Children[0].Task.Id != "c1" || roots[0].Children[1].Task.Id != "c2" {
+		t.Fatalf("unexpected children of p1")
+	}
+	if len(roots[0].Children[0].Children) != 1 || roots[0].Children[0].Children[0].Task.Id != "g1" {
+		t.Fatalf("expected grandchild under c1")
+	}
+
+	b, err := json.Marshal(roots)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var parsed []struct {
+		ID       string `json:"id"`
+		Children []struct {
+			ID       string            `json:"id"`
+			Children []json.RawMessage `json:"children"`
+		} `json:"children"`
+	}
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if parsed[0].ID != "p1" || len(parsed[0].Children) != 2 || len(parsed[0].Children[0].Children) != 1 {
+		t.Fatalf("unexpected json tree: %s", b)
+	}
+}
+
+func TestBuildTaskTree_ParentCycle(t *testing.T) {
+	// d1 hangs off the a1 -> b1 -> a1 cycle; r1 is an ordinary root.
+	items := []*tasks.Task{
+		{Id: "d1", Title: "Dangling", Parent: "b1", Position: "00000000000000000001"},
+		{Id: "a1", Title: "A", Parent: "b1", Position: "00000000000000000002"},
+		{Id: "b1", Title: "B", Parent: "a1", Position: "00000000000000000003"},
+		{Id: "r1", Title: "Root", Position: "00000000000000000001"},
+	}
+
+	roots := buildTaskTree(items)
+	if len(roots) != 2 || roots[0].Task.Id != "r1" || roots[1].Task.Id != "b1" {
+		t.Fatalf("expected the cycle to be broken into a root, got %d roots", len(roots))
+	}
+	var buf bytes.Buffer
+	writeTaskTree(&buf, roots, 0)
+	for _, id := range []string{"r1", "a1", "b1", "d1"} {
+		if strings.Count(buf.String(), id) != 1 {
+			t.Fatalf("expected %s exactly once in:\n%s", id, buf.String())
+		}
+	}
+}
+
+func TestTasksListCmd_TreeAndFailEmpty(t *testing.T) {
+	origNew := newTasksService
+	t.Cleanup(func() { newTasksService = origNew })
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/tasks/v1/lists/l1/tasks") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"items": []map[string]any{
+					{"id": "c1", "title": "Child", "parent": "p1", "status": "completed", "position": "00000000000000000001"},
+					{"id": "p1", "title": "Parent", "status": "needsAction", "due": "2025-01-01T00:00:00.000Z", "position": "00000000000000000001"},
+				},
+			})
+		case strings.HasSuffix(r.URL.Path, "/tasks/v1/lists/empty/tasks") && r.Method == http.MethodGet:
+			_ = json.NewEncoder(w).Encode(map[string]any{"items": []map[string]any{}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	svc, err := tasks.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+	newTasksService = func(context.Context, string) (*tasks.Service, error) { return svc, nil }
+
+	flags := &RootFlags{Account: "a@b.com"}
+	u, uiErr := ui.New(ui.Options{Stdout: io.Discard, Stderr: io.Discard, Color: "never"})
+	if uiErr != nil {
+		t.Fatalf("ui.New: %v", uiErr)
+	}
+	ctx := ui.WithUI(context.Background(), u)
+
+	out := captureStdout(t, func() {
+		if err := runKong(t, &TasksListCmd{}, []string{"l1", "--tree"}, outfmt.WithMode(ctx, outfmt.Mode{Plain: true}), flags); err != nil {
+			t.Fatalf("list: %v", err)
+		}
+	})
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("unexpected tree output: %q", out)
+	}
+	if !strings.HasPrefix(lines[1], "[ ] Parent\t2025-01-01") || !strings.HasPrefix(lines[2], "  [x] Child\t") {
+		t.Fatalf("unexpected tree output: %q", out)
+	}
+
+	jsonOut := captureStdout(t, func() {
+		if err := runKong(t, &TasksListCmd{}, []string{"l1", "--tree"}, outfmt.WithMode(ctx, outfmt.Mode{JSON: true}), flags); err != nil {
+			t.Fatalf("list json: %v", err)
+		}
+	})
+	var parsed struct {
+		Tasks []struct {
+			ID       string `json:"id"`
+			Children []struct {
+				ID string `json:"id"`
+			} `json:"children"`
+		} `json:"tasks"`
+	}
+	if err := json.Unmarshal([]byte(jsonOut), &parsed); err != nil {
+		t.Fatalf("json parse: %v\nout=%q", err, jsonOut)
+	}
+	if len(parsed.Tasks) != 1 || parsed.Tasks[0].ID != "p1" || len(parsed.Tasks[0].Children) != 1 || parsed.Tasks[0].Children[0].ID != "c1" {
+		t.Fatalf("unexpected json tree: %s", jsonOut)
+	}
+
+	err = runKong(t, &TasksListCmd{}, []string{"empty", "--fail-empty"}, ctx, flags)
+	if ExitCode(err) != emptyResultsExitCode {
+		t.Fatalf("expected exit code %d, got %v", emptyResultsExitCode, err)
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// TemplateValueFlags collect {{key}} substitutions for template commands.
+type TemplateValueFlags struct {
+	Set     []string `name:"set" help:"Placeholder value as key=value, replacing {{key}}; can be repeated"`
+	SetFile string   `name:"set-file" help:"JSON object or two-column CSV (key,value) of placeholder values ('-' for stdin); --set wins on conflicts"`
+}
+
+// values merges --set-file and --set into one map.
+func (f TemplateValueFlags) values() (map[string]string, error) {
+	values := map[string]string{}
+	if path := strings.TrimSpace(f.SetFile); path != "" {
+		b, err := readInputFile(path)
+		if err != nil {
+			return nil, err
+		}
+		fileValues, err := parseTemplateValuesFile(path, b)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range fileValues {
+			values[k] = v
+		}
+	}
+	for _, raw := range f.Set {
+		k, v, ok := strings.Cut(raw, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, usagef("invalid --set %q (want key=value)", raw)
+		}
+		values[k] = v
+	}
+	return values, nil
+}
+
+// parseTemplateValuesFile accepts a JSON object (scalar values) or CSV rows of
+// key,value. A leading "key,value" header row is skipped.
+func parseTemplateValuesFile(path string, b []byte) (map[string]string, error) {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) == 0 {
+		return nil, usage("empty --set-file")
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".json") || trimmed[0] == '{' {
+		// UseNumber keeps numbers as written (an ID like 12345678901234567890
+		// would otherwise come back as 1.2345678901234567e+19).
+		var raw map[string]any
+		dec := json.NewDecoder(bytes.NewReader(trimmed))
+		dec.UseNumber()
+		if err := dec.Decode(&raw); err != nil {
+			return nil, usagef("invalid --set-file JSON (want an object of key: value): %v", err)
+		}
+		out := make(map[string]string, len(raw))
+		for k, v := range raw {
+			switch v := v.(type) {
+			case map[string]any, []any:
+				return nil, usagef("--set-file value for %q must be a string, number or boolean", k)
+			case nil:
+				out[k] = ""
+			case json.Number:
+				out[k] = v.String()
+			default:
+				out[k] = fmt.Sprint(v)
+			}
+		}
+		return out, nil
+	}
+
+	r := csv.NewReader(bytes.NewReader(trimmed))
+	r.FieldsPerRecord = 2
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, usagef("invalid --set-file CSV (want key,value rows): %v", err)
+	}
+	out := make(map[string]string, len(rows))
+	for i, row := range rows {
+		k := strings.TrimSpace(row[0])
+		if i == 0 && strings.EqualFold(k, "key") && strings.EqualFold(strings.TrimSpace(row[1]), "value") {
+			continue
+		}
+		if k == "" {
+			return nil, usagef("--set-file row %d has an empty key", i+1)
+		}
+		out[k] = row[1]
+	}
+	return out, nil
+}
+
+func templatePlaceholder(key string) string {
+	return "{{" + key + "}}"
+}
+
+func sortedTemplateKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestTemplateValueFlags(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "values.json")
+	if err := os.WriteFile(jsonPath, []byte(`{"name": "Ada", "amount": 1200, "invoice": 12345678901234567890, "rate": 0.07, "signed": true, "note": null}`), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err := TemplateValueFlags{SetFile: jsonPath, Set: []string{"name=Grace", "empty="}}.values()
+	if err != nil {
+		t.Fatalf("values: %v", err)
+	}
+	want := map[string]string{"name": "Grace", "amount": "1200", "invoice": "12345678901234567890", "rate": "0.07", "signed": "true", "note": "", "empty": ""}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	csvPath := filepath.Join(dir, "values.csv")
+	if err := os.WriteFile(csvPath, []byte("key,value\nclient,\"Acme, Inc.\"\ndate,2026-01-05\n"), 0o600); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	got, err = TemplateValueFlags{SetFile: csvPath}.values()
+	if err != nil {
+		t.Fatalf("values csv: %v", err)
+	}
+	if !reflect.DeepEqual(got, map[string]string{"client": "Acme, Inc.", "date": "2026-01-05"}) {
+		t.Fatalf("unexpected csv values: %v", got)
+	}
+
+	for name, f := range map[string]TemplateValueFlags{
+		"missing equals": {Set: []string{"name"}},
+		"empty key":      {Set: []string{"=x"}},
+	} {
+		if _, err := f.values(); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+
+	for name, content := range map[string]string{
+		"nested json": `{"a": {"b": 1}}`,
+		"bad csv":     "a,b,c\n",
+		"empty":       "  ",
+	} {
+		if _, err := parseTemplateValuesFile("values", []byte(content)); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+	}
+}
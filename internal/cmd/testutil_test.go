@@ -133,7 +133,8 @@ func runKong(t *testing.T, cmd any, args []string, ctx context.Context, flags *R
 	parser, err := kong.New(
 		cmd,
 		kong.Vars(kong.Vars{
-			"auth_services": googleauth.UserServiceCSV(),
+			"auth_services":     googleauth.UserServiceCSV(),
+			"upload_chunk_size": "16MB",
 		}),
 		kong.Writers(io.Discard, io.Discard),
 		kong.Exit(func(code int) { panic(exitPanic{code: code}) }),
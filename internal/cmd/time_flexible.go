@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	relativeOffsetRegex = regexp.MustCompile(`^([+-])\s*(\d+)\s*(m|mins?|minutes?|h|hrs?|hours?|d|days?|w|weeks?)$`)
+	clockRegex          = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(?::(\d{2}))?\s*(am|pm)?$`)
+)
+
+// parseFlexibleTime extends parseTimeExpr with human-friendly forms, resolved
+// against now in loc:
+// - Offsets: +2h, -30m, +1d, +1w, "in 90 minutes"
+// - Clock times (today): 3pm, 15:30, noon
+// - Day plus clock: tomorrow 3pm, next monday 09:00, 2026-01-05 at 14:30
+//
+// Wall-clock forms are built with time.Date in loc, so they keep their local
+// hour across DST changes; hour/minute offsets are exact durations, while day
+// and week offsets keep the wall-clock time.
+func parseFlexibleTime(expr string, now time.Time, loc *time.Location) (time.Time, error) {
+	expr = strings.TrimSpace(expr)
+	if loc == nil {
+		loc = time.Local
+	}
+	now = now.In(loc)
+
+	if t, err := parseTimeExpr(expr, now, loc); err == nil {
+		return t, nil
+	}
+
+	lower := strings.Join(strings.Fields(strings.ToLower(expr)), " ")
+	if rest, ok := strings.CutPrefix(lower, "in "); ok {
+		lower = "+" + rest
+	}
+	if t, ok := parseRelativeOffset(lower, now); ok {
+		return t, nil
+	}
+
+	// "3 pm" -> "3pm" so the clock is a single token.
+	lower = strings.NewReplacer(" am", "am", " pm", "pm").Replace(lower)
+	if h, m, s, ok := parseClock(lower); ok {
+		return time.Date(now.Year(), now.Month(), now.Day(), h, m, s, 0, loc), nil
+	}
+
+	if i := strings.LastIndex(lower, " "); i > 0 {
+		dayPart := strings.TrimSuffix(strings.TrimSpace(lower[:i]), " at")
+		if h, m, s, ok := parseClock(lower[i+1:]); ok {
+			if day, err := parseTimeExpr(dayPart, now, loc); err == nil {
+				day = day.In(loc)
+				return time.Date(day.Year(), day.Month(), day.Day(), h, m, s, 0, loc), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse %q as time (try: RFC3339, 2026-01-05 14:00, tomorrow 3pm, next monday 09:00, +2h)", expr)
+}
+
+// isRelativeOffset reports whether expr is an offset (+2h, in 90 minutes)
+// rather than a point in time.
+func isRelativeOffset(expr string) bool {
+	lower := strings.Join(strings.Fields(strings.ToLower(strings.TrimSpace(expr))), " ")
+	if rest, ok := strings.CutPrefix(lower, "in "); ok {
+		lower = "+" + rest
+	}
+	return relativeOffsetRegex.MatchString(lower)
+}
+
+func parseRelativeOffset(expr string, now time.Time) (time.Time, bool) {
+	match := relativeOffsetRegex.FindStringSubmatch(expr)
+	if match == nil {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(match[2])
+	if err != nil {
+		return time.Time{}, false
+	}
+	if match[1] == "-" {
+		n = -n
+	}
+	switch match[3][0] {
+	case 'm':
+		return now.Add(time.Duration(n) * time.Minute), true
+	case 'h':
+		return now.Add(time.Duration(n) * time.Hour), true
+	case 'd':
+		return now.AddDate(0, 0, n), true
+	default:
+		return now.AddDate(0, 0, 7*n), true
+	}
+}
+
+// parseClock parses a time of day: 15:04, 15:04:05, 3pm, 3:30pm, noon,
+// midnight.
+func parseClock(expr string) (int, int, int, bool) {
+	switch expr {
+	case "noon":
+		return 12, 0, 0, true
+	case "midnight":
+		return 0, 0, 0, true
+	}
+	match := clockRegex.FindStringSubmatch(expr)
+	if match == nil {
+		return 0, 0, 0, false
+	}
+	// A bare number is only a clock time with am/pm ("3pm", not "3").
+	if match[2] == "" && match[4] == "" {
+		return 0, 0, 0, false
+	}
+	h, _ := strconv.Atoi(match[1])
+	m, s := 0, 0
+	if match[2] != "" {
+		m, _ = strconv.Atoi(match[2])
+	}
+	if match[3] != "" {
+		s, _ = strconv.Atoi(match[3])
+	}
+	switch match[4] {
+	case "am", "pm":
+		if h < 1 || h > 12 {
+			return 0, 0, 0, false
+		}
+		if h == 12 {
+			h = 0
+		}
+		if match[4] == "pm" {
+			h += 12
+		}
+	}
+	if h > 23 || m > 59 || s > 59 {
+		return 0, 0, 0, false
+	}
+	return h, m, s, true
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+)
+
+func TestParseFlexibleTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	now := time.Date(2026, 1, 14, 10, 15, 0, 0, loc) // Wednesday
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2026-01-20T09:00:00Z", time.Date(2026, 1, 20, 9, 0, 0, 0, time.UTC)},
+		{"tomorrow 3pm", time.Date(2026, 1, 15, 15, 0, 0, 0, loc)},
+		{"Tomorrow at 3:30 PM", time.Date(2026, 1, 15, 15, 30, 0, 0, loc)},
+		{"next monday 09:00", time.Date(2026, 1, 19, 9, 0, 0, 0, loc)},
+		{"2026-02-01 14:30", time.Date(2026, 2, 1, 14, 30, 0, 0, loc)},
+		{"2026-02-01 at noon", time.Date(2026, 2, 1, 12, 0, 0, 0, loc)},
+		{"5pm", time.Date(2026, 1, 14, 17, 0, 0, 0, loc)},
+		{"12am", time.Date(2026, 1, 14, 0, 0, 0, 0, loc)},
+		{"+2h", now.Add(2 * time.Hour)},
+		{"-30m", now.Add(-30 * time.Minute)},
+		{"in 90 minutes", now.Add(90 * time.Minute)},
+		{"+1w", time.Date(2026, 1, 21, 10, 15, 0, 0, loc)},
+	}
+	for _, tt := range tests {
+		got, err := parseFlexibleTime(tt.in, now, loc)
+		if err != nil {
+			t.Fatalf("parseFlexibleTime(%q): %v", tt.in, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Fatalf("parseFlexibleTime(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", "soon", "3", "25:00", "13pm", "tomorrow 3"} {
+		if _, err := parseFlexibleTime(bad, now, loc); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+}
+
+func TestParseFlexibleTime_DST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+	// Saturday before the 2026-03-08 spring-forward transition.
+	now := time.Date(2026, 3, 7, 9, 0, 0, 0, loc)
+
+	// Wall-clock forms keep the local hour and pick up the new offset.
+	got, err := parseFlexibleTime("tomorrow 09:00", now, loc)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got.Format(time.RFC3339) != "2026-03-08T09:00:00-04:00" {
+		t.Fatalf("tomorrow 09:00 = %s", got.Format(time.RFC3339))
+	}
+	got, err = parseFlexibleTime("+1d", now, loc)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got.Format(time.RFC3339) != "2026-03-08T09:00:00-04:00" {
+		t.Fatalf("+1d = %s", got.Format(time.RFC3339))
+	}
+
+	// Hour offsets are exact durations: 24h later is 10:00 local.
+	got, err = parseFlexibleTime("+24h", now, loc)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got.Format(time.RFC3339) != "2026-03-08T10:00:00-04:00" {
+		t.Fatalf("+24h = %s", got.Format(time.RFC3339))
+	}
+}
+
+func TestResolveFlexibleEventTimes(t *testing.T) {
+	var calendarLookups int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/users/me/calendarList/") {
+			calendarLookups++
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"id": "primary", "timeZone": "Europe/Berlin"})
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	svc, err := calendar.NewService(context.Background(),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"),
+	)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	now := time.Date(2026, 6, 1, 8, 0, 0, 0, time.UTC)
+	strict := &calendar.EventDateTime{DateTime: "2026-06-02T10:00:00Z"}
+	if err := resolveFlexibleEventTimes(context.Background(), svc, "primary", now, strict, &calendar.EventDateTime{Date: "2026-06-02"}); err != nil {
+		t.Fatalf("resolve strict: %v", err)
+	}
+	if calendarLookups != 0 || strict.DateTime != "2026-06-02T10:00:00Z" {
+		t.Fatalf("strict values must pass through untouched (lookups=%d, %#v)", calendarLookups, strict)
+	}
+
+	start := &calendar.EventDateTime{DateTime: "tomorrow 3pm"}
+	day := &calendar.EventDateTime{Date: "tomorrow"}
+	if err := resolveFlexibleEventTimes(context.Background(), svc, "primary", now, start, day); err != nil {
+		t.Fatalf("resolve flexible: %v", err)
+	}
+	if start.DateTime != "2026-06-02T15:00:00+02:00" || start.TimeZone != "Europe/Berlin" || day.Date != "2026-06-02" {
+		t.Fatalf("unexpected resolution: %#v %#v", start, day)
+	}
+	if calendarLookups != 1 {
+		t.Fatalf("expected one timezone lookup, got %d", calendarLookups)
+	}
+
+	// An offset end is anchored on the start, not on now.
+	start = &calendar.EventDateTime{DateTime: "tomorrow 3pm"}
+	end := &calendar.EventDateTime{DateTime: "+3h"}
+	if err := resolveFlexibleEventTimes(context.Background(), svc, "primary", now, start, end); err != nil {
+		t.Fatalf("resolve offset end: %v", err)
+	}
+	if end.DateTime != "2026-06-02T18:00:00+02:00" {
+		t.Fatalf("expected end three hours after start, got %#v", end)
+	}
+	end = &calendar.EventDateTime{DateTime: "in 30 minutes"}
+	if err := resolveFlexibleEventTimes(context.Background(), svc, "primary", now, strict, end); err != nil {
+		t.Fatalf("resolve offset after strict start: %v", err)
+	}
+	if end.DateTime != "2026-06-02T12:30:00+02:00" {
+		t.Fatalf("expected end after strict start, got %#v", end)
+	}
+	day = &calendar.EventDateTime{Date: "+2d"}
+	if err := resolveFlexibleEventTimes(context.Background(), svc, "primary", now, &calendar.EventDateTime{Date: "2026-07-01"}, day); err != nil {
+		t.Fatalf("resolve offset date: %v", err)
+	}
+	if day.Date != "2026-07-03" {
+		t.Fatalf("expected date offset from start date, got %#v", day)
+	}
+
+	if err := resolveFlexibleEventTimes(context.Background(), svc, "primary", now, &calendar.EventDateTime{DateTime: "whenever"}); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
@@ -21,6 +21,12 @@ type TimeRangeFlags struct {
 	WeekStart string `name:"week-start" help:"Week start day for --week (sun, mon, ...)" default:""`
 }
 
+// hasTimeRange reports whether any range flag was given.
+func (f TimeRangeFlags) hasTimeRange() bool {
+	return strings.TrimSpace(f.From) != "" || strings.TrimSpace(f.To) != "" ||
+		f.Today || f.Tomorrow || f.Week || f.Days > 0
+}
+
 // TimeRange represents a resolved time range with timezone.
 type TimeRange struct {
 	From     time.Time
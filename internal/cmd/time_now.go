@@ -37,7 +37,7 @@ func (c *TimeNowCmd) Run(ctx context.Context) error {
 	offset := formatUTCOffset(now)
 
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
+		return outfmt.WriteJSONContext(ctx, os.Stdout, map[string]any{
 			"timezone":     tz,
 			"current_time": now.Format(time.RFC3339),
 			"utc_offset":   offset,
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"runtime"
+	"runtime/debug"
 	"strings"
 
 	"github.com/steipete/gogcli/internal/outfmt"
@@ -15,6 +17,15 @@ var (
 	date    = ""
 )
 
+// readBuildInfo is swapped in tests.
+var readBuildInfo = debug.ReadBuildInfo
+
+// versionModules are the linked API client modules reported by `gog version`.
+var versionModules = []string{
+	"google.golang.org/api",
+	"golang.org/x/oauth2",
+}
+
 func VersionString() string {
 	v := strings.TrimSpace(version)
 	if v == "" {
@@ -32,16 +43,89 @@ func VersionString() string {
 	return fmt.Sprintf("%s (%s %s)", v, strings.TrimSpace(commit), strings.TrimSpace(date))
 }
 
+type buildDetails struct {
+	Version  string            `json:"version"`
+	Commit   string            `json:"commit"`
+	Date     string            `json:"date"`
+	Go       string            `json:"go"`
+	Platform string            `json:"platform"`
+	Modules  map[string]string `json:"modules"`
+}
+
+// currentBuildDetails combines the -ldflags values with the build info
+// embedded by the Go toolchain, which fills in commit and date for plain
+// `go build`/`go install` builds.
+func currentBuildDetails() buildDetails {
+	d := buildDetails{
+		Version:  strings.TrimSpace(version),
+		Commit:   strings.TrimSpace(commit),
+		Date:     strings.TrimSpace(date),
+		Go:       runtime.Version(),
+		Platform: runtime.GOOS + "/" + runtime.GOARCH,
+		Modules:  map[string]string{},
+	}
+	if bi, ok := readBuildInfo(); ok && bi != nil {
+		if d.Version == "" && bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			d.Version = bi.Main.Version
+		}
+		for _, s := range bi.Settings {
+			switch {
+			case s.Key == "vcs.revision" && d.Commit == "":
+				d.Commit = s.Value
+				if len(d.Commit) > 12 {
+					d.Commit = d.Commit[:12]
+				}
+			case s.Key == "vcs.time" && d.Date == "":
+				d.Date = s.Value
+			}
+		}
+		for _, dep := range bi.Deps {
+			for _, path := range versionModules {
+				if dep.Path != path {
+					continue
+				}
+				v := dep.Version
+				if dep.Replace != nil {
+					v = dep.Replace.Version
+				}
+				d.Modules[path] = v
+			}
+		}
+	}
+	if d.Version == "" {
+		d.Version = "dev"
+	}
+	return d
+}
+
 type VersionCmd struct{}
 
-func (c *VersionCmd) Run(ctx context.Context) error {
+func (c *VersionCmd) Run(ctx context.Context, flags *RootFlags) error {
 	if outfmt.IsJSON(ctx) {
-		return outfmt.WriteJSON(os.Stdout, map[string]any{
-			"version": strings.TrimSpace(version),
-			"commit":  strings.TrimSpace(commit),
-			"date":    strings.TrimSpace(date),
-		})
+		return outfmt.WriteJSONContext(ctx, os.Stdout, currentBuildDetails())
+	}
+	// Scripts parse the single line; the build details are opt-in.
+	if flags == nil || !flags.Verbose {
+		fmt.Fprintln(os.Stdout, VersionString())
+		return nil
+	}
+
+	d := currentBuildDetails()
+	w, flush := tableWriter(ctx)
+	defer flush()
+	fmt.Fprintf(w, "version\t%s\n", d.Version)
+	if d.Commit != "" {
+		fmt.Fprintf(w, "commit\t%s\n", d.Commit)
+	}
+	if d.Date != "" {
+		fmt.Fprintf(w, "date\t%s\n", d.Date)
+	}
+	fmt.Fprintf(w, "go\t%s\n", d.Go)
+	fmt.Fprintf(w, "platform\t%s\n", d.Platform)
+	for _, path := range versionModules {
+		if v, ok := d.Modules[path]; ok {
+			fmt.Fprintf(w, "%s\t%s\n", path, v)
+		}
 	}
-	fmt.Fprintln(os.Stdout, VersionString())
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	"runtime/debug"
 	"testing"
 
 	"github.com/steipete/gogcli/internal/outfmt"
@@ -45,7 +46,7 @@ func TestVersionCmd_JSON(t *testing.T) {
 	ctx = outfmt.WithMode(ctx, outfmt.Mode{JSON: true})
 
 	jsonOut := captureStdout(t, func() {
-		if err := runKong(t, &VersionCmd{}, []string{}, ctx, nil); err != nil {
+		if err := runKong(t, &VersionCmd{}, []string{}, ctx, &RootFlags{}); err != nil {
 			t.Fatalf("execute: %v", err)
 		}
 	})
@@ -62,3 +63,42 @@ func TestVersionCmd_JSON(t *testing.T) {
 		t.Fatalf("unexpected json: %#v", parsed)
 	}
 }
+
+func TestCurrentBuildDetails_BuildInfoFallback(t *testing.T) {
+	origVersion, origCommit, origDate, origRead := version, commit, date, readBuildInfo
+	t.Cleanup(func() { version, commit, date, readBuildInfo = origVersion, origCommit, origDate, origRead })
+
+	readBuildInfo = func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{
+			Main: debug.Module{Path: "github.com/steipete/gogcli", Version: "(devel)"},
+			Deps: []*debug.Module{
+				{Path: "google.golang.org/api", Version: "v0.260.0"},
+				{Path: "golang.org/x/oauth2", Version: "v0.30.0", Replace: &debug.Module{Path: "golang.org/x/oauth2", Version: "v0.31.0"}},
+				{Path: "golang.org/x/text", Version: "v0.20.0"},
+			},
+			Settings: []debug.BuildSetting{
+				{Key: "vcs.revision", Value: "0123456789abcdef0123"},
+				{Key: "vcs.time", Value: "2026-01-02T03:04:05Z"},
+			},
+		}, true
+	}
+
+	version, commit, date = "", "", ""
+	d := currentBuildDetails()
+	if d.Version != "dev" || d.Commit != "0123456789ab" || d.Date != "2026-01-02T03:04:05Z" {
+		t.Fatalf("unexpected fallback: %#v", d)
+	}
+	if len(d.Modules) != 2 || d.Modules["google.golang.org/api"] != "v0.260.0" || d.Modules["golang.org/x/oauth2"] != "v0.31.0" {
+		t.Fatalf("unexpected modules: %#v", d.Modules)
+	}
+	if d.Go == "" || d.Platform == "" {
+		t.Fatalf("missing runtime info: %#v", d)
+	}
+
+	// Values injected via -ldflags win over the embedded build info.
+	version, commit, date = "v1", "abc", "2025-01-01"
+	d = currentBuildDetails()
+	if d.Version != "v1" || d.Commit != "abc" || d.Date != "2025-01-01" {
+		t.Fatalf("ldflags values not preferred: %#v", d)
+	}
+}
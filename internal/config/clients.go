@@ -124,6 +124,19 @@ func SetAccountClient(cfg *File, email string, client string) error {
 	return nil
 }
 
+// ClearAccountClient removes the explicit client mapping for email and reports
+// whether one existed.
+func ClearAccountClient(cfg *File, email string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if _, ok := cfg.AccountClients[email]; !ok {
+		return false
+	}
+
+	delete(cfg.AccountClients, email)
+
+	return true
+}
+
 func AccountClient(cfg File, email string) (string, bool) {
 	email = strings.ToLower(strings.TrimSpace(email))
 	if email == "" {
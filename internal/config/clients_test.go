@@ -134,6 +134,18 @@ func TestAccountAndDomainMappings(t *testing.T) {
 		t.Fatalf("AccountClient = %q,%v", got, ok)
 	}
 
+	if !ClearAccountClient(&cfg, "User@Example.com") {
+		t.Fatalf("expected mapping to be cleared")
+	}
+
+	if _, ok := AccountClient(cfg, "user@example.com"); ok {
+		t.Fatalf("expected cleared mapping to be gone")
+	}
+
+	if ClearAccountClient(&cfg, "user@example.com") {
+		t.Fatalf("expected second clear to report no mapping")
+	}
+
 	cfg.AccountClients["bad@example.com"] = "bad!"
 	if _, ok := AccountClient(cfg, "bad@example.com"); ok {
 		t.Fatalf("expected invalid account client to be ignored")
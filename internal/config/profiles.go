@@ -0,0 +1,75 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Profile holds the defaults a named profile (--profile / GOG_PROFILE)
+// applies. Empty fields fall back to the top-level config.
+type Profile struct {
+	Account        string `json:"account,omitempty"`
+	Client         string `json:"client,omitempty"`
+	KeyringBackend string `json:"keyring_backend,omitempty"`
+}
+
+var errUnknownProfile = errors.New("unknown profile")
+
+var (
+	activeProfileMu sync.RWMutex
+	activeProfile   string
+)
+
+// ProfileNames returns the defined profile names, sorted.
+func ProfileNames(cfg File) []string {
+	names := make([]string, 0, len(cfg.Profiles))
+	for name := range cfg.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// LookupProfile returns the profile called name. Unknown names are an error
+// listing the defined profiles.
+func LookupProfile(cfg File, name string) (Profile, error) {
+	name = strings.TrimSpace(name)
+	if p, ok := cfg.Profiles[name]; ok {
+		return p, nil
+	}
+
+	names := ProfileNames(cfg)
+	if len(names) == 0 {
+		return Profile{}, fmt.Errorf("%w %q (no profiles defined in config)", errUnknownProfile, name)
+	}
+
+	return Profile{}, fmt.Errorf("%w %q (defined: %s)", errUnknownProfile, name, strings.Join(names, ", "))
+}
+
+// UseProfile selects the profile consulted by ActiveProfile for the rest of
+// the process. An empty name clears the selection.
+func UseProfile(name string) {
+	activeProfileMu.Lock()
+	defer activeProfileMu.Unlock()
+
+	activeProfile = strings.TrimSpace(name)
+}
+
+// ActiveProfile returns the profile selected with UseProfile, if any.
+func ActiveProfile(cfg File) (Profile, bool) {
+	activeProfileMu.RLock()
+	name := activeProfile
+	activeProfileMu.RUnlock()
+
+	if name == "" {
+		return Profile{}, false
+	}
+
+	p, ok := cfg.Profiles[name]
+
+	return p, ok
+}
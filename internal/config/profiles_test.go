@@ -0,0 +1,40 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLookupProfile(t *testing.T) {
+	cfg := File{Profiles: map[string]Profile{
+		"work":     {Account: "me@work.com", Client: "work"},
+		"personal": {Account: "me@gmail.com"},
+	}}
+
+	p, err := LookupProfile(cfg, " work ")
+	if err != nil || p.Account != "me@work.com" || p.Client != "work" {
+		t.Fatalf("LookupProfile: %+v %v", p, err)
+	}
+
+	_, err = LookupProfile(cfg, "school")
+	if !errors.Is(err, errUnknownProfile) || !strings.Contains(err.Error(), "defined: personal, work") {
+		t.Fatalf("expected unknown profile error listing names, got %v", err)
+	}
+	if _, err = LookupProfile(File{}, "work"); err == nil || !strings.Contains(err.Error(), "no profiles defined") {
+		t.Fatalf("expected no-profiles error, got %v", err)
+	}
+}
+
+func TestActiveProfile(t *testing.T) {
+	t.Cleanup(func() { UseProfile("") })
+	cfg := File{Profiles: map[string]Profile{"work": {KeyringBackend: "file"}}}
+
+	if _, ok := ActiveProfile(cfg); ok {
+		t.Fatalf("expected no active profile")
+	}
+	UseProfile("work")
+	if p, ok := ActiveProfile(cfg); !ok || p.KeyringBackend != "file" {
+		t.Fatalf("ActiveProfile = %+v, %v", p, ok)
+	}
+}
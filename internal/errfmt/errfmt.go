@@ -37,6 +37,18 @@ func Format(err error) string {
 		)
 	}
 
+	var scopeErr *gogapi.MissingScopeError
+	if errors.As(err, &scopeErr) {
+		return fmt.Sprintf(
+			"Account %s lacks %s scope (missing: %s).\nRun: gog auth add %s --services %s",
+			scopeErr.Email,
+			scopeErr.Service,
+			strings.Join(scopeErr.Missing, ", "),
+			scopeErr.Email,
+			strings.Join(scopeErr.Services, ","),
+		)
+	}
+
 	var credErr *config.CredentialsMissingError
 	if errors.As(err, &credErr) {
 		return fmt.Sprintf(
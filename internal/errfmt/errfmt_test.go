@@ -2,6 +2,7 @@ package errfmt
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -148,3 +149,17 @@ func containsAll(s string, subs ...string) bool {
 
 	return true
 }
+
+func TestFormat_MissingScope(t *testing.T) {
+	err := &gogapi.MissingScopeError{
+		Service:  "calendar",
+		Email:    "a@b.com",
+		Missing:  []string{"https://www.googleapis.com/auth/calendar"},
+		Services: []string{"drive", "calendar"},
+	}
+	got := Format(fmt.Errorf("calendar options: %w", err))
+
+	if !containsAll(got, "lacks calendar scope", "gog auth add a@b.com --services drive,calendar") {
+		t.Fatalf("unexpected: %q", got)
+	}
+}
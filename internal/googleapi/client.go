@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/99designs/keyring"
@@ -20,11 +21,19 @@ import (
 	"github.com/steipete/gogcli/internal/secrets"
 )
 
-const defaultHTTPTimeout = 30 * time.Second
+const (
+	defaultHTTPTimeout = 30 * time.Second
+	// accessTokenReuseSkew keeps cached access tokens from being used right before expiry.
+	accessTokenReuseSkew = time.Minute
+	// lastUsedTouchInterval limits keyring writes for Token.LastUsedAt when a
+	// command builds several services.
+	lastUsedTouchInterval = time.Minute
+)
 
 var (
 	readClientCredentials = config.ReadClientCredentialsFor
 	openSecretsStore      = secrets.OpenDefault
+	timeNow               = time.Now
 )
 
 func tokenSourceForAccount(ctx context.Context, service googleauth.Service, email string) (oauth2.TokenSource, error) {
@@ -70,6 +79,12 @@ func tokenSourceForAccountScopes(ctx context.Context, serviceLabel string, email
 		tok = t
 	}
 
+	if err := checkStoredScopes(serviceLabel, email, client, tok, requiredScopes); err != nil {
+		return nil, err
+	}
+
+	touchTokenLastUsed(store, client, email, tok)
+
 	cfg := oauth2.Config{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
@@ -78,9 +93,77 @@ func tokenSourceForAccountScopes(ctx context.Context, serviceLabel string, email
 	}
 
 	// Ensure refresh-token exchanges don't hang forever.
-	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: defaultHTTPTimeout})
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, tokenHTTPClient(ctx))
+
+	return cfg.TokenSource(ctx, initialOAuthToken(store, client, email, tok.RefreshToken)), nil
+}
+
+// checkStoredScopes fails fast, without a network call, when the token was
+// authorized without the scopes a service needs; Google would otherwise answer
+// with an opaque 403. Tokens stored without a scope list are not checked.
+func checkStoredScopes(serviceLabel string, email string, client string, tok secrets.Token, requiredScopes []string) error {
+	if len(tok.Scopes) == 0 {
+		return nil
+	}
+
+	missing := googleauth.MissingScopes(tok.Scopes, requiredScopes)
+	if len(missing) == 0 {
+		return nil
+	}
+
+	// Accounts authorized with --readonly or a narrower --drive-scope still
+	// hold a usable grant for the service.
+	if service, err := googleauth.ParseService(serviceLabel); err == nil && googleauth.ServiceScopesGranted(service, tok.Scopes) {
+		return nil
+	}
+
+	services := append([]string{}, tok.Services...)
+	if !slices.Contains(services, serviceLabel) {
+		services = append(services, serviceLabel)
+	}
+
+	return &MissingScopeError{Service: serviceLabel, Email: email, Client: client, Missing: missing, Services: services}
+}
+
+// touchTokenLastUsed records that tok was used to build a service. Failures
+// only affect `auth list` metadata, so they are logged, not returned.
+func touchTokenLastUsed(store secrets.Store, client string, email string, tok secrets.Token) {
+	usage, ok := store.(secrets.TokenUsageStore)
+	if !ok {
+		return
+	}
+
+	now := timeNow().UTC()
+	if now.Sub(tok.LastUsedAt) < lastUsedTouchInterval {
+		return
+	}
+
+	if err := usage.SetTokenLastUsed(client, email, now); err != nil {
+		slog.Debug("failed to record token last use", "email", email, "client", client, "err", err)
+	}
+}
 
-	return cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: tok.RefreshToken}), nil
+// initialOAuthToken seeds the token source with an access token cached by
+// `gog auth refresh` so valid tokens skip the refresh exchange.
+func initialOAuthToken(store secrets.Store, client string, email string, refreshToken string) *oauth2.Token {
+	seed := &oauth2.Token{RefreshToken: refreshToken}
+
+	cache, ok := store.(secrets.AccessTokenStore)
+	if !ok {
+		return seed
+	}
+
+	cached, err := cache.GetAccessToken(client, email)
+	if err != nil || !cached.Valid(time.Now(), accessTokenReuseSkew) {
+		return seed
+	}
+
+	slog.Debug("using cached access token", "email", email, "client", client, "expiry", cached.Expiry)
+	seed.AccessToken = cached.AccessToken
+	seed.TokenType = cached.TokenType
+	seed.Expiry = cached.Expiry
+
+	return seed
 }
 
 func optionsForAccount(ctx context.Context, service googleauth.Service, email string) ([]option.ClientOption, error) {
@@ -95,6 +178,19 @@ func optionsForAccount(ctx context.Context, service googleauth.Service, email st
 func optionsForAccountScopes(ctx context.Context, serviceLabel string, email string, scopes []string) ([]option.ClientOption, error) {
 	slog.Debug("creating client options with custom scopes", "serviceLabel", serviceLabel, "email", email)
 
+	c, err := httpClientForAccountScopes(ctx, serviceLabel, email, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Debug("client options with custom scopes created successfully", "serviceLabel", serviceLabel, "email", email)
+
+	return []option.ClientOption{option.WithHTTPClient(c)}, nil
+}
+
+// httpClientForAccountScopes builds the authenticated (rate-limited, retrying)
+// HTTP client that backs every API service.
+func httpClientForAccountScopes(ctx context.Context, serviceLabel string, email string, scopes []string) (*http.Client, error) {
 	var creds config.ClientCredentials
 
 	var ts oauth2.TokenSource
@@ -123,21 +219,24 @@ func optionsForAccountScopes(ctx context.Context, serviceLabel string, email str
 		}
 	}
 	baseTransport := &http.Transport{
+		Proxy: googleauth.ProxyFunc(ctx),
 		TLSClientConfig: &tls.Config{
 			MinVersion: tls.VersionTLS12,
 		},
 	}
-	// Wrap with retry logic for 429 and 5xx errors
-	retryTransport := NewRetryTransport(&oauth2.Transport{
+	var authTransport http.RoundTripper = &oauth2.Transport{
 		Source: ts,
 		Base:   baseTransport,
-	})
-	c := &http.Client{
+	}
+	// Throttle every attempt (including retries) when --qps is set
+	if limiter := rateLimiterFromContext(ctx); limiter != nil {
+		authTransport = &RateLimitTransport{Base: authTransport, Limiter: limiter}
+	}
+	// Wrap with retry logic for 429 and 5xx errors
+	retryTransport := NewRetryTransport(authTransport)
+	applyRetryConfig(ctx, retryTransport)
+	return &http.Client{
 		Transport: retryTransport,
 		Timeout:   defaultHTTPTimeout,
-	}
-
-	slog.Debug("client options with custom scopes created successfully", "serviceLabel", serviceLabel, "email", email)
-
-	return []option.ClientOption{option.WithHTTPClient(c)}, nil
+	}, nil
 }
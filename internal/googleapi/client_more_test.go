@@ -5,7 +5,9 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/99designs/keyring"
 	"golang.org/x/oauth2"
@@ -25,15 +27,26 @@ type stubStore struct {
 	lastClient string
 	lastEmail  string
 	tok        secrets.Token
+	saved      []secrets.Token
+	touched    []time.Time
 	err        error
 }
 
-func (s *stubStore) Keys() ([]string, error)                      { return nil, nil }
-func (s *stubStore) SetToken(string, string, secrets.Token) error { return nil }
-func (s *stubStore) DeleteToken(string, string) error             { return nil }
-func (s *stubStore) ListTokens() ([]secrets.Token, error)         { return nil, nil }
-func (s *stubStore) GetDefaultAccount(string) (string, error)     { return "", nil }
-func (s *stubStore) SetDefaultAccount(string, string) error       { return nil }
+func (s *stubStore) Keys() ([]string, error)                  { return nil, nil }
+func (s *stubStore) DeleteToken(string, string) error         { return nil }
+func (s *stubStore) ListTokens() ([]secrets.Token, error)     { return nil, nil }
+func (s *stubStore) GetDefaultAccount(string) (string, error) { return "", nil }
+func (s *stubStore) SetDefaultAccount(string, string) error   { return nil }
+func (s *stubStore) SetToken(_ string, _ string, tok secrets.Token) error {
+	s.saved = append(s.saved, tok)
+
+	return nil
+}
+func (s *stubStore) SetTokenLastUsed(_ string, _ string, at time.Time) error {
+	s.touched = append(s.touched, at)
+
+	return nil
+}
 func (s *stubStore) GetToken(client string, email string) (secrets.Token, error) {
 	s.lastClient = client
 	s.lastEmail = email
@@ -121,6 +134,137 @@ func TestTokenSourceForAccountScopes_HappyPath(t *testing.T) {
 	}
 }
 
+type cachingStubStore struct {
+	stubStore
+	access secrets.AccessToken
+}
+
+func (s *cachingStubStore) SetAccessToken(string, string, secrets.AccessToken) error { return nil }
+func (s *cachingStubStore) GetAccessToken(string, string) (secrets.AccessToken, error) {
+	return s.access, nil
+}
+
+func TestTokenSourceForAccountScopes_MissingScope(t *testing.T) {
+	origOpen := openSecretsStore
+
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	s := &stubStore{tok: secrets.Token{
+		Email:        "a@b.com",
+		RefreshToken: "rt",
+		Services:     []string{"drive"},
+		Scopes:       []string{"https://www.googleapis.com/auth/drive"},
+	}}
+	openSecretsStore = func() (secrets.Store, error) { return s, nil }
+
+	calendarScopes, err := googleauth.Scopes(googleauth.ServiceCalendar)
+	if err != nil {
+		t.Fatalf("Scopes: %v", err)
+	}
+
+	_, err = tokenSourceForAccountScopes(context.Background(), "calendar", "a@b.com", "default", "id", "secret", calendarScopes)
+
+	var mse *MissingScopeError
+	if !errors.As(err, &mse) {
+		t.Fatalf("expected MissingScopeError, got: %T %v", err, err)
+	}
+
+	if mse.Service != "calendar" || strings.Join(mse.Services, ",") != "drive,calendar" || len(mse.Missing) != 1 {
+		t.Fatalf("unexpected: %#v", mse)
+	}
+
+	// Narrower grants for the same service (auth add --readonly) still pass.
+	s.tok.Scopes = []string{"https://www.googleapis.com/auth/drive.readonly"}
+
+	driveScopes, err := googleauth.Scopes(googleauth.ServiceDrive)
+	if err != nil {
+		t.Fatalf("Scopes: %v", err)
+	}
+
+	if _, err := tokenSourceForAccountScopes(context.Background(), "drive", "a@b.com", "default", "id", "secret", driveScopes); err != nil {
+		t.Fatalf("unexpected err for readonly grant: %v", err)
+	}
+}
+
+func TestTokenSourceForAccountScopes_TouchesLastUsed(t *testing.T) {
+	origOpen := openSecretsStore
+	origNow := timeNow
+
+	t.Cleanup(func() {
+		openSecretsStore = origOpen
+		timeNow = origNow
+	})
+
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+
+	s := &stubStore{tok: secrets.Token{Email: "a@b.com", RefreshToken: "rt", Services: []string{"gmail"}}}
+	openSecretsStore = func() (secrets.Store, error) { return s, nil }
+
+	if _, err := tokenSourceForAccountScopes(context.Background(), "gmail", "a@b.com", "default", "id", "secret", []string{"s1"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if len(s.touched) != 1 || !s.touched[0].Equal(now) {
+		t.Fatalf("unexpected last-use writes: %#v", s.touched)
+	}
+
+	if len(s.saved) != 0 {
+		t.Fatalf("token must not be re-saved, got %#v", s.saved)
+	}
+
+	// A recent timestamp is not rewritten.
+	s.tok.LastUsedAt = now.Add(-30 * time.Second)
+	if _, err := tokenSourceForAccountScopes(context.Background(), "gmail", "a@b.com", "default", "id", "secret", []string{"s1"}); err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	if len(s.touched) != 1 {
+		t.Fatalf("expected no second write, got %d", len(s.touched))
+	}
+}
+
+func TestTokenSourceForAccountScopes_UsesCachedAccessToken(t *testing.T) {
+	origOpen := openSecretsStore
+
+	t.Cleanup(func() { openSecretsStore = origOpen })
+
+	s := &cachingStubStore{
+		stubStore: stubStore{tok: secrets.Token{Email: "a@b.com", RefreshToken: "rt"}},
+		access:    secrets.AccessToken{AccessToken: "cached", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)},
+	}
+	openSecretsStore = func() (secrets.Store, error) { return s, nil }
+
+	ts, err := tokenSourceForAccountScopes(context.Background(), "svc", "a@b.com", "default", "id", "secret", []string{"s1"})
+	if err != nil {
+		t.Fatalf("unexpected err: %v", err)
+	}
+
+	// A valid cached token must be returned without contacting the token endpoint.
+	tok, err := ts.Token()
+	if err != nil {
+		t.Fatalf("Token: %v", err)
+	}
+
+	if tok.AccessToken != "cached" {
+		t.Fatalf("expected cached access token, got %q", tok.AccessToken)
+	}
+}
+
+func TestInitialOAuthToken_IgnoresExpiredCache(t *testing.T) {
+	s := &cachingStubStore{access: secrets.AccessToken{AccessToken: "old", Expiry: time.Now().Add(30 * time.Second)}}
+
+	tok := initialOAuthToken(s, "default", "a@b.com", "rt")
+	if tok.AccessToken != "" || tok.RefreshToken != "rt" {
+		t.Fatalf("expected refresh-only seed, got %#v", tok)
+	}
+
+	tok = initialOAuthToken(&stubStore{}, "default", "a@b.com", "rt")
+	if tok.AccessToken != "" {
+		t.Fatalf("expected refresh-only seed for non-caching store, got %#v", tok)
+	}
+}
+
 func TestTokenSourceForAccount_ReadCredsError(t *testing.T) {
 	origRead := readClientCredentials
 
@@ -3,6 +3,7 @@ package googleapi
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"google.golang.org/api/drive/v3"
 
@@ -18,3 +19,13 @@ func NewDrive(ctx context.Context, email string) (*drive.Service, error) {
 		return svc, nil
 	}
 }
+
+// NewDriveHTTPClient returns the authenticated HTTP client used for Drive, for
+// endpoints the generated client does not cover (e.g. per-sheet exports).
+func NewDriveHTTPClient(ctx context.Context, email string) (*http.Client, error) {
+	scopes, err := googleauth.Scopes(googleauth.ServiceDrive)
+	if err != nil {
+		return nil, fmt.Errorf("resolve scopes: %w", err)
+	}
+	return httpClientForAccountScopes(ctx, string(googleauth.ServiceDrive), email, scopes)
+}
@@ -3,6 +3,7 @@ package googleapi
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -25,6 +26,20 @@ func (e *AuthRequiredError) Unwrap() error {
 	return e.Cause
 }
 
+// MissingScopeError is returned before any API call when the stored token's
+// scopes don't cover the service being used.
+type MissingScopeError struct {
+	Service  string
+	Email    string
+	Client   string
+	Missing  []string
+	Services []string // services to pass to `gog auth add` (stored plus Service)
+}
+
+func (e *MissingScopeError) Error() string {
+	return fmt.Sprintf("account %s lacks %s scope (missing: %s)", e.Email, e.Service, strings.Join(e.Missing, ", "))
+}
+
 // RateLimitError indicates rate limit was exceeded
 type RateLimitError struct {
 	RetryAfter time.Duration
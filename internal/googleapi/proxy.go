@@ -0,0 +1,36 @@
+package googleapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/steipete/gogcli/internal/googleauth"
+)
+
+// ParseProxyURL validates a --proxy value. Supported schemes are http, https
+// and socks5.
+func ParseProxyURL(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", raw, err)
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: missing host", raw)
+	}
+	return u, nil
+}
+
+// tokenHTTPClient is the client used for OAuth token exchanges; it honors the
+// proxy so refreshes don't bypass it.
+func tokenHTTPClient(ctx context.Context) *http.Client {
+	return googleauth.HTTPClient(ctx, defaultHTTPTimeout)
+}
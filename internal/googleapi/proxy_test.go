@@ -0,0 +1,16 @@
+package googleapi
+
+import "testing"
+
+func TestParseProxyURL(t *testing.T) {
+	for _, raw := range []string{"http://proxy:8080", "https://user:pw@proxy.example.com", "socks5://127.0.0.1:1080"} {
+		if _, err := ParseProxyURL(raw); err != nil {
+			t.Fatalf("ParseProxyURL(%q): %v", raw, err)
+		}
+	}
+	for _, raw := range []string{"ftp://proxy", "proxy:8080", "http://", "://bad"} {
+		if _, err := ParseProxyURL(raw); err == nil {
+			t.Fatalf("expected error for %q", raw)
+		}
+	}
+}
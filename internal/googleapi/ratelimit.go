@@ -0,0 +1,110 @@
+package googleapi
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token bucket (burst of one) that spaces outbound requests
+// evenly at a fixed rate. It is safe for concurrent use.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+	now      func() time.Time
+}
+
+// NewRateLimiter returns a limiter allowing qps requests per second, or nil
+// when qps <= 0 (unlimited).
+func NewRateLimiter(qps float64) *RateLimiter {
+	if qps <= 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		interval: time.Duration(float64(time.Second) / qps),
+		now:      time.Now,
+	}
+}
+
+// Wait blocks until the next request slot is available or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := slot.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.release(slot)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// release gives back a reserved slot when the waiter was cancelled, so later
+// callers are not delayed by requests that never went out.
+func (l *RateLimiter) release(slot time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.next.Equal(slot.Add(l.interval)) {
+		l.next = slot
+	}
+}
+
+// RateLimitTransport throttles requests through a shared RateLimiter.
+type RateLimitTransport struct {
+	Base    http.RoundTripper
+	Limiter *RateLimiter
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.Limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+
+	return t.Base.RoundTrip(req)
+}
+
+type rateLimiterKey struct{}
+
+// WithRateLimiter shares limiter across every service client created from
+// ctx. A nil limiter leaves requests unthrottled.
+func WithRateLimiter(ctx context.Context, limiter *RateLimiter) context.Context {
+	if limiter == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, rateLimiterKey{}, limiter)
+}
+
+func rateLimiterFromContext(ctx context.Context) *RateLimiter {
+	if ctx == nil {
+		return nil
+	}
+
+	limiter, _ := ctx.Value(rateLimiterKey{}).(*RateLimiter)
+
+	return limiter
+}
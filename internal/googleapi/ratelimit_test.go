@@ -0,0 +1,90 @@
+package googleapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiterUnlimited(t *testing.T) {
+	if l := NewRateLimiter(0); l != nil {
+		t.Fatalf("expected nil limiter for qps=0")
+	}
+
+	var l *RateLimiter
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("nil limiter wait: %v", err)
+	}
+}
+
+func TestRateLimiterSpacesRequests(t *testing.T) {
+	l := NewRateLimiter(100)
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("wait: %v", err)
+		}
+	}
+
+	// First request is immediate, the next three wait ~10ms each.
+	if elapsed := time.Since(start); elapsed < 25*time.Millisecond {
+		t.Fatalf("expected throttling, elapsed %v", elapsed)
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	l := NewRateLimiter(0.1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("first wait: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("cancelled wait took too long: %v", elapsed)
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	calls := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return newTestResponse(http.StatusOK, "ok"), nil
+	})
+
+	rt := &RateLimitTransport{Base: base, Limiter: NewRateLimiter(1000)}
+	for i := 0; i < 3; i++ {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "http://example.com", nil)
+		if err != nil {
+			t.Fatalf("new request: %v", err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("round trip: %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	if got := rateLimiterFromContext(WithRateLimiter(context.Background(), nil)); got != nil {
+		t.Fatalf("expected no limiter")
+	}
+
+	l := NewRateLimiter(5)
+	if got := rateLimiterFromContext(WithRateLimiter(context.Background(), l)); got != l {
+		t.Fatalf("expected shared limiter")
+	}
+}
@@ -0,0 +1,46 @@
+package googleapi
+
+import (
+	"context"
+	"time"
+)
+
+// RetryConfig overrides the default retry policy of the shared transport.
+// Zero values keep the defaults (3 retries on 429, 1 on 5xx, 1s delays).
+type RetryConfig struct {
+	// MaxRetries caps retries for both 429 and retryable 5xx responses.
+	MaxRetries *int
+	// BaseDelay is the initial 429 backoff delay and the fixed 5xx delay.
+	BaseDelay time.Duration
+}
+
+type retryConfigKey struct{}
+
+// WithRetryConfig stores a retry policy that every service client created
+// from ctx applies to its transport.
+func WithRetryConfig(ctx context.Context, cfg RetryConfig) context.Context {
+	return context.WithValue(ctx, retryConfigKey{}, cfg)
+}
+
+func retryConfigFromContext(ctx context.Context) (RetryConfig, bool) {
+	if ctx == nil {
+		return RetryConfig{}, false
+	}
+	cfg, ok := ctx.Value(retryConfigKey{}).(RetryConfig)
+	return cfg, ok
+}
+
+func applyRetryConfig(ctx context.Context, t *RetryTransport) {
+	cfg, ok := retryConfigFromContext(ctx)
+	if !ok {
+		return
+	}
+	if cfg.MaxRetries != nil && *cfg.MaxRetries >= 0 {
+		t.MaxRetries429 = *cfg.MaxRetries
+		t.MaxRetries5xx = *cfg.MaxRetries
+	}
+	if cfg.BaseDelay > 0 {
+		t.BaseDelay = cfg.BaseDelay
+		t.ServerErrorDelay = cfg.BaseDelay
+	}
+}
@@ -3,7 +3,6 @@ package googleapi
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"os"
 
 	"golang.org/x/oauth2"
@@ -20,7 +19,7 @@ var newServiceAccountTokenSource = func(ctx context.Context, keyJSON []byte, sub
 	cfg.Subject = subject
 
 	// Ensure token exchanges don't hang forever.
-	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: defaultHTTPTimeout})
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, tokenHTTPClient(ctx))
 
 	return cfg.TokenSource(ctx), nil
 }
@@ -58,6 +58,10 @@ func TestNewServicesWithStoredToken(t *testing.T) {
 		t.Fatalf("NewSheets: %v", err)
 	}
 
+	if _, err := NewSlides(ctx, "a@b.com"); err != nil {
+		t.Fatalf("NewSlides: %v", err)
+	}
+
 	if _, err := NewTasks(ctx, "a@b.com"); err != nil {
 		t.Fatalf("NewTasks: %v", err)
 	}
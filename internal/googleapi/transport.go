@@ -14,11 +14,12 @@ import (
 // RetryTransport wraps an http.RoundTripper with retry logic for
 // rate limits (429) and server errors (5xx).
 type RetryTransport struct {
-	Base           http.RoundTripper
-	MaxRetries429  int
-	MaxRetries5xx  int
-	BaseDelay      time.Duration
-	CircuitBreaker *CircuitBreaker
+	Base             http.RoundTripper
+	MaxRetries429    int
+	MaxRetries5xx    int
+	BaseDelay        time.Duration
+	ServerErrorDelay time.Duration
+	CircuitBreaker   *CircuitBreaker
 }
 
 // NewRetryTransport creates a RetryTransport with sensible defaults.
@@ -28,11 +29,12 @@ func NewRetryTransport(base http.RoundTripper) *RetryTransport {
 	}
 
 	return &RetryTransport{
-		Base:           base,
-		MaxRetries429:  MaxRateLimitRetries,
-		MaxRetries5xx:  Max5xxRetries,
-		BaseDelay:      RateLimitBaseDelay,
-		CircuitBreaker: NewCircuitBreaker(),
+		Base:             base,
+		MaxRetries429:    MaxRateLimitRetries,
+		MaxRetries5xx:    Max5xxRetries,
+		BaseDelay:        RateLimitBaseDelay,
+		ServerErrorDelay: ServerErrorRetryDelay,
+		CircuitBreaker:   NewCircuitBreaker(),
 	}
 }
 
@@ -108,7 +110,7 @@ func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 				t.CircuitBreaker.RecordFailure()
 			}
 
-			if retries5xx >= t.MaxRetries5xx {
+			if retries5xx >= t.MaxRetries5xx || !retryableServerError(req.Method, resp.StatusCode) {
 				return resp, nil
 			}
 
@@ -118,7 +120,7 @@ func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 			drainAndClose(resp.Body)
 
-			if err := t.sleep(req.Context(), ServerErrorRetryDelay); err != nil {
+			if err := t.sleep(req.Context(), t.ServerErrorDelay); err != nil {
 				return nil, err
 			}
 
@@ -132,6 +134,18 @@ func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}
 }
 
+// retryableServerError reports whether a 5xx response may be retried.
+// Non-idempotent requests (POST/PATCH) only retry on 503, where the server
+// signals it did not process the request, to avoid duplicate writes.
+func retryableServerError(method string, status int) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return status == http.StatusServiceUnavailable
+	}
+}
+
 func (t *RetryTransport) calculateBackoff(attempt int, resp *http.Response) time.Duration {
 	// Check Retry-After header
 	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
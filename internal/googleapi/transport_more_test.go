@@ -383,3 +383,78 @@ func TestRetryTransportRoundTripError(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestRetryTransportRoundTripNonIdempotent5xx(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		status    int
+		wantCalls int
+	}{
+		{name: "post 500 not retried", method: http.MethodPost, status: http.StatusInternalServerError, wantCalls: 1},
+		{name: "patch 502 not retried", method: http.MethodPatch, status: http.StatusBadGateway, wantCalls: 1},
+		{name: "post 503 retried", method: http.MethodPost, status: http.StatusServiceUnavailable, wantCalls: 2},
+		{name: "put 500 retried", method: http.MethodPut, status: http.StatusInternalServerError, wantCalls: 2},
+		{name: "delete 500 retried", method: http.MethodDelete, status: http.StatusInternalServerError, wantCalls: 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+				if calls == 1 {
+					return newTestResponse(tc.status, "err"), nil
+				}
+
+				return newTestResponse(http.StatusOK, "ok"), nil
+			})
+
+			rt := &RetryTransport{Base: base, MaxRetries5xx: 1}
+
+			req, err := http.NewRequestWithContext(context.Background(), tc.method, "http://example.com", io.NopCloser(strings.NewReader("payload")))
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("round trip: %v", err)
+			}
+			_ = resp.Body.Close()
+
+			if calls != tc.wantCalls {
+				t.Fatalf("expected %d calls, got %d", tc.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestApplyRetryConfig(t *testing.T) {
+	rt := NewRetryTransport(nil)
+	applyRetryConfig(context.Background(), rt)
+	if rt.MaxRetries429 != MaxRateLimitRetries || rt.MaxRetries5xx != Max5xxRetries || rt.BaseDelay != RateLimitBaseDelay || rt.ServerErrorDelay != ServerErrorRetryDelay {
+		t.Fatalf("defaults changed without config: %#v", rt)
+	}
+
+	// A config without explicit values (no flags passed) keeps the defaults.
+	applyRetryConfig(WithRetryConfig(context.Background(), RetryConfig{}), rt)
+	if rt.MaxRetries429 != MaxRateLimitRetries || rt.MaxRetries5xx != Max5xxRetries || rt.ServerErrorDelay != ServerErrorRetryDelay {
+		t.Fatalf("defaults changed by empty config: %#v", rt)
+	}
+
+	five, zero := 5, 0
+	ctx := WithRetryConfig(context.Background(), RetryConfig{MaxRetries: &five, BaseDelay: 250 * time.Millisecond})
+	applyRetryConfig(ctx, rt)
+	if rt.MaxRetries429 != 5 || rt.MaxRetries5xx != 5 {
+		t.Fatalf("unexpected retries: 429=%d 5xx=%d", rt.MaxRetries429, rt.MaxRetries5xx)
+	}
+	if rt.BaseDelay != 250*time.Millisecond || rt.ServerErrorDelay != 250*time.Millisecond {
+		t.Fatalf("unexpected delays: base=%v 5xx=%v", rt.BaseDelay, rt.ServerErrorDelay)
+	}
+
+	applyRetryConfig(WithRetryConfig(context.Background(), RetryConfig{MaxRetries: &zero}), rt)
+	if rt.MaxRetries429 != 0 || rt.MaxRetries5xx != 0 {
+		t.Fatalf("expected retries disabled, got 429=%d 5xx=%d", rt.MaxRetries429, rt.MaxRetries5xx)
+	}
+}
@@ -46,6 +46,7 @@ type ManageServer struct {
 	listener   net.Listener
 	server     *http.Server
 	store      secrets.Store
+	httpClient *http.Client
 	fetchEmail func(ctx context.Context, tok *oauth2.Token) (string, error)
 	oauthState string
 	resultCh   chan error
@@ -110,6 +111,7 @@ func StartManageServer(ctx context.Context, opts ManageServerOptions) error {
 		csrfToken:  csrfToken,
 		listener:   ln,
 		store:      store,
+		httpClient: HTTPClient(ctx, oauthHTTPTimeout),
 		fetchEmail: fetchUserEmailDefault,
 		resultCh:   make(chan error, 1),
 	}
@@ -369,6 +371,12 @@ func (ms *ManageServer) handleOAuthCallback(w http.ResponseWriter, r *http.Reque
 	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
 	defer cancel()
 
+	// Request contexts come from the local server, not the CLI, so carry the
+	// proxy-aware client over explicitly.
+	if ms.httpClient != nil {
+		ctx = context.WithValue(ctx, oauth2.HTTPClient, ms.httpClient)
+	}
+
 	tok, err := cfg.Exchange(ctx, code)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -523,7 +531,10 @@ func fetchUserEmailWithURL(ctx context.Context, accessToken string, url string)
 
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
-	client := &http.Client{Timeout: 10 * time.Second}
+	client, ok := ctx.Value(oauth2.HTTPClient).(*http.Client)
+	if !ok || client == nil {
+		client = HTTPClient(ctx, 10*time.Second)
+	}
 
 	resp, err := client.Do(req)
 	if err != nil {
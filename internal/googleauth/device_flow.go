@@ -0,0 +1,60 @@
+package googleauth
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+// defaultDeviceFlowTimeout gives the user time to switch devices and approve;
+// Google device codes themselves expire after ~30 minutes.
+const defaultDeviceFlowTimeout = 10 * time.Minute
+
+// authorizeDevice runs the OAuth 2.0 device authorization grant (RFC 8628):
+// it requests a device code, prints the user code and verification URL, and
+// polls the token endpoint until the user approves, denies, or ctx expires.
+//
+// Google only issues device codes for "TVs and Limited Input devices" OAuth
+// clients, and only for a limited set of scopes.
+func authorizeDevice(ctx context.Context, creds config.ClientCredentials, scopes []string) (string, error) {
+	ctx = withOAuthHTTPClient(ctx, oauthHTTPTimeout)
+
+	cfg := oauth2.Config{
+		ClientID:     creds.ClientID,
+		ClientSecret: creds.ClientSecret,
+		Endpoint:     oauthEndpoint,
+		Scopes:       scopes,
+	}
+
+	da, err := cfg.DeviceAuth(ctx)
+	if err != nil {
+		return "", fmt.Errorf("request device code: %w", err)
+	}
+
+	verificationURL := da.VerificationURIComplete
+	if verificationURL == "" {
+		verificationURL = da.VerificationURI
+	}
+
+	fmt.Fprintln(os.Stderr, "On any device, visit:")
+	fmt.Fprintln(os.Stderr, verificationURL)
+	fmt.Fprintf(os.Stderr, "and enter the code: %s\n", da.UserCode)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Waiting for authorization…")
+
+	tok, err := cfg.DeviceAccessToken(ctx, da)
+	if err != nil {
+		return "", fmt.Errorf("device authorization: %w", err)
+	}
+
+	if tok.RefreshToken == "" {
+		return "", errNoRefreshToken
+	}
+
+	return tok.RefreshToken, nil
+}
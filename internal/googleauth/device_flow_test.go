@@ -0,0 +1,95 @@
+package googleauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func TestAuthorize_DeviceFlow(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+
+	var polls atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "bad form", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/device/code":
+			if r.Form.Get("scope") != "openid email" {
+				http.Error(w, "bad scope", http.StatusBadRequest)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"device_code":      "dev-code",
+				"user_code":        "ABCD-EFGH",
+				"verification_url": "https://www.google.com/device",
+				"expires_in":       60,
+				"interval":         1,
+			})
+		case "/token":
+			if r.Form.Get("device_code") != "dev-code" {
+				http.Error(w, "bad device code", http.StatusBadRequest)
+				return
+			}
+
+			polls.Add(1)
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"access_token":  "at",
+				"refresh_token": "rt-device",
+				"token_type":    "Bearer",
+				"expires_in":    3600,
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	oauthEndpoint = oauth2.Endpoint{
+		DeviceAuthURL: srv.URL + "/device/code",
+		TokenURL:      srv.URL + "/token",
+		AuthStyle:     oauth2.AuthStyleInParams,
+	}
+
+	rt, err := Authorize(context.Background(), AuthorizeOptions{
+		Scopes:     []string{"openid", "email"},
+		DeviceFlow: true,
+		Timeout:    10 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Authorize: %v", err)
+	}
+
+	if rt != "rt-device" {
+		t.Fatalf("unexpected refresh token: %q", rt)
+	}
+
+	if polls.Load() != 1 {
+		t.Fatalf("expected one token poll, got %d", polls.Load())
+	}
+}
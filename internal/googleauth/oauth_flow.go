@@ -26,6 +26,7 @@ type AuthorizeOptions struct {
 	Services     []Service
 	Scopes       []string
 	Manual       bool
+	DeviceFlow   bool
 	ForceConsent bool
 	Timeout      time.Duration
 	Client       string
@@ -62,6 +63,9 @@ var (
 func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 	if opts.Timeout <= 0 {
 		opts.Timeout = 2 * time.Minute
+		if opts.DeviceFlow {
+			opts.Timeout = defaultDeviceFlowTimeout
+		}
 	}
 
 	if len(opts.Scopes) == 0 {
@@ -76,6 +80,16 @@ func Authorize(ctx context.Context, opts AuthorizeOptions) (string, error) {
 		creds = c
 	}
 
+	// Code exchanges and device polling go through the --proxy client.
+	ctx = withOAuthHTTPClient(ctx, oauthHTTPTimeout)
+
+	if opts.DeviceFlow {
+		ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+
+		return authorizeDevice(ctx, creds, opts.Scopes)
+	}
+
 	state, err := randomStateFn()
 	if err != nil {
 		return "", err
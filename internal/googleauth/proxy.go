@@ -0,0 +1,77 @@
+package googleauth
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// oauthHTTPTimeout bounds token exchanges during interactive authorization.
+const oauthHTTPTimeout = 30 * time.Second
+
+type proxyKey struct{}
+
+type proxyConfig struct {
+	url       *url.URL
+	transport *http.Transport
+}
+
+// WithProxy routes API, OAuth token and userinfo requests made with ctx
+// through proxyURL. Without it, HTTPS_PROXY/HTTP_PROXY/NO_PROXY from the
+// environment apply.
+func WithProxy(ctx context.Context, proxyURL *url.URL) context.Context {
+	if proxyURL == nil {
+		return ctx
+	}
+
+	transport := &http.Transport{}
+	if base, ok := http.DefaultTransport.(*http.Transport); ok {
+		transport = base.Clone()
+	}
+	transport.Proxy = http.ProxyURL(proxyURL)
+
+	return context.WithValue(ctx, proxyKey{}, &proxyConfig{url: proxyURL, transport: transport})
+}
+
+func proxyConfigFromContext(ctx context.Context) *proxyConfig {
+	if ctx == nil {
+		return nil
+	}
+	cfg, _ := ctx.Value(proxyKey{}).(*proxyConfig)
+
+	return cfg
+}
+
+// ProxyFunc returns the proxy selector for transports built from ctx.
+func ProxyFunc(ctx context.Context) func(*http.Request) (*url.URL, error) {
+	if cfg := proxyConfigFromContext(ctx); cfg != nil {
+		return http.ProxyURL(cfg.url)
+	}
+
+	return http.ProxyFromEnvironment
+}
+
+// HTTPClient returns a client for OAuth token and userinfo requests that
+// honors the proxy in ctx. Clients share one transport per proxy, so repeated
+// refreshes reuse connections.
+func HTTPClient(ctx context.Context, timeout time.Duration) *http.Client {
+	var transport http.RoundTripper = http.DefaultTransport
+	if cfg := proxyConfigFromContext(ctx); cfg != nil {
+		transport = cfg.transport
+	}
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// withOAuthHTTPClient makes oauth2 calls on ctx use HTTPClient unless a
+// client was already attached.
+func withOAuthHTTPClient(ctx context.Context, timeout time.Duration) context.Context {
+	if c, ok := ctx.Value(oauth2.HTTPClient).(*http.Client); ok && c != nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, oauth2.HTTPClient, HTTPClient(ctx, timeout))
+}
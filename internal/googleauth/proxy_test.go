@@ -0,0 +1,78 @@
+package googleauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"golang.org/x/oauth2"
+
+	"github.com/steipete/gogcli/internal/config"
+)
+
+func TestProxyFunc(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://www.googleapis.com/drive/v3/files", nil)
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	u, err := url.Parse("socks5://127.0.0.1:1080")
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	got, err := ProxyFunc(WithProxy(context.Background(), u))(req)
+	if err != nil || got == nil || got.String() != "socks5://127.0.0.1:1080" {
+		t.Fatalf("unexpected proxy %v (err=%v)", got, err)
+	}
+}
+
+func TestHTTPClient_SharesProxyTransport(t *testing.T) {
+	if c := HTTPClient(context.Background(), 0); c.Transport != http.DefaultTransport {
+		t.Fatalf("expected default transport without --proxy, got %T", c.Transport)
+	}
+
+	ctx := WithProxy(context.Background(), &url.URL{Scheme: "http", Host: "proxy:8080"})
+	a, b := HTTPClient(ctx, 0), HTTPClient(ctx, 0)
+	if a.Transport == http.DefaultTransport || a.Transport != b.Transport {
+		t.Fatalf("expected one shared proxy transport, got %p and %p", a.Transport, b.Transport)
+	}
+}
+
+func TestRefreshAccessToken_UsesProxy(t *testing.T) {
+	origRead := readClientCredentials
+	origEndpoint := oauthEndpoint
+
+	t.Cleanup(func() {
+		readClientCredentials = origRead
+		oauthEndpoint = origEndpoint
+	})
+
+	readClientCredentials = func(string) (config.ClientCredentials, error) {
+		return config.ClientCredentials{ClientID: "id", ClientSecret: "secret"}, nil
+	}
+	oauthEndpoint = oauth2.Endpoint{AuthURL: "http://accounts.invalid/auth", TokenURL: "http://oauth2.invalid/token"}
+
+	var proxied string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = r.URL.String()
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "at", "token_type": "Bearer", "expires_in": 3600})
+	}))
+	t.Cleanup(proxy.Close)
+
+	proxyURL, err := url.Parse(proxy.URL)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+
+	tok, err := RefreshAccessToken(WithProxy(context.Background(), proxyURL), "default", "rt", []string{"s1"}, 0)
+	if err != nil {
+		t.Fatalf("RefreshAccessToken: %v", err)
+	}
+	if tok.AccessToken != "at" || proxied != "http://oauth2.invalid/token" {
+		t.Fatalf("token exchange bypassed the proxy: token=%q proxied=%q", tok.AccessToken, proxied)
+	}
+}
@@ -0,0 +1,116 @@
+package googleauth
+
+import "strings"
+
+const (
+	scopeURLPrefix = "https://www.googleapis.com/auth/"
+	scopeMailFull  = "https://mail.google.com/"
+)
+
+// scopeImplies lists narrower scopes covered by a broader grant, beyond the
+// general rule that X covers X.readonly.
+var scopeImplies = map[string][]string{
+	scopeURLPrefix + "drive": {
+		scopeURLPrefix + "drive.file",
+		scopeURLPrefix + "drive.metadata",
+		scopeURLPrefix + "drive.appdata",
+	},
+	scopeURLPrefix + "drive.metadata": {scopeURLPrefix + "drive.metadata.readonly"},
+	scopeURLPrefix + "calendar": {
+		scopeURLPrefix + "calendar.events",
+		scopeURLPrefix + "calendar.settings.readonly",
+	},
+	scopeURLPrefix + "gmail.modify": {
+		scopeURLPrefix + "gmail.readonly",
+		scopeURLPrefix + "gmail.compose",
+		scopeURLPrefix + "gmail.send",
+		scopeURLPrefix + "gmail.labels",
+	},
+	scopeURLPrefix + "gmail.compose": {scopeURLPrefix + "gmail.send"},
+}
+
+// NormalizeScope expands short scope names ("drive.readonly") to full scope
+// URLs; full URLs and the OIDC scopes are returned unchanged.
+func NormalizeScope(scope string) string {
+	scope = strings.TrimSpace(scope)
+	switch {
+	case scope == "":
+		return ""
+	case scope == scopeOpenID || scope == scopeEmail || scope == "profile":
+		return scope
+	case strings.Contains(scope, "://"):
+		return scope
+	default:
+		return scopeURLPrefix + scope
+	}
+}
+
+// ScopeGranted reports whether scope is covered by granted, either exactly or
+// through a broader scope (e.g. drive covers drive.readonly, and
+// https://mail.google.com/ covers every gmail.* scope).
+func ScopeGranted(granted []string, scope string) bool {
+	scope = NormalizeScope(scope)
+	if scope == "" {
+		return false
+	}
+	for _, g := range granted {
+		if scopeCovers(NormalizeScope(g), scope, 0) {
+			return true
+		}
+	}
+	return false
+}
+
+func scopeCovers(granted, scope string, depth int) bool {
+	if granted == scope {
+		return true
+	}
+	if depth > 4 {
+		return false
+	}
+	if granted == scopeMailFull && strings.HasPrefix(scope, scopeURLPrefix+"gmail.") {
+		return true
+	}
+	if granted+".readonly" == scope {
+		return true
+	}
+	for _, narrower := range scopeImplies[granted] {
+		if scopeCovers(narrower, scope, depth+1) {
+			return true
+		}
+	}
+	return false
+}
+
+// ServiceScopesGranted reports whether granted covers service under any of the
+// scope modes `gog auth add` can request (default, --readonly, or a narrower
+// --drive-scope), so read-only accounts still pass.
+func ServiceScopesGranted(service Service, granted []string) bool {
+	modes := []ScopeOptions{
+		{},
+		{Readonly: true},
+		{DriveScope: DriveScopeReadonly},
+		{DriveScope: DriveScopeFile},
+	}
+	for _, opts := range modes {
+		scopes, err := scopesForServiceWithOptions(service, opts)
+		if err != nil {
+			continue
+		}
+		if len(MissingScopes(granted, scopes)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingScopes returns the entries of required not covered by granted.
+func MissingScopes(granted []string, required []string) []string {
+	var missing []string
+	for _, s := range required {
+		if !ScopeGranted(granted, s) {
+			missing = append(missing, s)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,57 @@
+package googleauth
+
+import "testing"
+
+func TestScopeGranted(t *testing.T) {
+	drive := scopeURLPrefix + "drive"
+	tests := []struct {
+		granted []string
+		scope   string
+		want    bool
+	}{
+		{[]string{drive}, drive, true},
+		{[]string{drive}, "drive", true},
+		{[]string{drive}, "drive.readonly", true},
+		{[]string{drive}, "drive.file", true},
+		{[]string{drive}, "drive.metadata.readonly", true},
+		{[]string{scopeURLPrefix + "drive.readonly"}, "drive", false},
+		{[]string{scopeURLPrefix + "drive.file"}, "drive.readonly", false},
+		{[]string{scopeURLPrefix + "calendar"}, "calendar.events.readonly", true},
+		{[]string{scopeURLPrefix + "calendar.readonly"}, "calendar.events", false},
+		{[]string{scopeMailFull}, "gmail.send", true},
+		{[]string{scopeURLPrefix + "gmail.modify"}, "gmail.readonly", true},
+		{[]string{scopeURLPrefix + "gmail.modify"}, scopeMailFull, false},
+		{[]string{scopeURLPrefix + "contacts"}, "contacts.other.readonly", false},
+		{[]string{scopeURLPrefix + "contacts"}, "contacts.readonly", true},
+		{[]string{"email"}, "email", true},
+		{nil, "drive", false},
+		{[]string{drive}, "", false},
+	}
+	for _, tt := range tests {
+		if got := ScopeGranted(tt.granted, tt.scope); got != tt.want {
+			t.Errorf("ScopeGranted(%v, %q) = %v, want %v", tt.granted, tt.scope, got, tt.want)
+		}
+	}
+}
+
+func TestServiceScopesGranted(t *testing.T) {
+	full, err := Scopes(ServiceCalendar)
+	if err != nil {
+		t.Fatalf("Scopes: %v", err)
+	}
+	if !ServiceScopesGranted(ServiceCalendar, full) {
+		t.Fatal("default calendar scopes should pass")
+	}
+	if !ServiceScopesGranted(ServiceCalendar, []string{scopeURLPrefix + "calendar.readonly"}) {
+		t.Fatal("readonly calendar grant should pass")
+	}
+	if !ServiceScopesGranted(ServiceDrive, []string{scopeURLPrefix + "drive.file"}) {
+		t.Fatal("drive.file grant should pass")
+	}
+	if ServiceScopesGranted(ServiceCalendar, []string{scopeURLPrefix + "drive"}) {
+		t.Fatal("drive grant should not cover calendar")
+	}
+	if got := MissingScopes([]string{scopeURLPrefix + "drive"}, []string{scopeURLPrefix + "drive.readonly", scopeURLPrefix + "tasks"}); len(got) != 1 || got[0] != scopeURLPrefix+"tasks" {
+		t.Fatalf("MissingScopes = %v", got)
+	}
+}
@@ -3,6 +3,7 @@ package googleauth
 import (
 	"errors"
 	"fmt"
+	"net/url"
 	"sort"
 	"strings"
 )
@@ -20,6 +21,7 @@ const (
 	ServiceTasks     Service = "tasks"
 	ServicePeople    Service = "people"
 	ServiceSheets    Service = "sheets"
+	ServiceSlides    Service = "slides"
 	ServiceGroups    Service = "groups"
 	ServiceKeep      Service = "keep"
 )
@@ -33,6 +35,7 @@ const (
 var (
 	errUnknownService    = errors.New("unknown service")
 	errInvalidDriveScope = errors.New("invalid drive scope")
+	errInvalidRawScope   = errors.New("invalid scope")
 )
 
 type DriveScopeMode string
@@ -65,6 +68,7 @@ var serviceOrder = []Service{
 	ServiceContacts,
 	ServiceTasks,
 	ServiceSheets,
+	ServiceSlides,
 	ServicePeople,
 	ServiceGroups,
 	ServiceKeep,
@@ -158,6 +162,15 @@ var serviceInfoByService = map[Service]serviceInfo{
 		apis: []string{"Sheets API", "Drive API"},
 		note: "Export via Drive",
 	},
+	ServiceSlides: {
+		scopes: []string{
+			"https://www.googleapis.com/auth/drive",
+			"https://www.googleapis.com/auth/presentations",
+		},
+		user: true,
+		apis: []string{"Slides API", "Drive API"},
+		note: "Export/copy/create via Drive",
+	},
 	ServiceGroups: {
 		scopes: []string{"https://www.googleapis.com/auth/cloud-identity.groups.readonly"},
 		user:   false,
@@ -459,6 +472,13 @@ func scopesForServiceWithOptions(service Service, opts ScopeOptions) ([]string,
 		}
 
 		return []string{driveScopeValue(), sheetsScope}, nil
+	case ServiceSlides:
+		slidesScope := "https://www.googleapis.com/auth/presentations"
+		if opts.Readonly {
+			slidesScope = "https://www.googleapis.com/auth/presentations.readonly"
+		}
+
+		return []string{driveScopeValue(), slidesScope}, nil
 	case ServiceGroups:
 		return Scopes(service)
 	case ServiceKeep:
@@ -497,6 +517,37 @@ func mergeScopes(scopes []string, extras []string) []string {
 	return out
 }
 
+// MergeRawScopes validates user-supplied scope URLs and merges them into
+// scopes. With replace, only the raw scopes plus the OIDC identity scopes
+// (needed to verify the authorized email) are kept.
+func MergeRawScopes(scopes []string, raw []string, replace bool) ([]string, error) {
+	for _, s := range raw {
+		if err := validateRawScope(s); err != nil {
+			return nil, err
+		}
+	}
+
+	if replace {
+		scopes = []string{scopeOpenID, scopeEmail, scopeUserinfoEmail}
+	}
+
+	return mergeScopes(scopes, raw), nil
+}
+
+func validateRawScope(scope string) error {
+	switch scope {
+	case scopeOpenID, scopeEmail, "profile":
+		return nil
+	}
+
+	u, err := url.Parse(scope)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("%w: %q (expected a full scope URL like https://www.googleapis.com/auth/...)", errInvalidRawScope, scope)
+	}
+
+	return nil
+}
+
 func UserServiceCSV() string {
 	return serviceNames(UserServices(), ",")
 }
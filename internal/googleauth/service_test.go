@@ -1,6 +1,10 @@
 package googleauth
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+)
 
 func TestParseService(t *testing.T) {
 	tests := []struct {
@@ -18,6 +22,7 @@ func TestParseService(t *testing.T) {
 		{"tasks", ServiceTasks},
 		{"people", ServicePeople},
 		{"sheets", ServiceSheets},
+		{"slides", ServiceSlides},
 		{"groups", ServiceGroups},
 		{"keep", ServiceKeep},
 	}
@@ -62,7 +67,7 @@ func TestExtractCodeAndState_Errors(t *testing.T) {
 
 func TestAllServices(t *testing.T) {
 	svcs := AllServices()
-	if len(svcs) != 12 {
+	if len(svcs) != 13 {
 		t.Fatalf("unexpected: %v", svcs)
 	}
 	seen := make(map[Service]bool)
@@ -71,7 +76,7 @@ func TestAllServices(t *testing.T) {
 		seen[s] = true
 	}
 
-	for _, want := range []Service{ServiceGmail, ServiceCalendar, ServiceChat, ServiceClassroom, ServiceDrive, ServiceDocs, ServiceContacts, ServiceTasks, ServicePeople, ServiceSheets, ServiceGroups, ServiceKeep} {
+	for _, want := range []Service{ServiceGmail, ServiceCalendar, ServiceChat, ServiceClassroom, ServiceDrive, ServiceDocs, ServiceContacts, ServiceTasks, ServicePeople, ServiceSheets, ServiceSlides, ServiceGroups, ServiceKeep} {
 		if !seen[want] {
 			t.Fatalf("missing %q", want)
 		}
@@ -80,7 +85,7 @@ func TestAllServices(t *testing.T) {
 
 func TestUserServices(t *testing.T) {
 	svcs := UserServices()
-	if len(svcs) != 10 {
+	if len(svcs) != 11 {
 		t.Fatalf("unexpected: %v", svcs)
 	}
 
@@ -101,7 +106,7 @@ func TestUserServices(t *testing.T) {
 }
 
 func TestUserServiceCSV(t *testing.T) {
-	want := "gmail,calendar,chat,classroom,drive,docs,contacts,tasks,sheets,people"
+	want := "gmail,calendar,chat,classroom,drive,docs,contacts,tasks,sheets,slides,people"
 	if got := UserServiceCSV(); got != want {
 		t.Fatalf("unexpected user services csv: %q", got)
 	}
@@ -378,6 +383,21 @@ func TestScopesForManageWithOptions_SheetsHonorsDriveScopeMode(t *testing.T) {
 	}
 }
 
+func TestScopesForManageWithOptions_SlidesReadonly(t *testing.T) {
+	scopes, err := ScopesForManageWithOptions([]Service{ServiceSlides}, ScopeOptions{Readonly: true})
+	if err != nil {
+		t.Fatalf("err: %v", err)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/presentations.readonly") {
+		t.Fatalf("missing presentations.readonly in %v", scopes)
+	}
+
+	if !containsScope(scopes, "https://www.googleapis.com/auth/drive.readonly") {
+		t.Fatalf("missing drive.readonly in %v", scopes)
+	}
+}
+
 func TestScopes_DocsIncludesDriveAndDocsScopes(t *testing.T) {
 	scopes, err := Scopes(ServiceDocs)
 	if err != nil {
@@ -425,3 +445,32 @@ func TestScopes_UnknownService(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestMergeRawScopes(t *testing.T) {
+	base := []string{"https://www.googleapis.com/auth/drive", scopeEmail}
+	extra := "https://www.googleapis.com/auth/cloud-platform"
+
+	got, err := MergeRawScopes(base, []string{extra, "https://www.googleapis.com/auth/drive"}, false)
+	if err != nil {
+		t.Fatalf("MergeRawScopes: %v", err)
+	}
+
+	if strings.Join(got, " ") != "email https://www.googleapis.com/auth/cloud-platform https://www.googleapis.com/auth/drive" {
+		t.Fatalf("unexpected merged scopes: %v", got)
+	}
+
+	got, err = MergeRawScopes(base, []string{extra}, true)
+	if err != nil {
+		t.Fatalf("MergeRawScopes replace: %v", err)
+	}
+
+	if strings.Join(got, " ") != strings.Join([]string{scopeEmail, extra, scopeUserinfoEmail, scopeOpenID}, " ") {
+		t.Fatalf("unexpected replaced scopes: %v", got)
+	}
+
+	for _, bad := range []string{"drive", "http://example.com/scope", "https://"} {
+		if _, err := MergeRawScopes(base, []string{bad}, false); !errors.Is(err, errInvalidRawScope) {
+			t.Fatalf("expected invalid scope error for %q, got %v", bad, err)
+		}
+	}
+}
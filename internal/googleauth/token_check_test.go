@@ -50,6 +50,14 @@ func TestCheckRefreshTokenSuccess(t *testing.T) {
 	if err := CheckRefreshToken(context.Background(), "default", "good", []string{"scope"}, time.Second); err != nil {
 		t.Fatalf("CheckRefreshToken: %v", err)
 	}
+
+	tok, err := RefreshAccessToken(context.Background(), "default", "good", []string{"scope"}, time.Second)
+	if err != nil {
+		t.Fatalf("RefreshAccessToken: %v", err)
+	}
+	if tok.AccessToken != "access" || tok.Expiry.IsZero() {
+		t.Fatalf("unexpected token: %#v", tok)
+	}
 }
 
 func TestCheckRefreshTokenFailure(t *testing.T) {
@@ -3,7 +3,6 @@ package googleauth
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"strings"
 	"time"
 
@@ -35,7 +34,7 @@ func EmailForRefreshToken(ctx context.Context, client string, refreshToken strin
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Timeout: timeout})
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, HTTPClient(ctx, timeout))
 
 	ts := cfg.TokenSource(ctx, &oauth2.Token{RefreshToken: refreshToken})
 
@@ -2,28 +2,40 @@ package outfmt
 
 import (
 	"context"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
+	"sync"
+	"text/template"
 )
 
 type Mode struct {
 	JSON  bool
 	Plain bool
+	CSV   bool
+	// NDJSON (implies JSON) writes every WriteJSONContext value as a single
+	// compact line, so list commands can stream one item per line.
+	NDJSON bool
 }
 
 type ParseError struct{ msg string }
 
 func (e *ParseError) Error() string { return e.msg }
 
-func FromFlags(jsonOut bool, plainOut bool) (Mode, error) {
+func FromFlags(jsonOut bool, plainOut bool, csvOut bool) (Mode, error) {
 	if jsonOut && plainOut {
 		return Mode{}, &ParseError{msg: "invalid output mode (cannot combine --json and --plain)"}
 	}
 
-	return Mode{JSON: jsonOut, Plain: plainOut}, nil
+	if csvOut && (jsonOut || plainOut) {
+		return Mode{}, &ParseError{msg: "invalid output mode (cannot combine --csv with --json or --plain)"}
+	}
+
+	return Mode{JSON: jsonOut, Plain: plainOut, CSV: csvOut}, nil
 }
 
 func FromEnv() Mode {
@@ -49,13 +61,186 @@ func FromContext(ctx context.Context) Mode {
 	return Mode{}
 }
 
-func IsJSON(ctx context.Context) bool  { return FromContext(ctx).JSON }
-func IsPlain(ctx context.Context) bool { return FromContext(ctx).Plain }
+func IsJSON(ctx context.Context) bool   { return FromContext(ctx).JSON }
+func IsPlain(ctx context.Context) bool  { return FromContext(ctx).Plain }
+func IsCSV(ctx context.Context) bool    { return FromContext(ctx).CSV }
+func IsNDJSON(ctx context.Context) bool { return FromContext(ctx).NDJSON }
+
+type fieldsCtxKey struct{}
+
+// WithFields limits JSON output written via WriteJSONContext to the given
+// dotted paths (e.g. "event.id"). Empty fields disable projection.
+func WithFields(ctx context.Context, fields []string) context.Context {
+	return context.WithValue(ctx, fieldsCtxKey{}, fields)
+}
+
+func FieldsFromContext(ctx context.Context) []string {
+	if v, ok := ctx.Value(fieldsCtxKey{}).([]string); ok {
+		return v
+	}
+
+	return nil
+}
+
+// ParseFields splits a comma-separated --fields value into dotted paths.
+func ParseFields(raw string) []string {
+	parts := strings.Split(raw, ",")
+	out := make([]string, 0, len(parts))
+
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
+// fieldPresets maps a resource (the JSON key holding a command's items) to
+// named --fields projections of one item.
+var fieldPresets = map[string]map[string][]string{
+	"events": {"minimal": {"id", "summary", "start", "end"}},
+	"tasks":  {"minimal": {"id", "title", "status", "due"}},
+	"files":  {"minimal": {"id", "name", "mimeType"}},
+}
+
+// PresetFields resolves a named preset for resource into dotted --fields
+// paths. nextPageToken is always kept so paging keeps working.
+func PresetFields(resource, name string) ([]string, error) {
+	presets, ok := fieldPresets[resource]
+	if !ok {
+		return nil, fmt.Errorf("no field presets for %q", resource)
+	}
+
+	name = strings.ToLower(strings.TrimSpace(name))
+	item, ok := presets[name]
+	if !ok {
+		names := make([]string, 0, len(presets))
+		for n := range presets {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("unknown preset %q (available: %s)", name, strings.Join(names, ", "))
+	}
+
+	out := make([]string, 0, len(item)+1)
+	for _, f := range item {
+		out = append(out, resource+"."+f)
+	}
+
+	return append(out, "nextPageToken"), nil
+}
+
+type templateCtxKey struct{}
+
+// WithTemplate makes WriteJSONContext render its payload through tmpl
+// instead of encoding JSON.
+func WithTemplate(ctx context.Context, tmpl *template.Template) context.Context {
+	return context.WithValue(ctx, templateCtxKey{}, tmpl)
+}
+
+func TemplateFromContext(ctx context.Context) *template.Template {
+	if v, ok := ctx.Value(templateCtxKey{}).(*template.Template); ok {
+		return v
+	}
+
+	return nil
+}
+
+// ParseTemplate parses a --template value. The template sees the command's
+// JSON payload (keys as in --json output).
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("parse template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+type stdoutCtxKey struct{}
+
+// WithStdout sends JSON that commands write to os.Stdout to w instead, so a
+// caller can collect a command's output without touching os.Stdout.
+func WithStdout(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, stdoutCtxKey{}, w)
+}
+
+type stdoutStateCtxKey struct{}
+
+type stdoutState struct {
+	mu    sync.Mutex
+	wrote bool
+}
 
+// TrackStdout returns a ctx under which WriteJSONContext records whether a
+// JSON document has been written to os.Stdout; see WroteStdout.
+func TrackStdout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, stdoutStateCtxKey{}, &stdoutState{})
+}
+
+// WroteStdout reports whether a JSON document has been written to os.Stdout
+// under the TrackStdout ctx, so callers never append a second one.
+func WroteStdout(ctx context.Context) bool {
+	st, ok := ctx.Value(stdoutStateCtxKey{}).(*stdoutState)
+	if !ok {
+		return false
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.wrote
+}
+
+func markStdoutWritten(ctx context.Context) {
+	st, ok := ctx.Value(stdoutStateCtxKey{}).(*stdoutState)
+	if !ok {
+		return
+	}
+
+	st.mu.Lock()
+	st.wrote = true
+	st.mu.Unlock()
+}
+
+// WriteJSON writes v as indented JSON, ignoring any output options; commands
+// use WriteJSONContext so --fields, --template and --ndjson apply.
 func WriteJSON(w io.Writer, v any) error {
+	return WriteJSONContext(context.Background(), w, v)
+}
+
+// WriteJSONContext writes v using the mode, fields and template stored in
+// ctx.
+func WriteJSONContext(ctx context.Context, w io.Writer, v any) error {
+	if f, ok := w.(*os.File); ok && f == os.Stdout {
+		if redirect, ok := ctx.Value(stdoutCtxKey{}).(io.Writer); ok && redirect != nil {
+			w = redirect
+		} else {
+			markStdoutWritten(ctx)
+		}
+	}
+
+	if fields := FieldsFromContext(ctx); len(fields) > 0 {
+		projected, err := projectFields(v, fields)
+		if err != nil {
+			return err
+		}
+
+		v = projected
+	}
+
+	if tmpl := TemplateFromContext(ctx); tmpl != nil {
+		return executeTemplate(tmpl, w, v)
+	}
+
 	enc := json.NewEncoder(w)
 	enc.SetEscapeHTML(false)
-	enc.SetIndent("", "  ")
+	if !IsNDJSON(ctx) {
+		enc.SetIndent("", "  ")
+	}
 
 	if err := enc.Encode(v); err != nil {
 		return fmt.Errorf("encode json: %w", err)
@@ -64,6 +249,23 @@ func WriteJSON(w io.Writer, v any) error {
 	return nil
 }
 
+// WriteCSV writes header and rows as RFC 4180 CSV (CRLF line endings,
+// quoting fields that contain commas, quotes, or newlines).
+func WriteCSV(w io.Writer, header []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = true
+
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("write csv: %w", err)
+	}
+
+	return nil
+}
+
 func KeyValuePayload(key string, value any) map[string]any {
 	return map[string]any{
 		"key":   key,
@@ -92,3 +294,111 @@ func envBool(key string) bool {
 		return false
 	}
 }
+
+func executeTemplate(tmpl *template.Template, w io.Writer, v any) error {
+	data, err := toGeneric(v)
+	if err != nil {
+		return err
+	}
+
+	if err := tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("execute template: %w", err)
+	}
+
+	return nil
+}
+
+func toGeneric(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode json: %w", err)
+	}
+
+	var generic any
+	if err := json.Unmarshal(b, &generic); err != nil {
+		return nil, fmt.Errorf("decode json: %w", err)
+	}
+
+	return generic, nil
+}
+
+// projectFields keeps only the requested dotted paths of v's JSON form.
+// Paths descend into arrays of objects (e.g. "tasks.title"). Unknown paths
+// are omitted; values that are not JSON objects are returned
+// unchanged.
+func projectFields(v any, fields []string) (any, error) {
+	generic, err := toGeneric(v)
+	if err != nil {
+		return nil, err
+	}
+
+	src, ok := generic.(map[string]any)
+	if !ok {
+		return generic, nil
+	}
+
+	out := map[string]any{}
+
+	for _, field := range fields {
+		copyPath(out, src, strings.Split(field, "."))
+	}
+
+	return out, nil
+}
+
+func copyPath(dst map[string]any, src map[string]any, path []string) {
+	val, ok := src[path[0]]
+	if !ok {
+		return
+	}
+
+	if len(path) == 1 {
+		dst[path[0]] = val
+		return
+	}
+
+	if items, isList := val.([]any); isList {
+		copyListPath(dst, path[0], items, path[1:])
+		return
+	}
+
+	child, ok := val.(map[string]any)
+	if !ok {
+		return
+	}
+
+	next, ok := dst[path[0]].(map[string]any)
+	if !ok {
+		next = map[string]any{}
+	}
+
+	copyPath(next, child, path[1:])
+
+	if len(next) > 0 {
+		dst[path[0]] = next
+	}
+}
+
+func copyListPath(dst map[string]any, key string, items []any, rest []string) {
+	next, ok := dst[key].([]any)
+	if !ok || len(next) != len(items) {
+		next = make([]any, len(items))
+	}
+
+	for i, item := range items {
+		obj, isObj := item.(map[string]any)
+		if !isObj {
+			continue
+		}
+
+		elem, isElem := next[i].(map[string]any)
+		if !isElem {
+			elem = map[string]any{}
+		}
+
+		copyPath(elem, obj, rest)
+		next[i] = elem
+	}
+
+	dst[key] = next
+}
@@ -3,15 +3,23 @@ package outfmt
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
 	"testing"
 )
 
 func TestFromFlags(t *testing.T) {
-	if _, err := FromFlags(true, true); err == nil {
+	if _, err := FromFlags(true, true, false); err == nil {
 		t.Fatalf("expected error when combining --json and --plain")
 	}
 
-	got, err := FromFlags(true, false)
+	if _, err := FromFlags(true, false, true); err == nil {
+		t.Fatalf("expected error when combining --json and --csv")
+	}
+
+	got, err := FromFlags(true, false, false)
 	if err != nil {
 		t.Fatalf("unexpected err: %v", err)
 	}
@@ -45,6 +53,49 @@ func TestWriteJSON(t *testing.T) {
 	}
 }
 
+func TestTrackStdout(t *testing.T) {
+	ctx := TrackStdout(WithMode(context.Background(), Mode{JSON: true}))
+
+	var buf bytes.Buffer
+	if err := WriteJSONContext(ctx, &buf, map[string]any{"id": "a"}); err != nil {
+		t.Fatalf("WriteJSONContext: %v", err)
+	}
+	if WroteStdout(ctx) {
+		t.Fatal("writes to other writers must not count as stdout")
+	}
+
+	orig := os.Stdout
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer devNull.Close()
+	os.Stdout = devNull
+	_ = WriteJSONContext(WithFields(ctx, nil), os.Stdout, map[string]any{"id": "b"})
+	os.Stdout = orig
+	if !WroteStdout(ctx) {
+		t.Fatal("expected stdout write to be recorded, including through derived contexts")
+	}
+	if WroteStdout(context.Background()) {
+		t.Fatal("untracked contexts never report a stdout write")
+	}
+}
+
+func TestWithStdoutRedirectsStdoutWrites(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := TrackStdout(WithMode(context.Background(), Mode{JSON: true, NDJSON: true}))
+
+	if err := WriteJSONContext(WithStdout(ctx, &buf), os.Stdout, map[string]any{"id": "a"}); err != nil {
+		t.Fatalf("WriteJSONContext: %v", err)
+	}
+	if buf.String() != "{\"id\":\"a\"}\n" {
+		t.Fatalf("stdout write not redirected: %q", buf.String())
+	}
+	if WroteStdout(ctx) {
+		t.Fatal("redirected writes must not count as stdout")
+	}
+}
+
 func TestFromEnvAndParseError(t *testing.T) {
 	t.Setenv("GOG_JSON", "yes")
 	t.Setenv("GOG_PLAIN", "0")
@@ -65,3 +116,127 @@ func TestFromContext_WrongType(t *testing.T) {
 		t.Fatalf("expected zero mode, got %#v", got)
 	}
 }
+
+func TestWriteJSON_Fields(t *testing.T) {
+	ctx := WithFields(context.Background(), ParseFields(" event.id, event.summary,tasks.title,missing.path,count "))
+
+	var buf bytes.Buffer
+	if err := WriteJSONContext(ctx, &buf, map[string]any{
+		"event": map[string]any{"id": "e1", "summary": "Standup", "status": "confirmed"},
+		"tasks": []map[string]any{{"id": "t1", "title": "A"}, {"id": "t2", "title": "B"}},
+		"count": 2,
+		"extra": true,
+	}); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	want := map[string]any{
+		"event": map[string]any{"id": "e1", "summary": "Standup"},
+		"tasks": []any{map[string]any{"title": "A"}, map[string]any{"title": "B"}},
+		"count": float64(2),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected projection: %#v", got)
+	}
+
+	buf.Reset()
+	if err := WriteJSONContext(ctx, &buf, []string{"a"}); err != nil {
+		t.Fatalf("WriteJSON list: %v", err)
+	}
+
+	if strings.TrimSpace(buf.String()) != "[\n  \"a\"\n]" {
+		t.Fatalf("expected non-object payload unchanged, got %q", buf.String())
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, []string{"ID", "TITLE"}, [][]string{
+		{"1", "plain"},
+		{"2", "has, comma"},
+		{"3", "say \"hi\""},
+		{"4", "multi\nline"},
+	}); err != nil {
+		t.Fatalf("WriteCSV: %v", err)
+	}
+
+	want := "ID,TITLE\r\n1,plain\r\n2,\"has, comma\"\r\n3,\"say \"\"hi\"\"\"\r\n4,\"multi\r\nline\"\r\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected csv:\n%q\nwant\n%q", buf.String(), want)
+	}
+}
+
+func TestWriteJSON_Template(t *testing.T) {
+	tmpl, err := ParseTemplate("{{range .tasks}}{{.title}};{{end}}")
+	if err != nil {
+		t.Fatalf("ParseTemplate: %v", err)
+	}
+
+	type task struct {
+		Title string `json:"title"`
+	}
+
+	var buf bytes.Buffer
+	ctx := WithTemplate(context.Background(), tmpl)
+	if err := WriteJSONContext(ctx, &buf, map[string]any{"tasks": []task{{Title: "A"}, {Title: "B"}}}); err != nil {
+		t.Fatalf("WriteJSON: %v", err)
+	}
+
+	if buf.String() != "A;B;" {
+		t.Fatalf("unexpected template output: %q", buf.String())
+	}
+
+	if _, err := ParseTemplate("{{range .tasks}"); err == nil {
+		t.Fatalf("expected parse error")
+	}
+
+	bad, err := ParseTemplate("{{.tasks.nope.deeper}}")
+	if err != nil {
+		t.Fatalf("ParseTemplate: %v", err)
+	}
+
+	if err := WriteJSONContext(WithTemplate(context.Background(), bad), &buf, map[string]any{"tasks": []any{1}}); err == nil || !strings.Contains(err.Error(), "execute template") {
+		t.Fatalf("expected execute error, got %v", err)
+	}
+}
+
+func TestPresetFields(t *testing.T) {
+	got, err := PresetFields("tasks", " Minimal ")
+	if err != nil {
+		t.Fatalf("PresetFields: %v", err)
+	}
+
+	want := []string{"tasks.id", "tasks.title", "tasks.status", "tasks.due", "nextPageToken"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("PresetFields = %v, want %v", got, want)
+	}
+
+	if _, err := PresetFields("files", "everything"); err == nil || !strings.Contains(err.Error(), "available: minimal") {
+		t.Fatalf("expected available presets in error, got %v", err)
+	}
+
+	if _, err := PresetFields("nope", "minimal"); err == nil {
+		t.Fatalf("expected error for unknown resource")
+	}
+}
+
+func TestWriteJSON_NDJSON(t *testing.T) {
+	ctx := WithMode(context.Background(), Mode{JSON: true, NDJSON: true})
+	ctx = WithFields(ctx, ParseFields("id"))
+
+	var buf bytes.Buffer
+	for _, id := range []string{"a", "b"} {
+		if err := WriteJSONContext(ctx, &buf, map[string]any{"id": id, "name": "x"}); err != nil {
+			t.Fatalf("WriteJSON: %v", err)
+		}
+	}
+
+	if got, want := buf.String(), "{\"id\":\"a\"}\n{\"id\":\"b\"}\n"; got != want {
+		t.Fatalf("ndjson = %q, want %q", got, want)
+	}
+}
@@ -35,6 +35,7 @@ type Token struct {
 	Services     []string  `json:"services,omitempty"`
 	Scopes       []string  `json:"scopes,omitempty"`
 	CreatedAt    time.Time `json:"created_at,omitempty"`
+	LastUsedAt   time.Time `json:"last_used_at,omitempty"`
 	RefreshToken string    `json:"-"`
 }
 
@@ -44,14 +45,15 @@ const (
 )
 
 var (
-	errMissingEmail          = errors.New("missing email")
-	errMissingRefreshToken   = errors.New("missing refresh token")
-	errMissingSecretKey      = errors.New("missing secret key")
-	errNoTTY                 = errors.New("no TTY available for keyring file backend password prompt")
-	errInvalidKeyringBackend = errors.New("invalid keyring backend")
-	errKeyringTimeout        = errors.New("keyring connection timed out")
-	openKeyringFunc          = openKeyring
-	keyringOpenFunc          = keyring.Open
+	errMissingEmail           = errors.New("missing email")
+	errMissingRefreshToken    = errors.New("missing refresh token")
+	errMissingSecretKey       = errors.New("missing secret key")
+	errNoTTY                  = errors.New("no TTY available for keyring file backend password prompt")
+	errInvalidKeyringBackend  = errors.New("invalid keyring backend")
+	errKeyringTimeout         = errors.New("keyring connection timed out")
+	openKeyringFunc           = openKeyring
+	openKeyringForBackendFunc = openKeyringForBackend
+	keyringOpenFunc           = keyring.Open
 )
 
 type KeyringBackendInfo struct {
@@ -62,7 +64,9 @@ type KeyringBackendInfo struct {
 const (
 	keyringBackendSourceEnv     = "env"
 	keyringBackendSourceConfig  = "config"
+	keyringBackendSourceProfile = "profile"
 	keyringBackendSourceDefault = "default"
+	keyringBackendSourceFlag    = "flag"
 	keyringBackendAuto          = "auto"
 )
 
@@ -76,6 +80,12 @@ func ResolveKeyringBackendInfo() (KeyringBackendInfo, error) {
 		return KeyringBackendInfo{}, fmt.Errorf("resolve keyring backend: %w", err)
 	}
 
+	if p, ok := config.ActiveProfile(cfg); ok {
+		if v := normalizeKeyringBackend(p.KeyringBackend); v != "" {
+			return KeyringBackendInfo{Value: v, Source: keyringBackendSourceProfile}, nil
+		}
+	}
+
 	if cfg.KeyringBackend != "" {
 		if v := normalizeKeyringBackend(cfg.KeyringBackend); v != "" {
 			return KeyringBackendInfo{Value: v, Source: keyringBackendSourceConfig}, nil
@@ -146,7 +156,39 @@ func shouldUseKeyringTimeout(goos string, backendInfo KeyringBackendInfo, dbusAd
 	return goos == "linux" && backendInfo.Value == "auto" && dbusAddr != ""
 }
 
+// EffectiveKeyringBackend reports the backend the configured value actually
+// opens as on this host. "auto" resolves to file on headless Linux and to
+// keychain on macOS; elsewhere it may pick another OS keyring, so "" is
+// returned.
+func EffectiveKeyringBackend(backendInfo KeyringBackendInfo) string {
+	return effectiveKeyringBackend(runtime.GOOS, backendInfo, os.Getenv("DBUS_SESSION_BUS_ADDRESS"))
+}
+
+func effectiveKeyringBackend(goos string, backendInfo KeyringBackendInfo, dbusAddr string) string {
+	if backendInfo.Value != keyringBackendAuto {
+		return backendInfo.Value
+	}
+
+	switch {
+	case shouldForceFileBackend(goos, backendInfo, dbusAddr):
+		return "file"
+	case goos == "darwin":
+		return "keychain"
+	default:
+		return ""
+	}
+}
+
 func openKeyring() (keyring.Keyring, error) {
+	backendInfo, err := ResolveKeyringBackendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return openKeyringForBackend(backendInfo)
+}
+
+func openKeyringForBackend(backendInfo KeyringBackendInfo) (keyring.Keyring, error) {
 	// On Linux/WSL/containers, OS keychains (secret-service/kwallet) may be unavailable.
 	// In that case github.com/99designs/keyring falls back to the "file" backend,
 	// which *requires* both a directory and a password prompt function.
@@ -155,11 +197,6 @@ func openKeyring() (keyring.Keyring, error) {
 		return nil, fmt.Errorf("ensure keyring dir: %w", err)
 	}
 
-	backendInfo, err := ResolveKeyringBackendInfo()
-	if err != nil {
-		return nil, err
-	}
-
 	backends, err := allowedBackends(backendInfo)
 	if err != nil {
 		return nil, err
@@ -245,6 +282,22 @@ func OpenDefault() (Store, error) {
 	return &KeyringStore{ring: ring}, nil
 }
 
+// OpenBackend opens a store on an explicit backend (keychain|file), ignoring
+// GOG_KEYRING_BACKEND and config. Used to migrate tokens between backends.
+func OpenBackend(backend string) (Store, error) {
+	info := KeyringBackendInfo{Value: normalizeKeyringBackend(backend), Source: keyringBackendSourceFlag}
+	if info.Value == "" || info.Value == keyringBackendAuto {
+		return nil, fmt.Errorf("%w: %q (expected keychain or file)", errInvalidKeyringBackend, backend)
+	}
+
+	ring, err := openKeyringForBackendFunc(info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyringStore{ring: ring}, nil
+}
+
 func SetSecret(key string, value []byte) error {
 	key = strings.TrimSpace(key)
 	if key == "" {
@@ -346,6 +399,12 @@ func (s *KeyringStore) SetToken(client string, email string, tok Token) error {
 		}
 	}
 
+	// A cached access token was minted for the previous grant and may carry
+	// other scopes; drop it so the next call refreshes with the new one.
+	if err := s.ring.Remove(accessTokenKey(normalizedClient, email)); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+		return fmt.Errorf("delete access token: %w", err)
+	}
+
 	return nil
 }
 
@@ -390,6 +449,7 @@ func (s *KeyringStore) GetToken(client string, email string) (Token, error) {
 		Services:     st.Services,
 		Scopes:       st.Scopes,
 		CreatedAt:    st.CreatedAt,
+		LastUsedAt:   s.tokenLastUsed(normalizedClient, email),
 		RefreshToken: st.RefreshToken,
 	}, nil
 }
@@ -409,6 +469,14 @@ func (s *KeyringStore) DeleteToken(client string, email string) error {
 		return fmt.Errorf("delete token: %w", err)
 	}
 
+	if err := s.ring.Remove(accessTokenKey(normalizedClient, email)); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+		return fmt.Errorf("delete access token: %w", err)
+	}
+
+	if err := s.ring.Remove(lastUsedKey(normalizedClient, email)); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
+		return fmt.Errorf("delete token last use: %w", err)
+	}
+
 	if normalizedClient == config.DefaultClientName {
 		if err := s.ring.Remove(legacyTokenKey(email)); err != nil && !errors.Is(err, keyring.ErrKeyNotFound) {
 			return fmt.Errorf("delete legacy token: %w", err)
@@ -505,6 +573,125 @@ func normalizeClient(raw string) (string, error) {
 	return client, nil
 }
 
+// AccessToken is a short-lived OAuth access token cached by `gog auth refresh`.
+type AccessToken struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type,omitempty"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// Valid reports whether the cached token is usable for at least skew longer.
+func (t AccessToken) Valid(now time.Time, skew time.Duration) bool {
+	return t.AccessToken != "" && !t.Expiry.IsZero() && now.Add(skew).Before(t.Expiry)
+}
+
+// AccessTokenStore is implemented by stores that can cache access tokens.
+type AccessTokenStore interface {
+	SetAccessToken(client string, email string, tok AccessToken) error
+	GetAccessToken(client string, email string) (AccessToken, error)
+}
+
+func accessTokenKey(client string, email string) string {
+	return fmt.Sprintf("access_token:%s:%s", client, email)
+}
+
+func (s *KeyringStore) SetAccessToken(client string, email string, tok AccessToken) error {
+	email = normalize(email)
+	if email == "" {
+		return errMissingEmail
+	}
+
+	normalizedClient, err := normalizeClient(client)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(tok)
+	if err != nil {
+		return fmt.Errorf("encode access token: %w", err)
+	}
+
+	if err := s.ring.Set(keyring.Item{
+		Key:  accessTokenKey(normalizedClient, email),
+		Data: payload,
+	}); err != nil {
+		return wrapKeychainError(fmt.Errorf("store access token: %w", err))
+	}
+
+	return nil
+}
+
+func (s *KeyringStore) GetAccessToken(client string, email string) (AccessToken, error) {
+	email = normalize(email)
+	if email == "" {
+		return AccessToken{}, errMissingEmail
+	}
+
+	normalizedClient, err := normalizeClient(client)
+	if err != nil {
+		return AccessToken{}, err
+	}
+
+	item, err := s.ring.Get(accessTokenKey(normalizedClient, email))
+	if err != nil {
+		return AccessToken{}, fmt.Errorf("read access token: %w", err)
+	}
+
+	var tok AccessToken
+	if err := json.Unmarshal(item.Data, &tok); err != nil {
+		return AccessToken{}, fmt.Errorf("decode access token: %w", err)
+	}
+
+	return tok, nil
+}
+
+// TokenUsageStore is implemented by stores that record when a token was last
+// used. The timestamp lives under its own key so recording it never rewrites
+// the refresh token entry.
+type TokenUsageStore interface {
+	SetTokenLastUsed(client string, email string, at time.Time) error
+}
+
+func lastUsedKey(client string, email string) string {
+	return fmt.Sprintf("last_used:%s:%s", client, email)
+}
+
+func (s *KeyringStore) SetTokenLastUsed(client string, email string, at time.Time) error {
+	email = normalize(email)
+	if email == "" {
+		return errMissingEmail
+	}
+
+	normalizedClient, err := normalizeClient(client)
+	if err != nil {
+		return err
+	}
+
+	if err := s.ring.Set(keyring.Item{
+		Key:  lastUsedKey(normalizedClient, email),
+		Data: []byte(at.UTC().Format(time.RFC3339)),
+	}); err != nil {
+		return wrapKeychainError(fmt.Errorf("store token last use: %w", err))
+	}
+
+	return nil
+}
+
+// tokenLastUsed returns the recorded last use, or zero when none was stored.
+func (s *KeyringStore) tokenLastUsed(client string, email string) time.Time {
+	item, err := s.ring.Get(lastUsedKey(client, email))
+	if err != nil {
+		return time.Time{}
+	}
+
+	at, err := time.Parse(time.RFC3339, string(item.Data))
+	if err != nil {
+		return time.Time{}
+	}
+
+	return at
+}
+
 const defaultAccountKey = "default_account"
 
 func defaultAccountKeyForClient(client string) string {
@@ -162,3 +162,121 @@ func TestKeyringStoreDeleteAndDefaultErrors(t *testing.T) {
 		t.Fatalf("expected missing email, got %v", err)
 	}
 }
+
+func TestKeyringStore_AccessTokenCache(t *testing.T) {
+	store := &KeyringStore{ring: keyring.NewArrayKeyring(nil)}
+	client := config.DefaultClientName
+
+	if err := store.SetToken(client, "a@b.com", Token{Email: "a@b.com", RefreshToken: "rt"}); err != nil {
+		t.Fatalf("SetToken: %v", err)
+	}
+
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	if err := store.SetAccessToken(client, "A@B.com", AccessToken{AccessToken: "at", TokenType: "Bearer", Expiry: expiry}); err != nil {
+		t.Fatalf("SetAccessToken: %v", err)
+	}
+
+	got, err := store.GetAccessToken(client, "a@b.com")
+	if err != nil {
+		t.Fatalf("GetAccessToken: %v", err)
+	}
+	if got.AccessToken != "at" || !got.Expiry.Equal(expiry) {
+		t.Fatalf("unexpected access token: %#v", got)
+	}
+	if !got.Valid(time.Now(), time.Minute) || got.Valid(expiry, 0) {
+		t.Fatalf("unexpected validity for %#v", got)
+	}
+
+	tokens, err := store.ListTokens()
+	if err != nil {
+		t.Fatalf("ListTokens: %v", err)
+	}
+	if len(tokens) != 1 {
+		t.Fatalf("access token cache should not appear in ListTokens, got %d tokens", len(tokens))
+	}
+
+	if err := store.SetToken(client, "a@b.com", Token{Email: "a@b.com", RefreshToken: "rt2", Scopes: []string{"email"}}); err != nil {
+		t.Fatalf("SetToken (re-auth): %v", err)
+	}
+	if _, err := store.GetAccessToken(client, "a@b.com"); err == nil {
+		t.Fatalf("expected cached access token to be dropped on re-authorization")
+	}
+
+	if err := store.SetAccessToken(client, "a@b.com", AccessToken{AccessToken: "at2", TokenType: "Bearer", Expiry: expiry}); err != nil {
+		t.Fatalf("SetAccessToken: %v", err)
+	}
+	if err := store.DeleteToken(client, "a@b.com"); err != nil {
+		t.Fatalf("DeleteToken: %v", err)
+	}
+	if _, err := store.GetAccessToken(client, "a@b.com"); err == nil {
+		t.Fatalf("expected cached access token to be removed with the refresh token")
+	}
+}
+
+func TestKeyringStore_LastUsedAt(t *testing.T) {
+	ring := keyring.NewArrayKeyring(nil)
+	store := &KeyringStore{ring: ring}
+	client := config.DefaultClientName
+
+	// Tokens written before last use was tracked decode with a zero value.
+	legacy := []byte(`{"refresh_token":"rt"}`)
+	if err := ring.Set(keyring.Item{Key: tokenKey(client, "old@b.com"), Data: legacy}); err != nil {
+		t.Fatalf("ring.Set: %v", err)
+	}
+	old, err := store.GetToken(client, "old@b.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if !old.LastUsedAt.IsZero() {
+		t.Fatalf("expected zero LastUsedAt, got %v", old.LastUsedAt)
+	}
+
+	used := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if err := store.SetTokenLastUsed(client, "Old@b.com", used); err != nil {
+		t.Fatalf("SetTokenLastUsed: %v", err)
+	}
+	got, err := store.GetToken(client, "old@b.com")
+	if err != nil {
+		t.Fatalf("GetToken: %v", err)
+	}
+	if !got.LastUsedAt.Equal(used) || !got.CreatedAt.IsZero() {
+		t.Fatalf("unexpected token after touch: %#v", got)
+	}
+
+	// Recording a use must not rewrite the token entry itself.
+	item, err := ring.Get(tokenKey(client, "old@b.com"))
+	if err != nil || string(item.Data) != string(legacy) {
+		t.Fatalf("token entry changed: %q (err=%v)", item.Data, err)
+	}
+
+	if err := store.DeleteToken(client, "old@b.com"); err != nil {
+		t.Fatalf("DeleteToken: %v", err)
+	}
+	if _, err := ring.Get(lastUsedKey(client, "old@b.com")); !errors.Is(err, keyring.ErrKeyNotFound) {
+		t.Fatalf("expected last use removed, got %v", err)
+	}
+}
+
+func TestOpenBackend(t *testing.T) {
+	origOpen := openKeyringForBackendFunc
+
+	t.Cleanup(func() { openKeyringForBackendFunc = origOpen })
+
+	var got KeyringBackendInfo
+	openKeyringForBackendFunc = func(info KeyringBackendInfo) (keyring.Keyring, error) {
+		got = info
+		return keyring.NewArrayKeyring(nil), nil
+	}
+
+	if _, err := OpenBackend("auto"); !errors.Is(err, errInvalidKeyringBackend) {
+		t.Fatalf("expected invalid backend for auto, got %v", err)
+	}
+
+	if _, err := OpenBackend(" File "); err != nil {
+		t.Fatalf("OpenBackend: %v", err)
+	}
+
+	if got.Value != "file" || got.Source != keyringBackendSourceFlag {
+		t.Fatalf("unexpected backend info: %#v", got)
+	}
+}
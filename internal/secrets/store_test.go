@@ -114,6 +114,35 @@ func TestResolveKeyringBackendInfo_EnvOverridesConfig(t *testing.T) {
 	}
 }
 
+func TestResolveKeyringBackendInfo_ProfileOverridesConfig(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, "xdg-config"))
+	t.Setenv("GOG_KEYRING_BACKEND", "")
+	t.Cleanup(func() { config.UseProfile("") })
+
+	if err := config.WriteConfig(config.File{
+		KeyringBackend: "keychain",
+		Profiles:       map[string]config.Profile{"work": {KeyringBackend: "file"}},
+	}); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	config.UseProfile("work")
+	info, err := ResolveKeyringBackendInfo()
+	if err != nil {
+		t.Fatalf("ResolveKeyringBackendInfo: %v", err)
+	}
+	if info.Value != "file" || info.Source != keyringBackendSourceProfile {
+		t.Fatalf("expected file from profile, got %+v", info)
+	}
+
+	config.UseProfile("")
+	if info, err = ResolveKeyringBackendInfo(); err != nil || info.Source != keyringBackendSourceConfig {
+		t.Fatalf("expected config source without profile, got %+v %v", info, err)
+	}
+}
+
 func TestAllowedBackends_Invalid(t *testing.T) {
 	_, err := allowedBackends(KeyringBackendInfo{Value: "nope"})
 	if err == nil {
@@ -182,6 +211,32 @@ func TestKeyringDbusGuards(t *testing.T) {
 	}
 }
 
+func TestEffectiveKeyringBackend(t *testing.T) {
+	tests := []struct {
+		name     string
+		goos     string
+		backend  string
+		dbusAddr string
+		want     string
+	}{
+		{name: "explicit keychain", goos: "linux", backend: "keychain", want: "keychain"},
+		{name: "explicit file", goos: "darwin", backend: "file", want: "file"},
+		{name: "linux auto no dbus", goos: "linux", backend: "auto", want: "file"},
+		{name: "linux auto with dbus", goos: "linux", backend: "auto", dbusAddr: "unix:path=/run/user/1000/bus", want: ""},
+		{name: "darwin auto", goos: "darwin", backend: "auto", want: "keychain"},
+		{name: "windows auto", goos: "windows", backend: "auto", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info := KeyringBackendInfo{Value: tt.backend}
+			if got := effectiveKeyringBackend(tt.goos, info, tt.dbusAddr); got != tt.want {
+				t.Fatalf("effectiveKeyringBackend=%q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestOpenKeyringWithTimeout_Success(t *testing.T) {
 	home := t.TempDir()
 	t.Setenv("HOME", home)
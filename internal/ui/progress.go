@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultProgressInterval = 2 * time.Second
+
+// Progress prints periodic "label: N/M done" lines to stderr while a bulk
+// operation runs. When the total is unknown (<= 0) it prints a running count
+// instead. Lines are rate-limited, so operations that finish within one
+// interval print nothing. A Progress is safe for concurrent use.
+type Progress struct {
+	mu       sync.Mutex
+	p        *Printer // nil when progress output is disabled
+	label    string
+	total    int
+	done     int
+	printed  bool
+	shown    int // done count of the last printed line
+	last     time.Time
+	interval time.Duration
+	now      func() time.Time
+}
+
+// Progress starts a reporter for total items (<= 0 when unknown). It is a
+// no-op when the UI was created with NoProgress.
+func (u *UI) Progress(label string, total int) *Progress {
+	pr := &Progress{label: label, total: total, interval: defaultProgressInterval, now: time.Now}
+	if u == nil || u.noProgress {
+		return pr
+	}
+	if u.progressInterval > 0 {
+		pr.interval = u.progressInterval
+	}
+	pr.p = u.err
+	pr.last = pr.now()
+	return pr
+}
+
+// Add records n more finished items.
+func (pr *Progress) Add(n int) {
+	if pr == nil {
+		return
+	}
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	pr.done += n
+	if pr.p == nil {
+		return
+	}
+	if now := pr.now(); now.Sub(pr.last) >= pr.interval {
+		pr.last = now
+		pr.print()
+	}
+}
+
+// Done prints the final count, but only if an earlier line was printed and
+// it is out of date.
+func (pr *Progress) Done() {
+	if pr == nil {
+		return
+	}
+	pr.mu.Lock()
+	defer pr.mu.Unlock()
+
+	if pr.p != nil && pr.printed && pr.shown != pr.done {
+		pr.print()
+	}
+}
+
+func (pr *Progress) print() {
+	pr.printed = true
+	pr.shown = pr.done
+	if pr.total > 0 {
+		pr.p.Printf("%s: %d/%d done", pr.label, pr.done, pr.total)
+		return
+	}
+	pr.p.Printf("%s: %d done", pr.label, pr.done)
+}
@@ -0,0 +1,79 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func newTestProgress(t *testing.T, opts Options, label string, total int) (*Progress, *time.Time) {
+	t.Helper()
+
+	u, err := New(opts)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	u.progressInterval = time.Second
+
+	clock := time.Unix(0, 0)
+	pr := u.Progress(label, total)
+	pr.now = func() time.Time { return clock }
+	pr.last = clock
+	return pr, &clock
+}
+
+func TestProgress_PeriodicLines(t *testing.T) {
+	var errBuf bytes.Buffer
+	pr, clock := newTestProgress(t, Options{Stdout: &bytes.Buffer{}, Stderr: &errBuf, Color: "never"}, "import", 5)
+
+	pr.Add(1) // within the interval: nothing printed
+	*clock = clock.Add(time.Second)
+	pr.Add(1)
+	pr.Add(1)
+	*clock = clock.Add(2 * time.Second)
+	pr.Add(2)
+	pr.Done() // already shows 5/5
+
+	if got, want := errBuf.String(), "import: 2/5 done\nimport: 5/5 done\n"; got != want {
+		t.Fatalf("progress = %q, want %q", got, want)
+	}
+}
+
+func TestProgress_UnknownTotalAndQuick(t *testing.T) {
+	var errBuf bytes.Buffer
+	pr, clock := newTestProgress(t, Options{Stdout: &bytes.Buffer{}, Stderr: &errBuf, Color: "never"}, "scan", 0)
+	*clock = clock.Add(time.Second)
+	pr.Add(3)
+	pr.Add(4)
+	pr.Done()
+	if got, want := errBuf.String(), "scan: 3 done\nscan: 7 done\n"; got != want {
+		t.Fatalf("progress = %q, want %q", got, want)
+	}
+
+	// Operations that finish within one interval stay silent.
+	errBuf.Reset()
+	quick, _ := newTestProgress(t, Options{Stdout: &bytes.Buffer{}, Stderr: &errBuf, Color: "never"}, "copy", 2)
+	quick.Add(2)
+	quick.Done()
+	if errBuf.Len() != 0 {
+		t.Fatalf("expected no output, got %q", errBuf.String())
+	}
+}
+
+func TestProgress_Disabled(t *testing.T) {
+	var errBuf bytes.Buffer
+	pr, clock := newTestProgress(t, Options{Stdout: &bytes.Buffer{}, Stderr: &errBuf, Color: "never", NoProgress: true}, "copy", 2)
+	*clock = clock.Add(time.Minute)
+	pr.Add(1)
+	pr.Done()
+	if errBuf.Len() != 0 {
+		t.Fatalf("expected no output, got %q", errBuf.String())
+	}
+
+	var nilUI *UI
+	nilUI.Progress("x", 1).Add(1)
+
+	var nilProgress *Progress
+	nilProgress.Add(1)
+	nilProgress.Done()
+}
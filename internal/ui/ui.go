@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/muesli/termenv"
 )
@@ -14,6 +15,8 @@ type Options struct {
 	Stdout io.Writer
 	Stderr io.Writer
 	Color  string // auto|always|never
+	// NoProgress disables Progress lines (e.g. for --json).
+	NoProgress bool
 }
 
 const colorNever = "never"
@@ -21,6 +24,9 @@ const colorNever = "never"
 type UI struct {
 	out *Printer
 	err *Printer
+
+	noProgress       bool
+	progressInterval time.Duration // overrides defaultProgressInterval in tests
 }
 
 type ParseError struct{ msg string }
@@ -52,8 +58,9 @@ func New(opts Options) (*UI, error) {
 	errProfile := chooseProfile(errOut.Profile, colorMode)
 
 	return &UI{
-		out: newPrinter(out, outProfile),
-		err: newPrinter(errOut, errProfile),
+		out:        newPrinter(out, outProfile),
+		err:        newPrinter(errOut, errProfile),
+		noProgress: opts.NoProgress,
 	}, nil
 }
 